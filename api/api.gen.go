@@ -15,53 +15,321 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ArticleAnnotationFormat.
+const (
+	ArticleAnnotationFormatRuby  ArticleAnnotationFormat = "ruby"
+	ArticleAnnotationFormatSpans ArticleAnnotationFormat = "spans"
+)
+
+// Defines values for BatchOperationMethod.
+const (
+	BatchCreate BatchOperationMethod = "create"
+	BatchDelete BatchOperationMethod = "delete"
+	BatchUpdate BatchOperationMethod = "update"
+)
+
+// Defines values for BatchOperationResource.
+const (
+	BatchArticle   BatchOperationResource = "article"
+	BatchNewspaper BatchOperationResource = "newspaper"
+)
+
+// Defines values for ColumnSchedule.
+const (
+	Daily     ColumnSchedule = "daily"
+	Irregular ColumnSchedule = "irregular"
+	Monthly   ColumnSchedule = "monthly"
+	Weekdays  ColumnSchedule = "weekdays"
+	Weekly    ColumnSchedule = "weekly"
+)
+
+// Defines values for DiffEditOp.
+const (
+	Delete DiffEditOp = "delete"
+	Equal  DiffEditOp = "equal"
+	Insert DiffEditOp = "insert"
+)
+
+// Defines values for JSONPatchOperationOp.
+const (
+	JSONPatchAdd     JSONPatchOperationOp = "add"
+	JSONPatchCopy    JSONPatchOperationOp = "copy"
+	JSONPatchMove    JSONPatchOperationOp = "move"
+	JSONPatchRemove  JSONPatchOperationOp = "remove"
+	JSONPatchReplace JSONPatchOperationOp = "replace"
+	JSONPatchTest    JSONPatchOperationOp = "test"
+)
+
+// Defines values for PracticeErrorCategory.
+const (
+	Extra       PracticeErrorCategory = "extra"
+	Missing     PracticeErrorCategory = "missing"
+	Substituted PracticeErrorCategory = "substituted"
+)
+
+// Defines values for WebhookDeliveryResponseStatus.
+const (
+	Failed    WebhookDeliveryResponseStatus = "failed"
+	Pending   WebhookDeliveryResponseStatus = "pending"
+	Succeeded WebhookDeliveryResponseStatus = "succeeded"
+)
+
+// Defines values for WebhookEvent.
+const (
+	ArticleCreated   WebhookEvent = "article.created"
+	ArticleDeleted   WebhookEvent = "article.deleted"
+	ArticleUpdated   WebhookEvent = "article.updated"
+	NewspaperCreated WebhookEvent = "newspaper.created"
+	NewspaperDeleted WebhookEvent = "newspaper.deleted"
+	NewspaperUpdated WebhookEvent = "newspaper.updated"
+)
+
+// Defines values for ArticleSort.
+const (
+	ArticleSortLongest  ArticleSort = "longest"
+	ArticleSortNewest   ArticleSort = "newest"
+	ArticleSortOldest   ArticleSort = "oldest"
+	ArticleSortShortest ArticleSort = "shortest"
+)
+
+// Defines values for ListArticlesParamsSort.
+const (
+	ListArticlesParamsSortLongest  ListArticlesParamsSort = "longest"
+	ListArticlesParamsSortNewest   ListArticlesParamsSort = "newest"
+	ListArticlesParamsSortOldest   ListArticlesParamsSort = "oldest"
+	ListArticlesParamsSortShortest ListArticlesParamsSort = "shortest"
+)
+
+// Defines values for GetArticleByIdParamsAnnotate.
+const (
+	GetArticleByIdParamsAnnotateRuby  GetArticleByIdParamsAnnotate = "ruby"
+	GetArticleByIdParamsAnnotateSpans GetArticleByIdParamsAnnotate = "spans"
+)
+
+// Defines values for DiffArticleRevisionsParamsUnit.
+const (
+	Char DiffArticleRevisionsParamsUnit = "char"
+	Line DiffArticleRevisionsParamsUnit = "line"
+)
+
+// AnnotationSpan defines model for AnnotationSpan.
+type AnnotationSpan struct {
+	Offset  int     `json:"offset"`
+	Reading *string `json:"reading,omitempty"`
+	Text    string  `json:"text"`
+}
+
+// ArticleAnnotation defines model for ArticleAnnotation.
+type ArticleAnnotation struct {
+	Format ArticleAnnotationFormat `json:"format"`
+	Html   *string                 `json:"html,omitempty"`
+	Spans  *[]AnnotationSpan       `json:"spans,omitempty"`
+}
+
+// ArticleAnnotationFormat defines model for ArticleAnnotation.Format.
+type ArticleAnnotationFormat string
+
 // ArticleCreateRequest defines model for ArticleCreateRequest.
 type ArticleCreateRequest struct {
 	Body        string `json:"body"`
+	ColumnID    *int   `json:"columnID"`
 	Day         int    `json:"day"`
 	Month       int    `json:"month"`
-	NewspaperID *int   `json:"newspaperID,omitempty"`
+	NewspaperID int    `json:"newspaperID"`
 	Year        int    `json:"year"`
 }
 
+// ArticleDiffResponse defines model for ArticleDiffResponse.
+type ArticleDiffResponse struct {
+	Edits []DiffEdit `json:"edits"`
+	From  int        `json:"from"`
+	To    int        `json:"to"`
+	Unit  string     `json:"unit"`
+}
+
 // ArticleResponse defines model for ArticleResponse.
 type ArticleResponse struct {
-	Body        string `json:"body"`
-	Day         int    `json:"day"`
-	Id          int    `json:"id"`
-	Month       int    `json:"month"`
-	NewspaperID *int   `json:"newspaperID,omitempty"`
-	Year        int    `json:"year"`
+	Annotation  *ArticleAnnotation `json:"annotation,omitempty"`
+	Body        string             `json:"body"`
+	ColumnID    *int               `json:"columnID,omitempty"`
+	Day         int                `json:"day"`
+	Id          int                `json:"id"`
+	Keywords    *[]string          `json:"keywords,omitempty"`
+	Month       int                `json:"month"`
+	NewspaperID int                `json:"newspaperID"`
+	Stats       ArticleStats       `json:"stats"`
+	Summary     string             `json:"summary"`
+	Tags        *[]string          `json:"tags,omitempty"`
+	Year        int                `json:"year"`
+}
+
+// ArticleRevisionResponse defines model for ArticleRevisionResponse.
+type ArticleRevisionResponse struct {
+	ArticleID int       `json:"articleID"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+	Revision  int       `json:"revision"`
+}
+
+// ArticleStats defines model for ArticleStats.
+type ArticleStats struct {
+	Characters         int     `json:"characters"`
+	KanaRatio          float64 `json:"kanaRatio"`
+	KanjiRatio         float64 `json:"kanjiRatio"`
+	Paragraphs         int     `json:"paragraphs"`
+	ReadingTimeSeconds int     `json:"readingTimeSeconds"`
+	Sentences          int     `json:"sentences"`
 }
 
 // ArticleUpdateRequest defines model for ArticleUpdateRequest.
 type ArticleUpdateRequest struct {
 	Body        *string `json:"body,omitempty"`
+	ColumnID    *int    `json:"columnID"`
 	Day         *int    `json:"day,omitempty"`
 	Month       *int    `json:"month,omitempty"`
 	NewspaperID *int    `json:"newspaperID,omitempty"`
 	Year        *int    `json:"year,omitempty"`
 }
 
+// BatchErrorResponse defines model for BatchErrorResponse.
+type BatchErrorResponse struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+// BatchOperation defines model for BatchOperation.
+type BatchOperation struct {
+	Data     *map[string]interface{} `json:"data,omitempty"`
+	Id       *interface{}            `json:"id,omitempty"`
+	Method   BatchOperationMethod    `json:"method"`
+	Ref      *string                 `json:"ref,omitempty"`
+	Resource BatchOperationResource  `json:"resource"`
+}
+
+// BatchOperationMethod defines model for BatchOperation.Method.
+type BatchOperationMethod string
+
+// BatchOperationResource defines model for BatchOperation.Resource.
+type BatchOperationResource string
+
+// BatchRequest defines model for BatchRequest.
+type BatchRequest struct {
+	Operations []BatchOperation `json:"operations"`
+}
+
+// BatchResponse defines model for BatchResponse.
+type BatchResponse struct {
+	Results []BatchResult `json:"results"`
+}
+
+// BatchResult defines model for BatchResult.
+type BatchResult struct {
+	Body   *interface{} `json:"body,omitempty"`
+	Ref    *string      `json:"ref,omitempty"`
+	Status int          `json:"status"`
+}
+
+// CalendarDayResponse defines model for CalendarDayResponse.
+type CalendarDayResponse struct {
+	Articles    int  `json:"articles"`
+	Completed   bool `json:"completed"`
+	Day         int  `json:"day"`
+	Read        int  `json:"read"`
+	Transcribed int  `json:"transcribed"`
+}
+
+// CalendarResponse defines model for CalendarResponse.
+type CalendarResponse struct {
+	CompletedDays int                   `json:"completedDays"`
+	Days          []CalendarDayResponse `json:"days"`
+	Month         int                   `json:"month"`
+	NewspaperID   int                   `json:"newspaperID"`
+	Year          int                   `json:"year"`
+}
+
+// ColumnCreateRequest defines model for ColumnCreateRequest.
+type ColumnCreateRequest struct {
+	Description *string         `json:"description,omitempty"`
+	Name        string          `json:"name"`
+	NewspaperID int             `json:"newspaperID"`
+	Schedule    *ColumnSchedule `json:"schedule,omitempty"`
+	Slug        string          `json:"slug"`
+}
+
+// ColumnResponse defines model for ColumnResponse.
+type ColumnResponse struct {
+	Description string         `json:"description"`
+	Id          int            `json:"id"`
+	Name        string         `json:"name"`
+	NewspaperID int            `json:"newspaperID"`
+	Schedule    ColumnSchedule `json:"schedule"`
+	Slug        string         `json:"slug"`
+}
+
+// ColumnSchedule defines model for ColumnSchedule.
+type ColumnSchedule string
+
+// ColumnUpdateRequest defines model for ColumnUpdateRequest.
+type ColumnUpdateRequest struct {
+	Description *string         `json:"description,omitempty"`
+	Name        *string         `json:"name,omitempty"`
+	Schedule    *ColumnSchedule `json:"schedule,omitempty"`
+	Slug        *string         `json:"slug,omitempty"`
+}
+
+// DiffEdit defines model for DiffEdit.
+type DiffEdit struct {
+	Op   DiffEditOp `json:"op"`
+	Text string     `json:"text"`
+}
+
+// DiffEditOp defines model for DiffEdit.Op.
+type DiffEditOp string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// JSONPatch defines model for JSONPatch.
+type JSONPatch = []JSONPatchOperation
+
+// JSONPatchOperation defines model for JSONPatchOperation.
+type JSONPatchOperation struct {
+	From  *string              `json:"from,omitempty"`
+	Op    JSONPatchOperationOp `json:"op"`
+	Path  string               `json:"path"`
+	Value *interface{}         `json:"value,omitempty"`
+}
+
+// JSONPatchOperationOp defines model for JSONPatchOperation.Op.
+type JSONPatchOperationOp string
+
 // NewspaperCreateRequest defines model for NewspaperCreateRequest.
 type NewspaperCreateRequest struct {
-	ColumnName string `json:"columnName"`
-	Title      string `json:"title"`
+	Title string `json:"title"`
+}
+
+// NewspaperProgressResponse defines model for NewspaperProgressResponse.
+type NewspaperProgressResponse struct {
+	Articles    int    `json:"articles"`
+	Completed   int    `json:"completed"`
+	NewspaperID int    `json:"newspaperID"`
+	Read        int    `json:"read"`
+	Title       string `json:"title"`
+	Transcribed int    `json:"transcribed"`
 }
 
 // NewspaperResponse defines model for NewspaperResponse.
 type NewspaperResponse struct {
+	// Deprecated:
 	ColumnName string `json:"columnName"`
 	Id         int    `json:"id"`
 	Title      string `json:"title"`
@@ -69,8 +337,402 @@ type NewspaperResponse struct {
 
 // NewspaperUpdateRequest defines model for NewspaperUpdateRequest.
 type NewspaperUpdateRequest struct {
-	ColumnName *string `json:"columnName,omitempty"`
-	Title      *string `json:"title,omitempty"`
+	Title *string `json:"title,omitempty"`
+}
+
+// PracticeError defines model for PracticeError.
+type PracticeError struct {
+	Actual   string                `json:"actual"`
+	Category PracticeErrorCategory `json:"category"`
+	Expected string                `json:"expected"`
+	Position int                   `json:"position"`
+}
+
+// PracticeErrorCategory defines model for PracticeError.Category.
+type PracticeErrorCategory string
+
+// PracticeSessionCreateRequest defines model for PracticeSessionCreateRequest.
+type PracticeSessionCreateRequest struct {
+	DurationSeconds *int   `json:"durationSeconds,omitempty"`
+	Text            string `json:"text"`
+}
+
+// PracticeSessionResponse defines model for PracticeSessionResponse.
+type PracticeSessionResponse struct {
+	Accuracy        float64         `json:"accuracy"`
+	ArticleID       int             `json:"articleID"`
+	Correct         int             `json:"correct"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	Distance        int             `json:"distance"`
+	DurationSeconds *int            `json:"durationSeconds,omitempty"`
+	Errors          []PracticeError `json:"errors"`
+	Extra           int             `json:"extra"`
+	Id              int             `json:"id"`
+	Missing         int             `json:"missing"`
+	Substituted     int             `json:"substituted"`
+	Text            string          `json:"text"`
+}
+
+// ProgressResponse defines model for ProgressResponse.
+type ProgressResponse struct {
+	ActiveDays     int                         `json:"activeDays"`
+	Articles       int                         `json:"articles"`
+	Completed      int                         `json:"completed"`
+	CurrentStreak  int                         `json:"currentStreak"`
+	LastActiveDate *string                     `json:"lastActiveDate,omitempty"`
+	LongestStreak  int                         `json:"longestStreak"`
+	Newspapers     []NewspaperProgressResponse `json:"newspapers"`
+	Read           int                         `json:"read"`
+	Transcribed    int                         `json:"transcribed"`
+}
+
+// RelatedArticleResponse defines model for RelatedArticleResponse.
+type RelatedArticleResponse struct {
+	Article ArticleResponse `json:"article"`
+	Score   float64         `json:"score"`
+}
+
+// TagCountResponse defines model for TagCountResponse.
+type TagCountResponse struct {
+	Count int    `json:"count"`
+	Name  string `json:"name"`
+}
+
+// VocabularyEntryCreateRequest defines model for VocabularyEntryCreateRequest.
+type VocabularyEntryCreateRequest struct {
+	ArticleID *int    `json:"articleID,omitempty"`
+	Meaning   *string `json:"meaning,omitempty"`
+	Offset    *int    `json:"offset,omitempty"`
+	Reading   *string `json:"reading,omitempty"`
+	Word      string  `json:"word"`
+}
+
+// VocabularyEntryResponse defines model for VocabularyEntryResponse.
+type VocabularyEntryResponse struct {
+	ArticleID      *int       `json:"articleID,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DueDate        string     `json:"dueDate"`
+	EaseFactor     float64    `json:"easeFactor"`
+	Id             int        `json:"id"`
+	Interval       int        `json:"interval"`
+	LastReviewedAt *time.Time `json:"lastReviewedAt,omitempty"`
+	Meaning        string     `json:"meaning"`
+	Offset         *int       `json:"offset,omitempty"`
+	Reading        string     `json:"reading"`
+	Repetitions    int        `json:"repetitions"`
+	Word           string     `json:"word"`
+}
+
+// VocabularyEntryUpdateRequest defines model for VocabularyEntryUpdateRequest.
+type VocabularyEntryUpdateRequest struct {
+	Meaning *string `json:"meaning,omitempty"`
+	Reading *string `json:"reading,omitempty"`
+	Word    *string `json:"word,omitempty"`
+}
+
+// VocabularyReviewRequest defines model for VocabularyReviewRequest.
+type VocabularyReviewRequest struct {
+	Quality int `json:"quality"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	Attempts       int                           `json:"attempts"`
+	CreatedAt      time.Time                     `json:"createdAt"`
+	DeliveredAt    *time.Time                    `json:"deliveredAt,omitempty"`
+	Event          WebhookEvent                  `json:"event"`
+	Id             int                           `json:"id"`
+	LastError      string                        `json:"lastError"`
+	NextAttemptAt  *time.Time                    `json:"nextAttemptAt,omitempty"`
+	Payload        interface{}                   `json:"payload"`
+	ResponseStatus *int                          `json:"responseStatus,omitempty"`
+	Status         WebhookDeliveryResponseStatus `json:"status"`
+	SubscriptionID int                           `json:"subscriptionID"`
+}
+
+// WebhookDeliveryResponseStatus defines model for WebhookDeliveryResponse.Status.
+type WebhookDeliveryResponseStatus string
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent string
+
+// WebhookSubscriptionCreateRequest defines model for WebhookSubscriptionCreateRequest.
+type WebhookSubscriptionCreateRequest struct {
+	Events []WebhookEvent `json:"events"`
+	Secret string         `json:"secret"`
+	Url    string         `json:"url"`
+}
+
+// WebhookSubscriptionResponse defines model for WebhookSubscriptionResponse.
+type WebhookSubscriptionResponse struct {
+	Active    bool           `json:"active"`
+	CreatedAt time.Time      `json:"createdAt"`
+	Events    []WebhookEvent `json:"events"`
+	Id        int            `json:"id"`
+	Url       string         `json:"url"`
+}
+
+// WebhookSubscriptionUpdateRequest defines model for WebhookSubscriptionUpdateRequest.
+type WebhookSubscriptionUpdateRequest struct {
+	Active *bool           `json:"active,omitempty"`
+	Events *[]WebhookEvent `json:"events,omitempty"`
+	Secret *string         `json:"secret,omitempty"`
+	Url    *string         `json:"url,omitempty"`
+}
+
+// ArticleSort defines model for ArticleSort.
+type ArticleSort string
+
+// ColumnIDQuery defines model for ColumnIDQuery.
+type ColumnIDQuery = int
+
+// ExcludeQuery defines model for ExcludeQuery.
+type ExcludeQuery = []string
+
+// FeedLimit defines model for FeedLimit.
+type FeedLimit = int
+
+// FieldsQuery defines model for FieldsQuery.
+type FieldsQuery = []string
+
+// IfNoneMatchHeader defines model for IfNoneMatchHeader.
+type IfNoneMatchHeader = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// MaxCharacters defines model for MaxCharacters.
+type MaxCharacters = int
+
+// MinCharacters defines model for MinCharacters.
+type MinCharacters = int
+
+// NewspaperIDQuery defines model for NewspaperIDQuery.
+type NewspaperIDQuery = int
+
+// Offset defines model for Offset.
+type Offset = int
+
+// TagQuery defines model for TagQuery.
+type TagQuery = []string
+
+// UserIDHeader defines model for UserIDHeader.
+type UserIDHeader = string
+
+// ListArticlesParams defines parameters for ListArticles.
+type ListArticlesParams struct {
+	NewspaperID   *NewspaperIDQuery       `form:"newspaperID,omitempty" json:"newspaperID,omitempty"`
+	ColumnID      *ColumnIDQuery          `form:"columnID,omitempty" json:"columnID,omitempty"`
+	Tag           *TagQuery               `form:"tag,omitempty" json:"tag,omitempty"`
+	MinCharacters *MinCharacters          `form:"minCharacters,omitempty" json:"minCharacters,omitempty"`
+	MaxCharacters *MaxCharacters          `form:"maxCharacters,omitempty" json:"maxCharacters,omitempty"`
+	Sort          *ListArticlesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Limit         *Limit                  `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset        *Offset                 `form:"offset,omitempty" json:"offset,omitempty"`
+	Fields        *FieldsQuery            `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude       *ExcludeQuery           `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// ListArticlesParamsSort defines parameters for ListArticles.
+type ListArticlesParamsSort string
+
+// GetArticleByIdParams defines parameters for GetArticleById.
+type GetArticleByIdParams struct {
+	Annotate *GetArticleByIdParamsAnnotate `form:"annotate,omitempty" json:"annotate,omitempty"`
+	Fields   *FieldsQuery                  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude  *ExcludeQuery                 `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// GetArticleByIdParamsAnnotate defines parameters for GetArticleById.
+type GetArticleByIdParamsAnnotate string
+
+// CreatePracticeSessionParams defines parameters for CreatePracticeSession.
+type CreatePracticeSessionParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// MarkArticleReadParams defines parameters for MarkArticleRead.
+type MarkArticleReadParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// ListRelatedArticlesParams defines parameters for ListRelatedArticles.
+type ListRelatedArticlesParams struct {
+	K             *int           `form:"k,omitempty" json:"k,omitempty"`
+	SameNewspaper *bool          `form:"sameNewspaper,omitempty" json:"sameNewspaper,omitempty"`
+	Tag           *TagQuery      `form:"tag,omitempty" json:"tag,omitempty"`
+	MinCharacters *MinCharacters `form:"minCharacters,omitempty" json:"minCharacters,omitempty"`
+	MaxCharacters *MaxCharacters `form:"maxCharacters,omitempty" json:"maxCharacters,omitempty"`
+	Fields        *FieldsQuery   `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude       *ExcludeQuery  `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// ListArticleRevisionsParams defines parameters for ListArticleRevisions.
+type ListArticleRevisionsParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// GetArticleRevisionParams defines parameters for GetArticleRevision.
+type GetArticleRevisionParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// DiffArticleRevisionsParams defines parameters for DiffArticleRevisions.
+type DiffArticleRevisionsParams struct {
+	Against *int                            `form:"against,omitempty" json:"against,omitempty"`
+	Unit    *DiffArticleRevisionsParamsUnit `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// DiffArticleRevisionsParamsUnit defines parameters for DiffArticleRevisions.
+type DiffArticleRevisionsParamsUnit string
+
+// GetColumnByIdParams defines parameters for GetColumnById.
+type GetColumnByIdParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	NewspaperID *int    `form:"newspaperID,omitempty" json:"newspaperID,omitempty"`
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetAtomFeedParams defines parameters for GetAtomFeed.
+type GetAtomFeedParams struct {
+	Limit       *FeedLimit         `form:"limit,omitempty" json:"limit,omitempty"`
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
+// GetRssFeedParams defines parameters for GetRssFeed.
+type GetRssFeedParams struct {
+	Limit       *FeedLimit         `form:"limit,omitempty" json:"limit,omitempty"`
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
+// ListPracticeSessionsParams defines parameters for ListPracticeSessions.
+type ListPracticeSessionsParams struct {
+	ArticleID *int          `form:"articleID,omitempty" json:"articleID,omitempty"`
+	Limit     *Limit        `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset    *Offset       `form:"offset,omitempty" json:"offset,omitempty"`
+	Fields    *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude   *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+	XUserID   UserIDHeader  `json:"X-User-ID"`
+}
+
+// GetPracticeSessionParams defines parameters for GetPracticeSession.
+type GetPracticeSessionParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+	XUserID UserIDHeader  `json:"X-User-ID"`
+}
+
+// GetProgressParams defines parameters for GetProgress.
+type GetProgressParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// GetProgressCalendarParams defines parameters for GetProgressCalendar.
+type GetProgressCalendarParams struct {
+	Year    int          `form:"year" json:"year"`
+	Month   int          `form:"month" json:"month"`
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// ListVocabularyEntriesParams defines parameters for ListVocabularyEntries.
+type ListVocabularyEntriesParams struct {
+	ArticleID *int          `form:"articleID,omitempty" json:"articleID,omitempty"`
+	Limit     *Limit        `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset    *Offset       `form:"offset,omitempty" json:"offset,omitempty"`
+	Fields    *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude   *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+	XUserID   UserIDHeader  `json:"X-User-ID"`
+}
+
+// CreateVocabularyEntryParams defines parameters for CreateVocabularyEntry.
+type CreateVocabularyEntryParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// ListDueVocabularyEntriesParams defines parameters for ListDueVocabularyEntries.
+type ListDueVocabularyEntriesParams struct {
+	Limit   *Limit        `form:"limit,omitempty" json:"limit,omitempty"`
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+	XUserID UserIDHeader  `json:"X-User-ID"`
+}
+
+// ExportVocabularyParams defines parameters for ExportVocabulary.
+type ExportVocabularyParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// DeleteVocabularyEntryParams defines parameters for DeleteVocabularyEntry.
+type DeleteVocabularyEntryParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// GetVocabularyEntryParams defines parameters for GetVocabularyEntry.
+type GetVocabularyEntryParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+	XUserID UserIDHeader  `json:"X-User-ID"`
+}
+
+// UpdateVocabularyEntryParams defines parameters for UpdateVocabularyEntry.
+type UpdateVocabularyEntryParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// ReviewVocabularyEntryParams defines parameters for ReviewVocabularyEntry.
+type ReviewVocabularyEntryParams struct {
+	XUserID UserIDHeader `json:"X-User-ID"`
+}
+
+// GetNewspaperByIdParams defines parameters for GetNewspaperById.
+type GetNewspaperByIdParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// ListNewspaperColumnsParams defines parameters for ListNewspaperColumns.
+type ListNewspaperColumnsParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// GetNewspaperAtomFeedParams defines parameters for GetNewspaperAtomFeed.
+type GetNewspaperAtomFeedParams struct {
+	Limit       *FeedLimit         `form:"limit,omitempty" json:"limit,omitempty"`
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
+// GetNewspaperRssFeedParams defines parameters for GetNewspaperRssFeed.
+type GetNewspaperRssFeedParams struct {
+	Limit       *FeedLimit         `form:"limit,omitempty" json:"limit,omitempty"`
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
+// ListWebhookSubscriptionsParams defines parameters for ListWebhookSubscriptions.
+type ListWebhookSubscriptionsParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// GetWebhookSubscriptionParams defines parameters for GetWebhookSubscription.
+type GetWebhookSubscriptionParams struct {
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Limit   *Limit        `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset  *Offset       `form:"offset,omitempty" json:"offset,omitempty"`
+	Fields  *FieldsQuery  `form:"fields,omitempty" json:"fields,omitempty"`
+	Exclude *ExcludeQuery `form:"exclude,omitempty" json:"exclude,omitempty"`
 }
 
 // CreateArticleJSONRequestBody defines body for CreateArticle for application/json ContentType.
@@ -79,12 +741,51 @@ type CreateArticleJSONRequestBody = ArticleCreateRequest
 // UpdateArticleByIdJSONRequestBody defines body for UpdateArticleById for application/json ContentType.
 type UpdateArticleByIdJSONRequestBody = ArticleUpdateRequest
 
+// UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody defines body for UpdateArticleById for application/json-patch+json ContentType.
+type UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody defines body for UpdateArticleById for application/merge-patch+json ContentType.
+type UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody = ArticleUpdateRequest
+
+// CreatePracticeSessionJSONRequestBody defines body for CreatePracticeSession for application/json ContentType.
+type CreatePracticeSessionJSONRequestBody = PracticeSessionCreateRequest
+
+// ExecuteBatchJSONRequestBody defines body for ExecuteBatch for application/json ContentType.
+type ExecuteBatchJSONRequestBody = BatchRequest
+
+// CreateColumnJSONRequestBody defines body for CreateColumn for application/json ContentType.
+type CreateColumnJSONRequestBody = ColumnCreateRequest
+
+// UpdateColumnByIdJSONRequestBody defines body for UpdateColumnById for application/json ContentType.
+type UpdateColumnByIdJSONRequestBody = ColumnUpdateRequest
+
+// CreateVocabularyEntryJSONRequestBody defines body for CreateVocabularyEntry for application/json ContentType.
+type CreateVocabularyEntryJSONRequestBody = VocabularyEntryCreateRequest
+
+// UpdateVocabularyEntryJSONRequestBody defines body for UpdateVocabularyEntry for application/json ContentType.
+type UpdateVocabularyEntryJSONRequestBody = VocabularyEntryUpdateRequest
+
+// ReviewVocabularyEntryJSONRequestBody defines body for ReviewVocabularyEntry for application/json ContentType.
+type ReviewVocabularyEntryJSONRequestBody = VocabularyReviewRequest
+
 // CreateNewspaperJSONRequestBody defines body for CreateNewspaper for application/json ContentType.
 type CreateNewspaperJSONRequestBody = NewspaperCreateRequest
 
 // UpdateNewspaperByIdJSONRequestBody defines body for UpdateNewspaperById for application/json ContentType.
 type UpdateNewspaperByIdJSONRequestBody = NewspaperUpdateRequest
 
+// UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody defines body for UpdateNewspaperById for application/json-patch+json ContentType.
+type UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody = JSONPatch
+
+// UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody defines body for UpdateNewspaperById for application/merge-patch+json ContentType.
+type UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody = NewspaperUpdateRequest
+
+// CreateWebhookSubscriptionJSONRequestBody defines body for CreateWebhookSubscription for application/json ContentType.
+type CreateWebhookSubscriptionJSONRequestBody = WebhookSubscriptionCreateRequest
+
+// UpdateWebhookSubscriptionJSONRequestBody defines body for UpdateWebhookSubscription for application/json ContentType.
+type UpdateWebhookSubscriptionJSONRequestBody = WebhookSubscriptionUpdateRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -158,6 +859,9 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListArticles request
+	ListArticles(ctx context.Context, params *ListArticlesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateArticleWithBody request with any body
 	CreateArticleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -167,13 +871,118 @@ type ClientInterface interface {
 	DeleteArticleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetArticleById request
-	GetArticleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetArticleById(ctx context.Context, id int, params *GetArticleByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateArticleByIdWithBody request with any body
 	UpdateArticleByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateArticleById(ctx context.Context, id int, body UpdateArticleByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	UpdateArticleByIdWithApplicationJSONPatchPlusJSONBody(ctx context.Context, id int, body UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateArticleByIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int, body UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePracticeSessionWithBody request with any body
+	CreatePracticeSessionWithBody(ctx context.Context, id int, params *CreatePracticeSessionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePracticeSession(ctx context.Context, id int, params *CreatePracticeSessionParams, body CreatePracticeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MarkArticleRead request
+	MarkArticleRead(ctx context.Context, id int, params *MarkArticleReadParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRelatedArticles request
+	ListRelatedArticles(ctx context.Context, id int, params *ListRelatedArticlesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListArticleRevisions request
+	ListArticleRevisions(ctx context.Context, id int, params *ListArticleRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetArticleRevision request
+	GetArticleRevision(ctx context.Context, id int, rev int, params *GetArticleRevisionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DiffArticleRevisions request
+	DiffArticleRevisions(ctx context.Context, id int, rev int, params *DiffArticleRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreArticleRevision request
+	RestoreArticleRevision(ctx context.Context, id int, rev int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UntagArticle request
+	UntagArticle(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TagArticle request
+	TagArticle(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExecuteBatchWithBody request with any body
+	ExecuteBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ExecuteBatch(ctx context.Context, body ExecuteBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateColumnWithBody request with any body
+	CreateColumnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateColumn(ctx context.Context, body CreateColumnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteColumnById request
+	DeleteColumnById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetColumnById request
+	GetColumnById(ctx context.Context, id int, params *GetColumnByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateColumnByIdWithBody request with any body
+	UpdateColumnByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateColumnById(ctx context.Context, id int, body UpdateColumnByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAtomFeed request
+	GetAtomFeed(ctx context.Context, params *GetAtomFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRssFeed request
+	GetRssFeed(ctx context.Context, params *GetRssFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPracticeSessions request
+	ListPracticeSessions(ctx context.Context, params *ListPracticeSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPracticeSession request
+	GetPracticeSession(ctx context.Context, id int, params *GetPracticeSessionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProgress request
+	GetProgress(ctx context.Context, params *GetProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProgressCalendar request
+	GetProgressCalendar(ctx context.Context, id int, params *GetProgressCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListVocabularyEntries request
+	ListVocabularyEntries(ctx context.Context, params *ListVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateVocabularyEntryWithBody request with any body
+	CreateVocabularyEntryWithBody(ctx context.Context, params *CreateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateVocabularyEntry(ctx context.Context, params *CreateVocabularyEntryParams, body CreateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDueVocabularyEntries request
+	ListDueVocabularyEntries(ctx context.Context, params *ListDueVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportVocabulary request
+	ExportVocabulary(ctx context.Context, params *ExportVocabularyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteVocabularyEntry request
+	DeleteVocabularyEntry(ctx context.Context, id int, params *DeleteVocabularyEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVocabularyEntry request
+	GetVocabularyEntry(ctx context.Context, id int, params *GetVocabularyEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateVocabularyEntryWithBody request with any body
+	UpdateVocabularyEntryWithBody(ctx context.Context, id int, params *UpdateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateVocabularyEntry(ctx context.Context, id int, params *UpdateVocabularyEntryParams, body UpdateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReviewVocabularyEntryWithBody request with any body
+	ReviewVocabularyEntryWithBody(ctx context.Context, id int, params *ReviewVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReviewVocabularyEntry(ctx context.Context, id int, params *ReviewVocabularyEntryParams, body ReviewVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CreateNewspaperWithBody request with any body
 	CreateNewspaperWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -183,16 +992,57 @@ type ClientInterface interface {
 	DeleteNewspaperById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetNewspaperById request
-	GetNewspaperById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetNewspaperById(ctx context.Context, id int, params *GetNewspaperByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateNewspaperByIdWithBody request with any body
 	UpdateNewspaperByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateNewspaperById(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNewspaperByIdWithApplicationJSONPatchPlusJSONBody(ctx context.Context, id int, body UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNewspaperByIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int, body UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNewspaperColumns request
+	ListNewspaperColumns(ctx context.Context, id int, params *ListNewspaperColumnsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNewspaperAtomFeed request
+	GetNewspaperAtomFeed(ctx context.Context, id int, params *GetNewspaperAtomFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNewspaperRssFeed request
+	GetNewspaperRssFeed(ctx context.Context, id int, params *GetNewspaperRssFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListNewspaperTags request
+	ListNewspaperTags(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookSubscriptions request
+	ListWebhookSubscriptions(ctx context.Context, params *ListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookSubscriptionWithBody request with any body
+	CreateWebhookSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhookSubscription(ctx context.Context, body CreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhookSubscription request
+	DeleteWebhookSubscription(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookSubscription request
+	GetWebhookSubscription(ctx context.Context, id int, params *GetWebhookSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookSubscriptionWithBody request with any body
+	UpdateWebhookSubscriptionWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhookSubscription(ctx context.Context, id int, body UpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListWebhookDeliveries request
+	ListWebhookDeliveries(ctx context.Context, id int, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RedeliverWebhook request
+	RedeliverWebhook(ctx context.Context, id int, deliveryID int, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) CreateArticleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateArticleRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListArticles(ctx context.Context, params *ListArticlesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListArticlesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -203,8 +1053,20 @@ func (c *Client) CreateArticleWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateArticle(ctx context.Context, body CreateArticleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateArticleRequest(c.Server, body)
+func (c *Client) CreateArticleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateArticleRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateArticle(ctx context.Context, body CreateArticleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateArticleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +1089,8 @@ func (c *Client) DeleteArticleById(ctx context.Context, id int, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetArticleById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetArticleByIdRequest(c.Server, id)
+func (c *Client) GetArticleById(ctx context.Context, id int, params *GetArticleByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArticleByIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +1125,8 @@ func (c *Client) UpdateArticleById(ctx context.Context, id int, body UpdateArtic
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateNewspaperWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateNewspaperRequestWithBody(c.Server, contentType, body)
+func (c *Client) UpdateArticleByIdWithApplicationJSONPatchPlusJSONBody(ctx context.Context, id int, body UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateArticleByIdRequestWithApplicationJSONPatchPlusJSONBody(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +1137,8 @@ func (c *Client) CreateNewspaperWithBody(ctx context.Context, contentType string
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateNewspaper(ctx context.Context, body CreateNewspaperJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateNewspaperRequest(c.Server, body)
+func (c *Client) UpdateArticleByIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int, body UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateArticleByIdRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +1149,8 @@ func (c *Client) CreateNewspaper(ctx context.Context, body CreateNewspaperJSONRe
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteNewspaperById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteNewspaperByIdRequest(c.Server, id)
+func (c *Client) CreatePracticeSessionWithBody(ctx context.Context, id int, params *CreatePracticeSessionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePracticeSessionRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -299,8 +1161,8 @@ func (c *Client) DeleteNewspaperById(ctx context.Context, id int, reqEditors ...
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetNewspaperById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetNewspaperByIdRequest(c.Server, id)
+func (c *Client) CreatePracticeSession(ctx context.Context, id int, params *CreatePracticeSessionParams, body CreatePracticeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePracticeSessionRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +1173,8 @@ func (c *Client) GetNewspaperById(ctx context.Context, id int, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateNewspaperByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateNewspaperByIdRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) MarkArticleRead(ctx context.Context, id int, params *MarkArticleReadParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMarkArticleReadRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -323,8 +1185,8 @@ func (c *Client) UpdateNewspaperByIdWithBody(ctx context.Context, id int, conten
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateNewspaperById(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateNewspaperByIdRequest(c.Server, id, body)
+func (c *Client) ListRelatedArticles(ctx context.Context, id int, params *ListRelatedArticlesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRelatedArticlesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -335,1015 +1197,9639 @@ func (c *Client) UpdateNewspaperById(ctx context.Context, id int, body UpdateNew
 	return c.Client.Do(req)
 }
 
-// NewCreateArticleRequest calls the generic CreateArticle builder with application/json body
-func NewCreateArticleRequest(server string, body CreateArticleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListArticleRevisions(ctx context.Context, id int, params *ListArticleRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListArticleRevisionsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateArticleRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateArticleRequestWithBody generates requests for CreateArticle with any type of body
-func NewCreateArticleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetArticleRevision(ctx context.Context, id int, rev int, params *GetArticleRevisionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetArticleRevisionRequest(c.Server, id, rev, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/article")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DiffArticleRevisions(ctx context.Context, id int, rev int, params *DiffArticleRevisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffArticleRevisionsRequest(c.Server, id, rev, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteArticleByIdRequest generates requests for DeleteArticleById
-func NewDeleteArticleByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) RestoreArticleRevision(ctx context.Context, id int, rev int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreArticleRevisionRequest(c.Server, id, rev)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/article/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UntagArticle(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUntagArticleRequest(c.Server, id, tag)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetArticleByIdRequest generates requests for GetArticleById
-func NewGetArticleByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) TagArticle(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTagArticleRequest(c.Server, id, tag)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ExecuteBatchWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteBatchRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/article/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ExecuteBatch(ctx context.Context, body ExecuteBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExecuteBatchRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateArticleByIdRequest calls the generic UpdateArticleById builder with application/json body
-func NewUpdateArticleByIdRequest(server string, id int, body UpdateArticleByIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CreateColumnWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateColumnRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateArticleByIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateArticleByIdRequestWithBody generates requests for UpdateArticleById with any type of body
-func NewUpdateArticleByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateColumn(ctx context.Context, body CreateColumnJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateColumnRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteColumnById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteColumnByIdRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/article/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetColumnById(ctx context.Context, id int, params *GetColumnByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetColumnByIdRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateNewspaperRequest calls the generic CreateNewspaper builder with application/json body
-func NewCreateNewspaperRequest(server string, body CreateNewspaperJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateColumnByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateColumnByIdRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateNewspaperRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateNewspaperRequestWithBody generates requests for CreateNewspaper with any type of body
-func NewCreateNewspaperRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateColumnById(ctx context.Context, id int, body UpdateColumnByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateColumnByIdRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/newspaper")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteNewspaperByIdRequest generates requests for DeleteNewspaperById
-func NewDeleteNewspaperByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetAtomFeed(ctx context.Context, params *GetAtomFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAtomFeedRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetRssFeed(ctx context.Context, params *GetRssFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRssFeedRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/newspaper/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListPracticeSessions(ctx context.Context, params *ListPracticeSessionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPracticeSessionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetNewspaperByIdRequest generates requests for GetNewspaperById
-func NewGetNewspaperByIdRequest(server string, id int) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetPracticeSession(ctx context.Context, id int, params *GetPracticeSessionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPracticeSessionRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetProgress(ctx context.Context, params *GetProgressParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProgressRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/newspaper/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetProgressCalendar(ctx context.Context, id int, params *GetProgressCalendarParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProgressCalendarRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateNewspaperByIdRequest calls the generic UpdateNewspaperById builder with application/json body
-func NewUpdateNewspaperByIdRequest(server string, id int, body UpdateNewspaperByIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListVocabularyEntries(ctx context.Context, params *ListVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListVocabularyEntriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateNewspaperByIdRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateNewspaperByIdRequestWithBody generates requests for UpdateNewspaperById with any type of body
-func NewUpdateNewspaperByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateVocabularyEntryWithBody(ctx context.Context, params *CreateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVocabularyEntryRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateVocabularyEntry(ctx context.Context, params *CreateVocabularyEntryParams, body CreateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateVocabularyEntryRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/newspaper/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListDueVocabularyEntries(ctx context.Context, params *ListDueVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDueVocabularyEntriesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+func (c *Client) ExportVocabulary(ctx context.Context, params *ExportVocabularyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportVocabularyRequest(c.Server, params)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return nil
+	return c.Client.Do(req)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+func (c *Client) DeleteVocabularyEntry(ctx context.Context, id int, params *DeleteVocabularyEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteVocabularyEntryRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+func (c *Client) GetVocabularyEntry(ctx context.Context, id int, params *GetVocabularyEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVocabularyEntryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+func (c *Client) UpdateVocabularyEntryWithBody(ctx context.Context, id int, params *UpdateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVocabularyEntryRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// CreateArticleWithBodyWithResponse request with any body
-	CreateArticleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error)
-
-	CreateArticleWithResponse(ctx context.Context, body CreateArticleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error)
-
-	// DeleteArticleByIdWithResponse request
-	DeleteArticleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteArticleByIdResponse, error)
-
-	// GetArticleByIdWithResponse request
-	GetArticleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetArticleByIdResponse, error)
-
-	// UpdateArticleByIdWithBodyWithResponse request with any body
-	UpdateArticleByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error)
-
-	UpdateArticleByIdWithResponse(ctx context.Context, id int, body UpdateArticleByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error)
-
-	// CreateNewspaperWithBodyWithResponse request with any body
-	CreateNewspaperWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error)
-
-	CreateNewspaperWithResponse(ctx context.Context, body CreateNewspaperJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error)
-
-	// DeleteNewspaperByIdWithResponse request
-	DeleteNewspaperByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteNewspaperByIdResponse, error)
-
-	// GetNewspaperByIdWithResponse request
-	GetNewspaperByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetNewspaperByIdResponse, error)
-
-	// UpdateNewspaperByIdWithBodyWithResponse request with any body
-	UpdateNewspaperByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error)
-
-	UpdateNewspaperByIdWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error)
+func (c *Client) UpdateVocabularyEntry(ctx context.Context, id int, params *UpdateVocabularyEntryParams, body UpdateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateVocabularyEntryRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type CreateArticleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ArticleResponse
-	JSON400      *ErrorResponse
+func (c *Client) ReviewVocabularyEntryWithBody(ctx context.Context, id int, params *ReviewVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewVocabularyEntryRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateArticleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ReviewVocabularyEntry(ctx context.Context, id int, params *ReviewVocabularyEntryParams, body ReviewVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReviewVocabularyEntryRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateArticleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) CreateNewspaperWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNewspaperRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type DeleteArticleByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+func (c *Client) CreateNewspaper(ctx context.Context, body CreateNewspaperJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNewspaperRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteArticleByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) DeleteNewspaperById(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNewspaperByIdRequest(c.Server, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteArticleByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *Client) GetNewspaperById(ctx context.Context, id int, params *GetNewspaperByIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNewspaperByIdRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-type GetArticleByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ArticleResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+func (c *Client) UpdateNewspaperByIdWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNewspaperByIdRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetArticleByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UpdateNewspaperById(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNewspaperByIdRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetArticleByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
-
-type UpdateArticleByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ArticleResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-}
-
-// Status returns HTTPResponse.Status
-func (r UpdateArticleByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UpdateNewspaperByIdWithApplicationJSONPatchPlusJSONBody(ctx context.Context, id int, body UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNewspaperByIdRequestWithApplicationJSONPatchPlusJSONBody(c.Server, id, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateArticleByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type CreateNewspaperResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *NewspaperResponse
-	JSON400      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateNewspaperResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) UpdateNewspaperByIdWithApplicationMergePatchPlusJSONBody(ctx context.Context, id int, body UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNewspaperByIdRequestWithApplicationMergePatchPlusJSONBody(c.Server, id, body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateNewspaperResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type DeleteNewspaperByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteNewspaperByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) ListNewspaperColumns(ctx context.Context, id int, params *ListNewspaperColumnsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNewspaperColumnsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteNewspaperByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type GetNewspaperByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NewspaperResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetNewspaperByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetNewspaperAtomFeed(ctx context.Context, id int, params *GetNewspaperAtomFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNewspaperAtomFeedRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetNewspaperByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
-}
-
-type UpdateNewspaperByIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *NewspaperResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
+	return c.Client.Do(req)
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateNewspaperByIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *Client) GetNewspaperRssFeed(ctx context.Context, id int, params *GetNewspaperRssFeedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNewspaperRssFeedRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
-
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateNewspaperByIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-	return 0
+	return c.Client.Do(req)
 }
 
-// CreateArticleWithBodyWithResponse request with arbitrary body returning *CreateArticleResponse
-func (c *ClientWithResponses) CreateArticleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error) {
-	rsp, err := c.CreateArticleWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) ListNewspaperTags(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNewspaperTagsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateArticleResponse(rsp)
-}
-
-func (c *ClientWithResponses) CreateArticleWithResponse(ctx context.Context, body CreateArticleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error) {
-	rsp, err := c.CreateArticle(ctx, body, reqEditors...)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-	return ParseCreateArticleResponse(rsp)
+	return c.Client.Do(req)
 }
 
-// DeleteArticleByIdWithResponse request returning *DeleteArticleByIdResponse
-func (c *ClientWithResponses) DeleteArticleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteArticleByIdResponse, error) {
-	rsp, err := c.DeleteArticleById(ctx, id, reqEditors...)
+func (c *Client) ListWebhookSubscriptions(ctx context.Context, params *ListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookSubscriptionsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteArticleByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// GetArticleByIdWithResponse request returning *GetArticleByIdResponse
-func (c *ClientWithResponses) GetArticleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetArticleByIdResponse, error) {
-	rsp, err := c.GetArticleById(ctx, id, reqEditors...)
+func (c *Client) CreateWebhookSubscriptionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookSubscriptionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetArticleByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// UpdateArticleByIdWithBodyWithResponse request with arbitrary body returning *UpdateArticleByIdResponse
-func (c *ClientWithResponses) UpdateArticleByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error) {
-	rsp, err := c.UpdateArticleByIdWithBody(ctx, id, contentType, body, reqEditors...)
+func (c *Client) CreateWebhookSubscription(ctx context.Context, body CreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookSubscriptionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateArticleByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) UpdateArticleByIdWithResponse(ctx context.Context, id int, body UpdateArticleByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error) {
-	rsp, err := c.UpdateArticleById(ctx, id, body, reqEditors...)
+func (c *Client) DeleteWebhookSubscription(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookSubscriptionRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateArticleByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// CreateNewspaperWithBodyWithResponse request with arbitrary body returning *CreateNewspaperResponse
-func (c *ClientWithResponses) CreateNewspaperWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error) {
-	rsp, err := c.CreateNewspaperWithBody(ctx, contentType, body, reqEditors...)
+func (c *Client) GetWebhookSubscription(ctx context.Context, id int, params *GetWebhookSubscriptionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookSubscriptionRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateNewspaperResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) CreateNewspaperWithResponse(ctx context.Context, body CreateNewspaperJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error) {
-	rsp, err := c.CreateNewspaper(ctx, body, reqEditors...)
+func (c *Client) UpdateWebhookSubscriptionWithBody(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookSubscriptionRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateNewspaperResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// DeleteNewspaperByIdWithResponse request returning *DeleteNewspaperByIdResponse
-func (c *ClientWithResponses) DeleteNewspaperByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteNewspaperByIdResponse, error) {
-	rsp, err := c.DeleteNewspaperById(ctx, id, reqEditors...)
+func (c *Client) UpdateWebhookSubscription(ctx context.Context, id int, body UpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookSubscriptionRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteNewspaperByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// GetNewspaperByIdWithResponse request returning *GetNewspaperByIdResponse
-func (c *ClientWithResponses) GetNewspaperByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*GetNewspaperByIdResponse, error) {
-	rsp, err := c.GetNewspaperById(ctx, id, reqEditors...)
+func (c *Client) ListWebhookDeliveries(ctx context.Context, id int, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWebhookDeliveriesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetNewspaperByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// UpdateNewspaperByIdWithBodyWithResponse request with arbitrary body returning *UpdateNewspaperByIdResponse
-func (c *ClientWithResponses) UpdateNewspaperByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error) {
-	rsp, err := c.UpdateNewspaperByIdWithBody(ctx, id, contentType, body, reqEditors...)
+func (c *Client) RedeliverWebhook(ctx context.Context, id int, deliveryID int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedeliverWebhookRequest(c.Server, id, deliveryID)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateNewspaperByIdResponse(rsp)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-func (c *ClientWithResponses) UpdateNewspaperByIdWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error) {
-	rsp, err := c.UpdateNewspaperById(ctx, id, body, reqEditors...)
+// NewListArticlesRequest generates requests for ListArticles
+func NewListArticlesRequest(server string, params *ListArticlesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateNewspaperByIdResponse(rsp)
-}
 
-// ParseCreateArticleResponse parses an HTTP response from a CreateArticleWithResponse call
-func ParseCreateArticleResponse(rsp *http.Response) (*CreateArticleResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	operationPath := fmt.Sprintf("/article")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateArticleResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ArticleResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+		if params.NewspaperID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "newspaperID", runtime.ParamLocationQuery, *params.NewspaperID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
 		}
-		response.JSON400 = &dest
 
+		if params.ColumnID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "columnID", runtime.ParamLocationQuery, *params.ColumnID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinCharacters != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minCharacters", runtime.ParamLocationQuery, *params.MinCharacters); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxCharacters != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxCharacters", runtime.ParamLocationQuery, *params.MaxCharacters); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateArticleRequest calls the generic CreateArticle builder with application/json body
+func NewCreateArticleRequest(server string, body CreateArticleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateArticleRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateArticleRequestWithBody generates requests for CreateArticle with any type of body
+func NewCreateArticleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteArticleByIdRequest generates requests for DeleteArticleById
+func NewDeleteArticleByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetArticleByIdRequest generates requests for GetArticleById
+func NewGetArticleByIdRequest(server string, id int, params *GetArticleByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Annotate != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "annotate", runtime.ParamLocationQuery, *params.Annotate); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateArticleByIdRequest calls the generic UpdateArticleById builder with application/json body
+func NewUpdateArticleByIdRequest(server string, id int, body UpdateArticleByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateArticleByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateArticleByIdRequestWithApplicationJSONPatchPlusJSONBody calls the generic UpdateArticleById builder with application/json-patch+json body
+func NewUpdateArticleByIdRequestWithApplicationJSONPatchPlusJSONBody(server string, id int, body UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateArticleByIdRequestWithBody(server, id, "application/json-patch+json", bodyReader)
+}
+
+// NewUpdateArticleByIdRequestWithApplicationMergePatchPlusJSONBody calls the generic UpdateArticleById builder with application/merge-patch+json body
+func NewUpdateArticleByIdRequestWithApplicationMergePatchPlusJSONBody(server string, id int, body UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateArticleByIdRequestWithBody(server, id, "application/merge-patch+json", bodyReader)
+}
+
+// NewUpdateArticleByIdRequestWithBody generates requests for UpdateArticleById with any type of body
+func NewUpdateArticleByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreatePracticeSessionRequest calls the generic CreatePracticeSession builder with application/json body
+func NewCreatePracticeSessionRequest(server string, id int, params *CreatePracticeSessionParams, body CreatePracticeSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreatePracticeSessionRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewCreatePracticeSessionRequestWithBody generates requests for CreatePracticeSession with any type of body
+func NewCreatePracticeSessionRequestWithBody(server string, id int, params *CreatePracticeSessionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/practice-sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewMarkArticleReadRequest generates requests for MarkArticleRead
+func NewMarkArticleReadRequest(server string, id int, params *MarkArticleReadParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewListRelatedArticlesRequest generates requests for ListRelatedArticles
+func NewListRelatedArticlesRequest(server string, id int, params *ListRelatedArticlesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/related", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.K != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "k", runtime.ParamLocationQuery, *params.K); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SameNewspaper != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sameNewspaper", runtime.ParamLocationQuery, *params.SameNewspaper); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tag != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tag", runtime.ParamLocationQuery, *params.Tag); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinCharacters != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minCharacters", runtime.ParamLocationQuery, *params.MinCharacters); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxCharacters != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxCharacters", runtime.ParamLocationQuery, *params.MaxCharacters); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListArticleRevisionsRequest generates requests for ListArticleRevisions
+func NewListArticleRevisionsRequest(server string, id int, params *ListArticleRevisionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/revisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetArticleRevisionRequest generates requests for GetArticleRevision
+func NewGetArticleRevisionRequest(server string, id int, rev int, params *GetArticleRevisionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rev", runtime.ParamLocationPath, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/revisions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDiffArticleRevisionsRequest generates requests for DiffArticleRevisions
+func NewDiffArticleRevisionsRequest(server string, id int, rev int, params *DiffArticleRevisionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rev", runtime.ParamLocationPath, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/revisions/%s/diff", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Against != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "against", runtime.ParamLocationQuery, *params.Against); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Unit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unit", runtime.ParamLocationQuery, *params.Unit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreArticleRevisionRequest generates requests for RestoreArticleRevision
+func NewRestoreArticleRevisionRequest(server string, id int, rev int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "rev", runtime.ParamLocationPath, rev)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/revisions/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUntagArticleRequest generates requests for UntagArticle
+func NewUntagArticleRequest(server string, id int, tag string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTagArticleRequest generates requests for TagArticle
+func NewTagArticleRequest(server string, id int, tag string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tag", runtime.ParamLocationPath, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/article/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExecuteBatchRequest calls the generic ExecuteBatch builder with application/json body
+func NewExecuteBatchRequest(server string, body ExecuteBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewExecuteBatchRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewExecuteBatchRequestWithBody generates requests for ExecuteBatch with any type of body
+func NewExecuteBatchRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/batch")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateColumnRequest calls the generic CreateColumn builder with application/json body
+func NewCreateColumnRequest(server string, body CreateColumnJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateColumnRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateColumnRequestWithBody generates requests for CreateColumn with any type of body
+func NewCreateColumnRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/column")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteColumnByIdRequest generates requests for DeleteColumnById
+func NewDeleteColumnByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/column/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetColumnByIdRequest generates requests for GetColumnById
+func NewGetColumnByIdRequest(server string, id int, params *GetColumnByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/column/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateColumnByIdRequest calls the generic UpdateColumnById builder with application/json body
+func NewUpdateColumnByIdRequest(server string, id int, body UpdateColumnByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateColumnByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateColumnByIdRequestWithBody generates requests for UpdateColumnById with any type of body
+func NewUpdateColumnByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/column/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.NewspaperID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "newspaperID", runtime.ParamLocationQuery, *params.NewspaperID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAtomFeedRequest generates requests for GetAtomFeed
+func NewGetAtomFeedRequest(server string, params *GetAtomFeedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/feed.atom")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetRssFeedRequest generates requests for GetRssFeed
+func NewGetRssFeedRequest(server string, params *GetRssFeedParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/feed.rss")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListPracticeSessionsRequest generates requests for ListPracticeSessions
+func NewListPracticeSessionsRequest(server string, params *ListPracticeSessionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/practice-sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ArticleID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "articleID", runtime.ParamLocationQuery, *params.ArticleID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetPracticeSessionRequest generates requests for GetPracticeSession
+func NewGetPracticeSessionRequest(server string, id int, params *GetPracticeSessionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/practice-sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetProgressRequest generates requests for GetProgress
+func NewGetProgressRequest(server string, params *GetProgressParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/progress")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetProgressCalendarRequest generates requests for GetProgressCalendar
+func NewGetProgressCalendarRequest(server string, id int, params *GetProgressCalendarParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/progress/newspaper/%s/calendar", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "year", runtime.ParamLocationQuery, params.Year); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "month", runtime.ParamLocationQuery, params.Month); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewListVocabularyEntriesRequest generates requests for ListVocabularyEntries
+func NewListVocabularyEntriesRequest(server string, params *ListVocabularyEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ArticleID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "articleID", runtime.ParamLocationQuery, *params.ArticleID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewCreateVocabularyEntryRequest calls the generic CreateVocabularyEntry builder with application/json body
+func NewCreateVocabularyEntryRequest(server string, params *CreateVocabularyEntryParams, body CreateVocabularyEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateVocabularyEntryRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateVocabularyEntryRequestWithBody generates requests for CreateVocabularyEntry with any type of body
+func NewCreateVocabularyEntryRequestWithBody(server string, params *CreateVocabularyEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewListDueVocabularyEntriesRequest generates requests for ListDueVocabularyEntries
+func NewListDueVocabularyEntriesRequest(server string, params *ListDueVocabularyEntriesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary/due")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewExportVocabularyRequest generates requests for ExportVocabulary
+func NewExportVocabularyRequest(server string, params *ExportVocabularyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteVocabularyEntryRequest generates requests for DeleteVocabularyEntry
+func NewDeleteVocabularyEntryRequest(server string, id int, params *DeleteVocabularyEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetVocabularyEntryRequest generates requests for GetVocabularyEntry
+func NewGetVocabularyEntryRequest(server string, id int, params *GetVocabularyEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateVocabularyEntryRequest calls the generic UpdateVocabularyEntry builder with application/json body
+func NewUpdateVocabularyEntryRequest(server string, id int, params *UpdateVocabularyEntryParams, body UpdateVocabularyEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateVocabularyEntryRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateVocabularyEntryRequestWithBody generates requests for UpdateVocabularyEntry with any type of body
+func NewUpdateVocabularyEntryRequestWithBody(server string, id int, params *UpdateVocabularyEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewReviewVocabularyEntryRequest calls the generic ReviewVocabularyEntry builder with application/json body
+func NewReviewVocabularyEntryRequest(server string, id int, params *ReviewVocabularyEntryParams, body ReviewVocabularyEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReviewVocabularyEntryRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewReviewVocabularyEntryRequestWithBody generates requests for ReviewVocabularyEntry with any type of body
+func NewReviewVocabularyEntryRequestWithBody(server string, id int, params *ReviewVocabularyEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/vocabulary/%s/review", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-User-ID", runtime.ParamLocationHeader, params.XUserID)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-User-ID", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewCreateNewspaperRequest calls the generic CreateNewspaper builder with application/json body
+func NewCreateNewspaperRequest(server string, body CreateNewspaperJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateNewspaperRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateNewspaperRequestWithBody generates requests for CreateNewspaper with any type of body
+func NewCreateNewspaperRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteNewspaperByIdRequest generates requests for DeleteNewspaperById
+func NewDeleteNewspaperByIdRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNewspaperByIdRequest generates requests for GetNewspaperById
+func NewGetNewspaperByIdRequest(server string, id int, params *GetNewspaperByIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNewspaperByIdRequest calls the generic UpdateNewspaperById builder with application/json body
+func NewUpdateNewspaperByIdRequest(server string, id int, body UpdateNewspaperByIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNewspaperByIdRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateNewspaperByIdRequestWithApplicationJSONPatchPlusJSONBody calls the generic UpdateNewspaperById builder with application/json-patch+json body
+func NewUpdateNewspaperByIdRequestWithApplicationJSONPatchPlusJSONBody(server string, id int, body UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNewspaperByIdRequestWithBody(server, id, "application/json-patch+json", bodyReader)
+}
+
+// NewUpdateNewspaperByIdRequestWithApplicationMergePatchPlusJSONBody calls the generic UpdateNewspaperById builder with application/merge-patch+json body
+func NewUpdateNewspaperByIdRequestWithApplicationMergePatchPlusJSONBody(server string, id int, body UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNewspaperByIdRequestWithBody(server, id, "application/merge-patch+json", bodyReader)
+}
+
+// NewUpdateNewspaperByIdRequestWithBody generates requests for UpdateNewspaperById with any type of body
+func NewUpdateNewspaperByIdRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListNewspaperColumnsRequest generates requests for ListNewspaperColumns
+func NewListNewspaperColumnsRequest(server string, id int, params *ListNewspaperColumnsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s/columns", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNewspaperAtomFeedRequest generates requests for GetNewspaperAtomFeed
+func NewGetNewspaperAtomFeedRequest(server string, id int, params *GetNewspaperAtomFeedParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s/feed.atom", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetNewspaperRssFeedRequest generates requests for GetNewspaperRssFeed
+func NewGetNewspaperRssFeedRequest(server string, id int, params *GetNewspaperRssFeedParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s/feed.rss", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListNewspaperTagsRequest generates requests for ListNewspaperTags
+func NewListNewspaperTagsRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/newspaper/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWebhookSubscriptionsRequest generates requests for ListWebhookSubscriptions
+func NewListWebhookSubscriptionsRequest(server string, params *ListWebhookSubscriptionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookSubscriptionRequest calls the generic CreateWebhookSubscription builder with application/json body
+func NewCreateWebhookSubscriptionRequest(server string, body CreateWebhookSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookSubscriptionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateWebhookSubscriptionRequestWithBody generates requests for CreateWebhookSubscription with any type of body
+func NewCreateWebhookSubscriptionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookSubscriptionRequest generates requests for DeleteWebhookSubscription
+func NewDeleteWebhookSubscriptionRequest(server string, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookSubscriptionRequest generates requests for GetWebhookSubscription
+func NewGetWebhookSubscriptionRequest(server string, id int, params *GetWebhookSubscriptionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookSubscriptionRequest calls the generic UpdateWebhookSubscription builder with application/json body
+func NewUpdateWebhookSubscriptionRequest(server string, id int, body UpdateWebhookSubscriptionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookSubscriptionRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookSubscriptionRequestWithBody generates requests for UpdateWebhookSubscription with any type of body
+func NewUpdateWebhookSubscriptionRequestWithBody(server string, id int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListWebhookDeliveriesRequest generates requests for ListWebhookDeliveries
+func NewListWebhookDeliveriesRequest(server string, id int, params *ListWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Exclude != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "exclude", runtime.ParamLocationQuery, *params.Exclude); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRedeliverWebhookRequest generates requests for RedeliverWebhook
+func NewRedeliverWebhookRequest(server string, id int, deliveryID int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deliveryID", runtime.ParamLocationPath, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries/%s/redeliver", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListArticlesWithResponse request
+	ListArticlesWithResponse(ctx context.Context, params *ListArticlesParams, reqEditors ...RequestEditorFn) (*ListArticlesResponse, error)
+
+	// CreateArticleWithBodyWithResponse request with any body
+	CreateArticleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error)
+
+	CreateArticleWithResponse(ctx context.Context, body CreateArticleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error)
+
+	// DeleteArticleByIdWithResponse request
+	DeleteArticleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteArticleByIdResponse, error)
+
+	// GetArticleByIdWithResponse request
+	GetArticleByIdWithResponse(ctx context.Context, id int, params *GetArticleByIdParams, reqEditors ...RequestEditorFn) (*GetArticleByIdResponse, error)
+
+	// UpdateArticleByIdWithBodyWithResponse request with any body
+	UpdateArticleByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error)
+
+	UpdateArticleByIdWithResponse(ctx context.Context, id int, body UpdateArticleByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error)
+
+	UpdateArticleByIdWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error)
+
+	UpdateArticleByIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error)
+
+	// CreatePracticeSessionWithBodyWithResponse request with any body
+	CreatePracticeSessionWithBodyWithResponse(ctx context.Context, id int, params *CreatePracticeSessionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePracticeSessionResponse, error)
+
+	CreatePracticeSessionWithResponse(ctx context.Context, id int, params *CreatePracticeSessionParams, body CreatePracticeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePracticeSessionResponse, error)
+
+	// MarkArticleReadWithResponse request
+	MarkArticleReadWithResponse(ctx context.Context, id int, params *MarkArticleReadParams, reqEditors ...RequestEditorFn) (*MarkArticleReadResponse, error)
+
+	// ListRelatedArticlesWithResponse request
+	ListRelatedArticlesWithResponse(ctx context.Context, id int, params *ListRelatedArticlesParams, reqEditors ...RequestEditorFn) (*ListRelatedArticlesResponse, error)
+
+	// ListArticleRevisionsWithResponse request
+	ListArticleRevisionsWithResponse(ctx context.Context, id int, params *ListArticleRevisionsParams, reqEditors ...RequestEditorFn) (*ListArticleRevisionsResponse, error)
+
+	// GetArticleRevisionWithResponse request
+	GetArticleRevisionWithResponse(ctx context.Context, id int, rev int, params *GetArticleRevisionParams, reqEditors ...RequestEditorFn) (*GetArticleRevisionResponse, error)
+
+	// DiffArticleRevisionsWithResponse request
+	DiffArticleRevisionsWithResponse(ctx context.Context, id int, rev int, params *DiffArticleRevisionsParams, reqEditors ...RequestEditorFn) (*DiffArticleRevisionsResponse, error)
+
+	// RestoreArticleRevisionWithResponse request
+	RestoreArticleRevisionWithResponse(ctx context.Context, id int, rev int, reqEditors ...RequestEditorFn) (*RestoreArticleRevisionResponse, error)
+
+	// UntagArticleWithResponse request
+	UntagArticleWithResponse(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*UntagArticleResponse, error)
+
+	// TagArticleWithResponse request
+	TagArticleWithResponse(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*TagArticleResponse, error)
+
+	// ExecuteBatchWithBodyWithResponse request with any body
+	ExecuteBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteBatchResponse, error)
+
+	ExecuteBatchWithResponse(ctx context.Context, body ExecuteBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteBatchResponse, error)
+
+	// CreateColumnWithBodyWithResponse request with any body
+	CreateColumnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateColumnResponse, error)
+
+	CreateColumnWithResponse(ctx context.Context, body CreateColumnJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateColumnResponse, error)
+
+	// DeleteColumnByIdWithResponse request
+	DeleteColumnByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteColumnByIdResponse, error)
+
+	// GetColumnByIdWithResponse request
+	GetColumnByIdWithResponse(ctx context.Context, id int, params *GetColumnByIdParams, reqEditors ...RequestEditorFn) (*GetColumnByIdResponse, error)
+
+	// UpdateColumnByIdWithBodyWithResponse request with any body
+	UpdateColumnByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateColumnByIdResponse, error)
+
+	UpdateColumnByIdWithResponse(ctx context.Context, id int, body UpdateColumnByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateColumnByIdResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// GetAtomFeedWithResponse request
+	GetAtomFeedWithResponse(ctx context.Context, params *GetAtomFeedParams, reqEditors ...RequestEditorFn) (*GetAtomFeedResponse, error)
+
+	// GetRssFeedWithResponse request
+	GetRssFeedWithResponse(ctx context.Context, params *GetRssFeedParams, reqEditors ...RequestEditorFn) (*GetRssFeedResponse, error)
+
+	// ListPracticeSessionsWithResponse request
+	ListPracticeSessionsWithResponse(ctx context.Context, params *ListPracticeSessionsParams, reqEditors ...RequestEditorFn) (*ListPracticeSessionsResponse, error)
+
+	// GetPracticeSessionWithResponse request
+	GetPracticeSessionWithResponse(ctx context.Context, id int, params *GetPracticeSessionParams, reqEditors ...RequestEditorFn) (*GetPracticeSessionResponse, error)
+
+	// GetProgressWithResponse request
+	GetProgressWithResponse(ctx context.Context, params *GetProgressParams, reqEditors ...RequestEditorFn) (*GetProgressResponse, error)
+
+	// GetProgressCalendarWithResponse request
+	GetProgressCalendarWithResponse(ctx context.Context, id int, params *GetProgressCalendarParams, reqEditors ...RequestEditorFn) (*GetProgressCalendarResponse, error)
+
+	// ListVocabularyEntriesWithResponse request
+	ListVocabularyEntriesWithResponse(ctx context.Context, params *ListVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*ListVocabularyEntriesResponse, error)
+
+	// CreateVocabularyEntryWithBodyWithResponse request with any body
+	CreateVocabularyEntryWithBodyWithResponse(ctx context.Context, params *CreateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVocabularyEntryResponse, error)
+
+	CreateVocabularyEntryWithResponse(ctx context.Context, params *CreateVocabularyEntryParams, body CreateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVocabularyEntryResponse, error)
+
+	// ListDueVocabularyEntriesWithResponse request
+	ListDueVocabularyEntriesWithResponse(ctx context.Context, params *ListDueVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*ListDueVocabularyEntriesResponse, error)
+
+	// ExportVocabularyWithResponse request
+	ExportVocabularyWithResponse(ctx context.Context, params *ExportVocabularyParams, reqEditors ...RequestEditorFn) (*ExportVocabularyResponse, error)
+
+	// DeleteVocabularyEntryWithResponse request
+	DeleteVocabularyEntryWithResponse(ctx context.Context, id int, params *DeleteVocabularyEntryParams, reqEditors ...RequestEditorFn) (*DeleteVocabularyEntryResponse, error)
+
+	// GetVocabularyEntryWithResponse request
+	GetVocabularyEntryWithResponse(ctx context.Context, id int, params *GetVocabularyEntryParams, reqEditors ...RequestEditorFn) (*GetVocabularyEntryResponse, error)
+
+	// UpdateVocabularyEntryWithBodyWithResponse request with any body
+	UpdateVocabularyEntryWithBodyWithResponse(ctx context.Context, id int, params *UpdateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVocabularyEntryResponse, error)
+
+	UpdateVocabularyEntryWithResponse(ctx context.Context, id int, params *UpdateVocabularyEntryParams, body UpdateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVocabularyEntryResponse, error)
+
+	// ReviewVocabularyEntryWithBodyWithResponse request with any body
+	ReviewVocabularyEntryWithBodyWithResponse(ctx context.Context, id int, params *ReviewVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewVocabularyEntryResponse, error)
+
+	ReviewVocabularyEntryWithResponse(ctx context.Context, id int, params *ReviewVocabularyEntryParams, body ReviewVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewVocabularyEntryResponse, error)
+
+	// CreateNewspaperWithBodyWithResponse request with any body
+	CreateNewspaperWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error)
+
+	CreateNewspaperWithResponse(ctx context.Context, body CreateNewspaperJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error)
+
+	// DeleteNewspaperByIdWithResponse request
+	DeleteNewspaperByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteNewspaperByIdResponse, error)
+
+	// GetNewspaperByIdWithResponse request
+	GetNewspaperByIdWithResponse(ctx context.Context, id int, params *GetNewspaperByIdParams, reqEditors ...RequestEditorFn) (*GetNewspaperByIdResponse, error)
+
+	// UpdateNewspaperByIdWithBodyWithResponse request with any body
+	UpdateNewspaperByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error)
+
+	UpdateNewspaperByIdWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error)
+
+	UpdateNewspaperByIdWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error)
+
+	UpdateNewspaperByIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error)
+
+	// ListNewspaperColumnsWithResponse request
+	ListNewspaperColumnsWithResponse(ctx context.Context, id int, params *ListNewspaperColumnsParams, reqEditors ...RequestEditorFn) (*ListNewspaperColumnsResponse, error)
+
+	// GetNewspaperAtomFeedWithResponse request
+	GetNewspaperAtomFeedWithResponse(ctx context.Context, id int, params *GetNewspaperAtomFeedParams, reqEditors ...RequestEditorFn) (*GetNewspaperAtomFeedResponse, error)
+
+	// GetNewspaperRssFeedWithResponse request
+	GetNewspaperRssFeedWithResponse(ctx context.Context, id int, params *GetNewspaperRssFeedParams, reqEditors ...RequestEditorFn) (*GetNewspaperRssFeedResponse, error)
+
+	// ListNewspaperTagsWithResponse request
+	ListNewspaperTagsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*ListNewspaperTagsResponse, error)
+
+	// ListWebhookSubscriptionsWithResponse request
+	ListWebhookSubscriptionsWithResponse(ctx context.Context, params *ListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*ListWebhookSubscriptionsResponse, error)
+
+	// CreateWebhookSubscriptionWithBodyWithResponse request with any body
+	CreateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookSubscriptionResponse, error)
+
+	CreateWebhookSubscriptionWithResponse(ctx context.Context, body CreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookSubscriptionResponse, error)
+
+	// DeleteWebhookSubscriptionWithResponse request
+	DeleteWebhookSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteWebhookSubscriptionResponse, error)
+
+	// GetWebhookSubscriptionWithResponse request
+	GetWebhookSubscriptionWithResponse(ctx context.Context, id int, params *GetWebhookSubscriptionParams, reqEditors ...RequestEditorFn) (*GetWebhookSubscriptionResponse, error)
+
+	// UpdateWebhookSubscriptionWithBodyWithResponse request with any body
+	UpdateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookSubscriptionResponse, error)
+
+	UpdateWebhookSubscriptionWithResponse(ctx context.Context, id int, body UpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookSubscriptionResponse, error)
+
+	// ListWebhookDeliveriesWithResponse request
+	ListWebhookDeliveriesWithResponse(ctx context.Context, id int, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error)
+
+	// RedeliverWebhookWithResponse request
+	RedeliverWebhookWithResponse(ctx context.Context, id int, deliveryID int, reqEditors ...RequestEditorFn) (*RedeliverWebhookResponse, error)
+}
+
+type ListArticlesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ArticleResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListArticlesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListArticlesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateArticleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ArticleResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateArticleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateArticleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteArticleByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteArticleByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteArticleByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetArticleByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArticleByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArticleByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateArticleByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateArticleByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateArticleByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreatePracticeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PracticeSessionResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreatePracticeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreatePracticeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MarkArticleReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r MarkArticleReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MarkArticleReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRelatedArticlesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RelatedArticleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRelatedArticlesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRelatedArticlesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListArticleRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ArticleRevisionResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListArticleRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListArticleRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetArticleRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleRevisionResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetArticleRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetArticleRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffArticleRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleDiffResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffArticleRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffArticleRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreArticleRevisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreArticleRevisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreArticleRevisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UntagArticleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UntagArticleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UntagArticleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TagArticleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ArticleResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TagArticleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TagArticleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExecuteBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchResponse
+	JSON400      *BatchErrorResponse
+	JSON404      *BatchErrorResponse
+	JSON500      *BatchErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExecuteBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExecuteBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateColumnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ColumnResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateColumnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateColumnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteColumnByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteColumnByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteColumnByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetColumnByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ColumnResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetColumnByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetColumnByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateColumnByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ColumnResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateColumnByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateColumnByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAtomFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAtomFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAtomFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRssFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRssFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRssFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPracticeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PracticeSessionResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPracticeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPracticeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPracticeSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PracticeSessionResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPracticeSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPracticeSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProgressResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProgressCalendarResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CalendarResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProgressCalendarResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProgressCalendarResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListVocabularyEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VocabularyEntryResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListVocabularyEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListVocabularyEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateVocabularyEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *VocabularyEntryResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateVocabularyEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateVocabularyEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDueVocabularyEntriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]VocabularyEntryResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDueVocabularyEntriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDueVocabularyEntriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportVocabularyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportVocabularyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportVocabularyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteVocabularyEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteVocabularyEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteVocabularyEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVocabularyEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VocabularyEntryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVocabularyEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVocabularyEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateVocabularyEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VocabularyEntryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateVocabularyEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateVocabularyEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReviewVocabularyEntryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VocabularyEntryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReviewVocabularyEntryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReviewVocabularyEntryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateNewspaperResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *NewspaperResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateNewspaperResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateNewspaperResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNewspaperByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNewspaperByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNewspaperByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNewspaperByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NewspaperResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNewspaperByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNewspaperByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNewspaperByIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NewspaperResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNewspaperByIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNewspaperByIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNewspaperColumnsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ColumnResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNewspaperColumnsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNewspaperColumnsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNewspaperAtomFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNewspaperAtomFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNewspaperAtomFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNewspaperRssFeedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNewspaperRssFeedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNewspaperRssFeedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNewspaperTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TagCountResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNewspaperTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNewspaperTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookSubscriptionResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookSubscriptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookSubscriptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WebhookSubscriptionResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSubscriptionResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDeliveryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RedeliverWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *WebhookDeliveryResponse
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RedeliverWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RedeliverWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListArticlesWithResponse request returning *ListArticlesResponse
+func (c *ClientWithResponses) ListArticlesWithResponse(ctx context.Context, params *ListArticlesParams, reqEditors ...RequestEditorFn) (*ListArticlesResponse, error) {
+	rsp, err := c.ListArticles(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListArticlesResponse(rsp)
+}
+
+// CreateArticleWithBodyWithResponse request with arbitrary body returning *CreateArticleResponse
+func (c *ClientWithResponses) CreateArticleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error) {
+	rsp, err := c.CreateArticleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateArticleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateArticleWithResponse(ctx context.Context, body CreateArticleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateArticleResponse, error) {
+	rsp, err := c.CreateArticle(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateArticleResponse(rsp)
+}
+
+// DeleteArticleByIdWithResponse request returning *DeleteArticleByIdResponse
+func (c *ClientWithResponses) DeleteArticleByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteArticleByIdResponse, error) {
+	rsp, err := c.DeleteArticleById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteArticleByIdResponse(rsp)
+}
+
+// GetArticleByIdWithResponse request returning *GetArticleByIdResponse
+func (c *ClientWithResponses) GetArticleByIdWithResponse(ctx context.Context, id int, params *GetArticleByIdParams, reqEditors ...RequestEditorFn) (*GetArticleByIdResponse, error) {
+	rsp, err := c.GetArticleById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArticleByIdResponse(rsp)
+}
+
+// UpdateArticleByIdWithBodyWithResponse request with arbitrary body returning *UpdateArticleByIdResponse
+func (c *ClientWithResponses) UpdateArticleByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error) {
+	rsp, err := c.UpdateArticleByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateArticleByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateArticleByIdWithResponse(ctx context.Context, id int, body UpdateArticleByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error) {
+	rsp, err := c.UpdateArticleById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateArticleByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateArticleByIdWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateArticleByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error) {
+	rsp, err := c.UpdateArticleByIdWithApplicationJSONPatchPlusJSONBody(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateArticleByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateArticleByIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateArticleByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateArticleByIdResponse, error) {
+	rsp, err := c.UpdateArticleByIdWithApplicationMergePatchPlusJSONBody(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateArticleByIdResponse(rsp)
+}
+
+// CreatePracticeSessionWithBodyWithResponse request with arbitrary body returning *CreatePracticeSessionResponse
+func (c *ClientWithResponses) CreatePracticeSessionWithBodyWithResponse(ctx context.Context, id int, params *CreatePracticeSessionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePracticeSessionResponse, error) {
+	rsp, err := c.CreatePracticeSessionWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePracticeSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePracticeSessionWithResponse(ctx context.Context, id int, params *CreatePracticeSessionParams, body CreatePracticeSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePracticeSessionResponse, error) {
+	rsp, err := c.CreatePracticeSession(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePracticeSessionResponse(rsp)
+}
+
+// MarkArticleReadWithResponse request returning *MarkArticleReadResponse
+func (c *ClientWithResponses) MarkArticleReadWithResponse(ctx context.Context, id int, params *MarkArticleReadParams, reqEditors ...RequestEditorFn) (*MarkArticleReadResponse, error) {
+	rsp, err := c.MarkArticleRead(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMarkArticleReadResponse(rsp)
+}
+
+// ListRelatedArticlesWithResponse request returning *ListRelatedArticlesResponse
+func (c *ClientWithResponses) ListRelatedArticlesWithResponse(ctx context.Context, id int, params *ListRelatedArticlesParams, reqEditors ...RequestEditorFn) (*ListRelatedArticlesResponse, error) {
+	rsp, err := c.ListRelatedArticles(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRelatedArticlesResponse(rsp)
+}
+
+// ListArticleRevisionsWithResponse request returning *ListArticleRevisionsResponse
+func (c *ClientWithResponses) ListArticleRevisionsWithResponse(ctx context.Context, id int, params *ListArticleRevisionsParams, reqEditors ...RequestEditorFn) (*ListArticleRevisionsResponse, error) {
+	rsp, err := c.ListArticleRevisions(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListArticleRevisionsResponse(rsp)
+}
+
+// GetArticleRevisionWithResponse request returning *GetArticleRevisionResponse
+func (c *ClientWithResponses) GetArticleRevisionWithResponse(ctx context.Context, id int, rev int, params *GetArticleRevisionParams, reqEditors ...RequestEditorFn) (*GetArticleRevisionResponse, error) {
+	rsp, err := c.GetArticleRevision(ctx, id, rev, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetArticleRevisionResponse(rsp)
+}
+
+// DiffArticleRevisionsWithResponse request returning *DiffArticleRevisionsResponse
+func (c *ClientWithResponses) DiffArticleRevisionsWithResponse(ctx context.Context, id int, rev int, params *DiffArticleRevisionsParams, reqEditors ...RequestEditorFn) (*DiffArticleRevisionsResponse, error) {
+	rsp, err := c.DiffArticleRevisions(ctx, id, rev, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffArticleRevisionsResponse(rsp)
+}
+
+// RestoreArticleRevisionWithResponse request returning *RestoreArticleRevisionResponse
+func (c *ClientWithResponses) RestoreArticleRevisionWithResponse(ctx context.Context, id int, rev int, reqEditors ...RequestEditorFn) (*RestoreArticleRevisionResponse, error) {
+	rsp, err := c.RestoreArticleRevision(ctx, id, rev, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreArticleRevisionResponse(rsp)
+}
+
+// UntagArticleWithResponse request returning *UntagArticleResponse
+func (c *ClientWithResponses) UntagArticleWithResponse(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*UntagArticleResponse, error) {
+	rsp, err := c.UntagArticle(ctx, id, tag, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUntagArticleResponse(rsp)
+}
+
+// TagArticleWithResponse request returning *TagArticleResponse
+func (c *ClientWithResponses) TagArticleWithResponse(ctx context.Context, id int, tag string, reqEditors ...RequestEditorFn) (*TagArticleResponse, error) {
+	rsp, err := c.TagArticle(ctx, id, tag, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTagArticleResponse(rsp)
+}
+
+// ExecuteBatchWithBodyWithResponse request with arbitrary body returning *ExecuteBatchResponse
+func (c *ClientWithResponses) ExecuteBatchWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ExecuteBatchResponse, error) {
+	rsp, err := c.ExecuteBatchWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) ExecuteBatchWithResponse(ctx context.Context, body ExecuteBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*ExecuteBatchResponse, error) {
+	rsp, err := c.ExecuteBatch(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExecuteBatchResponse(rsp)
+}
+
+// CreateColumnWithBodyWithResponse request with arbitrary body returning *CreateColumnResponse
+func (c *ClientWithResponses) CreateColumnWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateColumnResponse, error) {
+	rsp, err := c.CreateColumnWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateColumnResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateColumnWithResponse(ctx context.Context, body CreateColumnJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateColumnResponse, error) {
+	rsp, err := c.CreateColumn(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateColumnResponse(rsp)
+}
+
+// DeleteColumnByIdWithResponse request returning *DeleteColumnByIdResponse
+func (c *ClientWithResponses) DeleteColumnByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteColumnByIdResponse, error) {
+	rsp, err := c.DeleteColumnById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteColumnByIdResponse(rsp)
+}
+
+// GetColumnByIdWithResponse request returning *GetColumnByIdResponse
+func (c *ClientWithResponses) GetColumnByIdWithResponse(ctx context.Context, id int, params *GetColumnByIdParams, reqEditors ...RequestEditorFn) (*GetColumnByIdResponse, error) {
+	rsp, err := c.GetColumnById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetColumnByIdResponse(rsp)
+}
+
+// UpdateColumnByIdWithBodyWithResponse request with arbitrary body returning *UpdateColumnByIdResponse
+func (c *ClientWithResponses) UpdateColumnByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateColumnByIdResponse, error) {
+	rsp, err := c.UpdateColumnByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateColumnByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateColumnByIdWithResponse(ctx context.Context, id int, body UpdateColumnByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateColumnByIdResponse, error) {
+	rsp, err := c.UpdateColumnById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateColumnByIdResponse(rsp)
+}
+
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// GetAtomFeedWithResponse request returning *GetAtomFeedResponse
+func (c *ClientWithResponses) GetAtomFeedWithResponse(ctx context.Context, params *GetAtomFeedParams, reqEditors ...RequestEditorFn) (*GetAtomFeedResponse, error) {
+	rsp, err := c.GetAtomFeed(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAtomFeedResponse(rsp)
+}
+
+// GetRssFeedWithResponse request returning *GetRssFeedResponse
+func (c *ClientWithResponses) GetRssFeedWithResponse(ctx context.Context, params *GetRssFeedParams, reqEditors ...RequestEditorFn) (*GetRssFeedResponse, error) {
+	rsp, err := c.GetRssFeed(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRssFeedResponse(rsp)
+}
+
+// ListPracticeSessionsWithResponse request returning *ListPracticeSessionsResponse
+func (c *ClientWithResponses) ListPracticeSessionsWithResponse(ctx context.Context, params *ListPracticeSessionsParams, reqEditors ...RequestEditorFn) (*ListPracticeSessionsResponse, error) {
+	rsp, err := c.ListPracticeSessions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPracticeSessionsResponse(rsp)
+}
+
+// GetPracticeSessionWithResponse request returning *GetPracticeSessionResponse
+func (c *ClientWithResponses) GetPracticeSessionWithResponse(ctx context.Context, id int, params *GetPracticeSessionParams, reqEditors ...RequestEditorFn) (*GetPracticeSessionResponse, error) {
+	rsp, err := c.GetPracticeSession(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPracticeSessionResponse(rsp)
+}
+
+// GetProgressWithResponse request returning *GetProgressResponse
+func (c *ClientWithResponses) GetProgressWithResponse(ctx context.Context, params *GetProgressParams, reqEditors ...RequestEditorFn) (*GetProgressResponse, error) {
+	rsp, err := c.GetProgress(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProgressResponse(rsp)
+}
+
+// GetProgressCalendarWithResponse request returning *GetProgressCalendarResponse
+func (c *ClientWithResponses) GetProgressCalendarWithResponse(ctx context.Context, id int, params *GetProgressCalendarParams, reqEditors ...RequestEditorFn) (*GetProgressCalendarResponse, error) {
+	rsp, err := c.GetProgressCalendar(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProgressCalendarResponse(rsp)
+}
+
+// ListVocabularyEntriesWithResponse request returning *ListVocabularyEntriesResponse
+func (c *ClientWithResponses) ListVocabularyEntriesWithResponse(ctx context.Context, params *ListVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*ListVocabularyEntriesResponse, error) {
+	rsp, err := c.ListVocabularyEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListVocabularyEntriesResponse(rsp)
+}
+
+// CreateVocabularyEntryWithBodyWithResponse request with arbitrary body returning *CreateVocabularyEntryResponse
+func (c *ClientWithResponses) CreateVocabularyEntryWithBodyWithResponse(ctx context.Context, params *CreateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateVocabularyEntryResponse, error) {
+	rsp, err := c.CreateVocabularyEntryWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVocabularyEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateVocabularyEntryWithResponse(ctx context.Context, params *CreateVocabularyEntryParams, body CreateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateVocabularyEntryResponse, error) {
+	rsp, err := c.CreateVocabularyEntry(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateVocabularyEntryResponse(rsp)
+}
+
+// ListDueVocabularyEntriesWithResponse request returning *ListDueVocabularyEntriesResponse
+func (c *ClientWithResponses) ListDueVocabularyEntriesWithResponse(ctx context.Context, params *ListDueVocabularyEntriesParams, reqEditors ...RequestEditorFn) (*ListDueVocabularyEntriesResponse, error) {
+	rsp, err := c.ListDueVocabularyEntries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDueVocabularyEntriesResponse(rsp)
+}
+
+// ExportVocabularyWithResponse request returning *ExportVocabularyResponse
+func (c *ClientWithResponses) ExportVocabularyWithResponse(ctx context.Context, params *ExportVocabularyParams, reqEditors ...RequestEditorFn) (*ExportVocabularyResponse, error) {
+	rsp, err := c.ExportVocabulary(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportVocabularyResponse(rsp)
+}
+
+// DeleteVocabularyEntryWithResponse request returning *DeleteVocabularyEntryResponse
+func (c *ClientWithResponses) DeleteVocabularyEntryWithResponse(ctx context.Context, id int, params *DeleteVocabularyEntryParams, reqEditors ...RequestEditorFn) (*DeleteVocabularyEntryResponse, error) {
+	rsp, err := c.DeleteVocabularyEntry(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteVocabularyEntryResponse(rsp)
+}
+
+// GetVocabularyEntryWithResponse request returning *GetVocabularyEntryResponse
+func (c *ClientWithResponses) GetVocabularyEntryWithResponse(ctx context.Context, id int, params *GetVocabularyEntryParams, reqEditors ...RequestEditorFn) (*GetVocabularyEntryResponse, error) {
+	rsp, err := c.GetVocabularyEntry(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVocabularyEntryResponse(rsp)
+}
+
+// UpdateVocabularyEntryWithBodyWithResponse request with arbitrary body returning *UpdateVocabularyEntryResponse
+func (c *ClientWithResponses) UpdateVocabularyEntryWithBodyWithResponse(ctx context.Context, id int, params *UpdateVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateVocabularyEntryResponse, error) {
+	rsp, err := c.UpdateVocabularyEntryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVocabularyEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateVocabularyEntryWithResponse(ctx context.Context, id int, params *UpdateVocabularyEntryParams, body UpdateVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateVocabularyEntryResponse, error) {
+	rsp, err := c.UpdateVocabularyEntry(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateVocabularyEntryResponse(rsp)
+}
+
+// ReviewVocabularyEntryWithBodyWithResponse request with arbitrary body returning *ReviewVocabularyEntryResponse
+func (c *ClientWithResponses) ReviewVocabularyEntryWithBodyWithResponse(ctx context.Context, id int, params *ReviewVocabularyEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReviewVocabularyEntryResponse, error) {
+	rsp, err := c.ReviewVocabularyEntryWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewVocabularyEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReviewVocabularyEntryWithResponse(ctx context.Context, id int, params *ReviewVocabularyEntryParams, body ReviewVocabularyEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*ReviewVocabularyEntryResponse, error) {
+	rsp, err := c.ReviewVocabularyEntry(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReviewVocabularyEntryResponse(rsp)
+}
+
+// CreateNewspaperWithBodyWithResponse request with arbitrary body returning *CreateNewspaperResponse
+func (c *ClientWithResponses) CreateNewspaperWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error) {
+	rsp, err := c.CreateNewspaperWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNewspaperResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateNewspaperWithResponse(ctx context.Context, body CreateNewspaperJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNewspaperResponse, error) {
+	rsp, err := c.CreateNewspaper(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNewspaperResponse(rsp)
+}
+
+// DeleteNewspaperByIdWithResponse request returning *DeleteNewspaperByIdResponse
+func (c *ClientWithResponses) DeleteNewspaperByIdWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteNewspaperByIdResponse, error) {
+	rsp, err := c.DeleteNewspaperById(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNewspaperByIdResponse(rsp)
+}
+
+// GetNewspaperByIdWithResponse request returning *GetNewspaperByIdResponse
+func (c *ClientWithResponses) GetNewspaperByIdWithResponse(ctx context.Context, id int, params *GetNewspaperByIdParams, reqEditors ...RequestEditorFn) (*GetNewspaperByIdResponse, error) {
+	rsp, err := c.GetNewspaperById(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNewspaperByIdResponse(rsp)
+}
+
+// UpdateNewspaperByIdWithBodyWithResponse request with arbitrary body returning *UpdateNewspaperByIdResponse
+func (c *ClientWithResponses) UpdateNewspaperByIdWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error) {
+	rsp, err := c.UpdateNewspaperByIdWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNewspaperByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNewspaperByIdWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error) {
+	rsp, err := c.UpdateNewspaperById(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNewspaperByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNewspaperByIdWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error) {
+	rsp, err := c.UpdateNewspaperByIdWithApplicationJSONPatchPlusJSONBody(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNewspaperByIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNewspaperByIdWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, id int, body UpdateNewspaperByIdApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNewspaperByIdResponse, error) {
+	rsp, err := c.UpdateNewspaperByIdWithApplicationMergePatchPlusJSONBody(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNewspaperByIdResponse(rsp)
+}
+
+// ListNewspaperColumnsWithResponse request returning *ListNewspaperColumnsResponse
+func (c *ClientWithResponses) ListNewspaperColumnsWithResponse(ctx context.Context, id int, params *ListNewspaperColumnsParams, reqEditors ...RequestEditorFn) (*ListNewspaperColumnsResponse, error) {
+	rsp, err := c.ListNewspaperColumns(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNewspaperColumnsResponse(rsp)
+}
+
+// GetNewspaperAtomFeedWithResponse request returning *GetNewspaperAtomFeedResponse
+func (c *ClientWithResponses) GetNewspaperAtomFeedWithResponse(ctx context.Context, id int, params *GetNewspaperAtomFeedParams, reqEditors ...RequestEditorFn) (*GetNewspaperAtomFeedResponse, error) {
+	rsp, err := c.GetNewspaperAtomFeed(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNewspaperAtomFeedResponse(rsp)
+}
+
+// GetNewspaperRssFeedWithResponse request returning *GetNewspaperRssFeedResponse
+func (c *ClientWithResponses) GetNewspaperRssFeedWithResponse(ctx context.Context, id int, params *GetNewspaperRssFeedParams, reqEditors ...RequestEditorFn) (*GetNewspaperRssFeedResponse, error) {
+	rsp, err := c.GetNewspaperRssFeed(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNewspaperRssFeedResponse(rsp)
+}
+
+// ListNewspaperTagsWithResponse request returning *ListNewspaperTagsResponse
+func (c *ClientWithResponses) ListNewspaperTagsWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*ListNewspaperTagsResponse, error) {
+	rsp, err := c.ListNewspaperTags(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNewspaperTagsResponse(rsp)
+}
+
+// ListWebhookSubscriptionsWithResponse request returning *ListWebhookSubscriptionsResponse
+func (c *ClientWithResponses) ListWebhookSubscriptionsWithResponse(ctx context.Context, params *ListWebhookSubscriptionsParams, reqEditors ...RequestEditorFn) (*ListWebhookSubscriptionsResponse, error) {
+	rsp, err := c.ListWebhookSubscriptions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookSubscriptionsResponse(rsp)
+}
+
+// CreateWebhookSubscriptionWithBodyWithResponse request with arbitrary body returning *CreateWebhookSubscriptionResponse
+func (c *ClientWithResponses) CreateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookSubscriptionResponse, error) {
+	rsp, err := c.CreateWebhookSubscriptionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookSubscriptionWithResponse(ctx context.Context, body CreateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookSubscriptionResponse, error) {
+	rsp, err := c.CreateWebhookSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookSubscriptionResponse(rsp)
+}
+
+// DeleteWebhookSubscriptionWithResponse request returning *DeleteWebhookSubscriptionResponse
+func (c *ClientWithResponses) DeleteWebhookSubscriptionWithResponse(ctx context.Context, id int, reqEditors ...RequestEditorFn) (*DeleteWebhookSubscriptionResponse, error) {
+	rsp, err := c.DeleteWebhookSubscription(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookSubscriptionResponse(rsp)
+}
+
+// GetWebhookSubscriptionWithResponse request returning *GetWebhookSubscriptionResponse
+func (c *ClientWithResponses) GetWebhookSubscriptionWithResponse(ctx context.Context, id int, params *GetWebhookSubscriptionParams, reqEditors ...RequestEditorFn) (*GetWebhookSubscriptionResponse, error) {
+	rsp, err := c.GetWebhookSubscription(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookSubscriptionResponse(rsp)
+}
+
+// UpdateWebhookSubscriptionWithBodyWithResponse request with arbitrary body returning *UpdateWebhookSubscriptionResponse
+func (c *ClientWithResponses) UpdateWebhookSubscriptionWithBodyWithResponse(ctx context.Context, id int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookSubscriptionResponse, error) {
+	rsp, err := c.UpdateWebhookSubscriptionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookSubscriptionResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateWebhookSubscriptionWithResponse(ctx context.Context, id int, body UpdateWebhookSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookSubscriptionResponse, error) {
+	rsp, err := c.UpdateWebhookSubscription(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateWebhookSubscriptionResponse(rsp)
+}
+
+// ListWebhookDeliveriesWithResponse request returning *ListWebhookDeliveriesResponse
+func (c *ClientWithResponses) ListWebhookDeliveriesWithResponse(ctx context.Context, id int, params *ListWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*ListWebhookDeliveriesResponse, error) {
+	rsp, err := c.ListWebhookDeliveries(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWebhookDeliveriesResponse(rsp)
+}
+
+// RedeliverWebhookWithResponse request returning *RedeliverWebhookResponse
+func (c *ClientWithResponses) RedeliverWebhookWithResponse(ctx context.Context, id int, deliveryID int, reqEditors ...RequestEditorFn) (*RedeliverWebhookResponse, error) {
+	rsp, err := c.RedeliverWebhook(ctx, id, deliveryID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedeliverWebhookResponse(rsp)
+}
+
+// ParseListArticlesResponse parses an HTTP response from a ListArticlesWithResponse call
+func ParseListArticlesResponse(rsp *http.Response) (*ListArticlesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListArticlesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateArticleResponse parses an HTTP response from a CreateArticleWithResponse call
+func ParseCreateArticleResponse(rsp *http.Response) (*CreateArticleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateArticleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteArticleByIdResponse parses an HTTP response from a DeleteArticleByIdWithResponse call
+func ParseDeleteArticleByIdResponse(rsp *http.Response) (*DeleteArticleByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteArticleByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetArticleByIdResponse parses an HTTP response from a GetArticleByIdWithResponse call
+func ParseGetArticleByIdResponse(rsp *http.Response) (*GetArticleByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetArticleByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateArticleByIdResponse parses an HTTP response from a UpdateArticleByIdWithResponse call
+func ParseUpdateArticleByIdResponse(rsp *http.Response) (*UpdateArticleByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateArticleByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreatePracticeSessionResponse parses an HTTP response from a CreatePracticeSessionWithResponse call
+func ParseCreatePracticeSessionResponse(rsp *http.Response) (*CreatePracticeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreatePracticeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PracticeSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMarkArticleReadResponse parses an HTTP response from a MarkArticleReadWithResponse call
+func ParseMarkArticleReadResponse(rsp *http.Response) (*MarkArticleReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MarkArticleReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRelatedArticlesResponse parses an HTTP response from a ListRelatedArticlesWithResponse call
+func ParseListRelatedArticlesResponse(rsp *http.Response) (*ListRelatedArticlesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRelatedArticlesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RelatedArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListArticleRevisionsResponse parses an HTTP response from a ListArticleRevisionsWithResponse call
+func ParseListArticleRevisionsResponse(rsp *http.Response) (*ListArticleRevisionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListArticleRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ArticleRevisionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetArticleRevisionResponse parses an HTTP response from a GetArticleRevisionWithResponse call
+func ParseGetArticleRevisionResponse(rsp *http.Response) (*GetArticleRevisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetArticleRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleRevisionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDiffArticleRevisionsResponse parses an HTTP response from a DiffArticleRevisionsWithResponse call
+func ParseDiffArticleRevisionsResponse(rsp *http.Response) (*DiffArticleRevisionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiffArticleRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleDiffResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreArticleRevisionResponse parses an HTTP response from a RestoreArticleRevisionWithResponse call
+func ParseRestoreArticleRevisionResponse(rsp *http.Response) (*RestoreArticleRevisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreArticleRevisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUntagArticleResponse parses an HTTP response from a UntagArticleWithResponse call
+func ParseUntagArticleResponse(rsp *http.Response) (*UntagArticleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UntagArticleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTagArticleResponse parses an HTTP response from a TagArticleWithResponse call
+func ParseTagArticleResponse(rsp *http.Response) (*TagArticleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TagArticleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ArticleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExecuteBatchResponse parses an HTTP response from a ExecuteBatchWithResponse call
+func ParseExecuteBatchResponse(rsp *http.Response) (*ExecuteBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExecuteBatchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BatchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BatchErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest BatchErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest BatchErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateColumnResponse parses an HTTP response from a CreateColumnWithResponse call
+func ParseCreateColumnResponse(rsp *http.Response) (*CreateColumnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateColumnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ColumnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteColumnByIdResponse parses an HTTP response from a DeleteColumnByIdWithResponse call
+func ParseDeleteColumnByIdResponse(rsp *http.Response) (*DeleteColumnByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteColumnByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetColumnByIdResponse parses an HTTP response from a GetColumnByIdWithResponse call
+func ParseGetColumnByIdResponse(rsp *http.Response) (*GetColumnByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetColumnByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ColumnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateColumnByIdResponse parses an HTTP response from a UpdateColumnByIdWithResponse call
+func ParseUpdateColumnByIdResponse(rsp *http.Response) (*UpdateColumnByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateColumnByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ColumnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAtomFeedResponse parses an HTTP response from a GetAtomFeedWithResponse call
+func ParseGetAtomFeedResponse(rsp *http.Response) (*GetAtomFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAtomFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRssFeedResponse parses an HTTP response from a GetRssFeedWithResponse call
+func ParseGetRssFeedResponse(rsp *http.Response) (*GetRssFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRssFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListPracticeSessionsResponse parses an HTTP response from a ListPracticeSessionsWithResponse call
+func ParseListPracticeSessionsResponse(rsp *http.Response) (*ListPracticeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListPracticeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PracticeSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPracticeSessionResponse parses an HTTP response from a GetPracticeSessionWithResponse call
+func ParseGetPracticeSessionResponse(rsp *http.Response) (*GetPracticeSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPracticeSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PracticeSessionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProgressResponse parses an HTTP response from a GetProgressWithResponse call
+func ParseGetProgressResponse(rsp *http.Response) (*GetProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProgressResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetProgressCalendarResponse parses an HTTP response from a GetProgressCalendarWithResponse call
+func ParseGetProgressCalendarResponse(rsp *http.Response) (*GetProgressCalendarResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetProgressCalendarResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CalendarResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListVocabularyEntriesResponse parses an HTTP response from a ListVocabularyEntriesWithResponse call
+func ParseListVocabularyEntriesResponse(rsp *http.Response) (*ListVocabularyEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListVocabularyEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VocabularyEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateVocabularyEntryResponse parses an HTTP response from a CreateVocabularyEntryWithResponse call
+func ParseCreateVocabularyEntryResponse(rsp *http.Response) (*CreateVocabularyEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateVocabularyEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest VocabularyEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDueVocabularyEntriesResponse parses an HTTP response from a ListDueVocabularyEntriesWithResponse call
+func ParseListDueVocabularyEntriesResponse(rsp *http.Response) (*ListDueVocabularyEntriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDueVocabularyEntriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []VocabularyEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportVocabularyResponse parses an HTTP response from a ExportVocabularyWithResponse call
+func ParseExportVocabularyResponse(rsp *http.Response) (*ExportVocabularyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportVocabularyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteVocabularyEntryResponse parses an HTTP response from a DeleteVocabularyEntryWithResponse call
+func ParseDeleteVocabularyEntryResponse(rsp *http.Response) (*DeleteVocabularyEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteVocabularyEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetVocabularyEntryResponse parses an HTTP response from a GetVocabularyEntryWithResponse call
+func ParseGetVocabularyEntryResponse(rsp *http.Response) (*GetVocabularyEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVocabularyEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VocabularyEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateVocabularyEntryResponse parses an HTTP response from a UpdateVocabularyEntryWithResponse call
+func ParseUpdateVocabularyEntryResponse(rsp *http.Response) (*UpdateVocabularyEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateVocabularyEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VocabularyEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReviewVocabularyEntryResponse parses an HTTP response from a ReviewVocabularyEntryWithResponse call
+func ParseReviewVocabularyEntryResponse(rsp *http.Response) (*ReviewVocabularyEntryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReviewVocabularyEntryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VocabularyEntryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateNewspaperResponse parses an HTTP response from a CreateNewspaperWithResponse call
+func ParseCreateNewspaperResponse(rsp *http.Response) (*CreateNewspaperResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateNewspaperResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest NewspaperResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteNewspaperByIdResponse parses an HTTP response from a DeleteNewspaperByIdWithResponse call
+func ParseDeleteNewspaperByIdResponse(rsp *http.Response) (*DeleteNewspaperByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteNewspaperByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNewspaperByIdResponse parses an HTTP response from a GetNewspaperByIdWithResponse call
+func ParseGetNewspaperByIdResponse(rsp *http.Response) (*GetNewspaperByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNewspaperByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NewspaperResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateNewspaperByIdResponse parses an HTTP response from a UpdateNewspaperByIdWithResponse call
+func ParseUpdateNewspaperByIdResponse(rsp *http.Response) (*UpdateNewspaperByIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateNewspaperByIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NewspaperResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNewspaperColumnsResponse parses an HTTP response from a ListNewspaperColumnsWithResponse call
+func ParseListNewspaperColumnsResponse(rsp *http.Response) (*ListNewspaperColumnsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNewspaperColumnsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ColumnResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNewspaperAtomFeedResponse parses an HTTP response from a GetNewspaperAtomFeedWithResponse call
+func ParseGetNewspaperAtomFeedResponse(rsp *http.Response) (*GetNewspaperAtomFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNewspaperAtomFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNewspaperRssFeedResponse parses an HTTP response from a GetNewspaperRssFeedWithResponse call
+func ParseGetNewspaperRssFeedResponse(rsp *http.Response) (*GetNewspaperRssFeedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNewspaperRssFeedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNewspaperTagsResponse parses an HTTP response from a ListNewspaperTagsWithResponse call
+func ParseListNewspaperTagsResponse(rsp *http.Response) (*ListNewspaperTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNewspaperTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TagCountResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookSubscriptionsResponse parses an HTTP response from a ListWebhookSubscriptionsWithResponse call
+func ParseListWebhookSubscriptionsResponse(rsp *http.Response) (*ListWebhookSubscriptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookSubscriptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookSubscriptionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookSubscriptionResponse parses an HTTP response from a CreateWebhookSubscriptionWithResponse call
+func ParseCreateWebhookSubscriptionResponse(rsp *http.Response) (*CreateWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WebhookSubscriptionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookSubscriptionResponse parses an HTTP response from a DeleteWebhookSubscriptionWithResponse call
+func ParseDeleteWebhookSubscriptionResponse(rsp *http.Response) (*DeleteWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookSubscriptionResponse parses an HTTP response from a GetWebhookSubscriptionWithResponse call
+func ParseGetWebhookSubscriptionResponse(rsp *http.Response) (*GetWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscriptionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookSubscriptionResponse parses an HTTP response from a UpdateWebhookSubscriptionWithResponse call
+func ParseUpdateWebhookSubscriptionResponse(rsp *http.Response) (*UpdateWebhookSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSubscriptionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListWebhookDeliveriesResponse parses an HTTP response from a ListWebhookDeliveriesWithResponse call
+func ParseListWebhookDeliveriesResponse(rsp *http.Response) (*ListWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDeliveryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRedeliverWebhookResponse parses an HTTP response from a RedeliverWebhookWithResponse call
+func ParseRedeliverWebhookResponse(rsp *http.Response) (*RedeliverWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RedeliverWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest WebhookDeliveryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List articles
+	// (GET /article)
+	ListArticles(c *gin.Context, params ListArticlesParams)
+	// Create a new article
+	// (POST /article)
+	CreateArticle(c *gin.Context)
+	// Delete a article by ID
+	// (DELETE /article/{id})
+	DeleteArticleById(c *gin.Context, id int)
+	// Find article by ID
+	// (GET /article/{id})
+	GetArticleById(c *gin.Context, id int, params GetArticleByIdParams)
+	// Update a article by ID
+	// (PATCH /article/{id})
+	UpdateArticleById(c *gin.Context, id int)
+	// Submit a transcription of an article
+	// (POST /article/{id}/practice-sessions)
+	CreatePracticeSession(c *gin.Context, id int, params CreatePracticeSessionParams)
+	// Mark an article as read
+	// (POST /article/{id}/read)
+	MarkArticleRead(c *gin.Context, id int, params MarkArticleReadParams)
+	// Find related articles
+	// (GET /article/{id}/related)
+	ListRelatedArticles(c *gin.Context, id int, params ListRelatedArticlesParams)
+	// List revisions of an article
+	// (GET /article/{id}/revisions)
+	ListArticleRevisions(c *gin.Context, id int, params ListArticleRevisionsParams)
+	// Find a revision of an article
+	// (GET /article/{id}/revisions/{rev})
+	GetArticleRevision(c *gin.Context, id int, rev int, params GetArticleRevisionParams)
+	// Diff two revisions of an article
+	// (GET /article/{id}/revisions/{rev}/diff)
+	DiffArticleRevisions(c *gin.Context, id int, rev int, params DiffArticleRevisionsParams)
+	// Restore an article to a revision
+	// (POST /article/{id}/revisions/{rev}/restore)
+	RestoreArticleRevision(c *gin.Context, id int, rev int)
+	// Untag an article
+	// (DELETE /article/{id}/tags/{tag})
+	UntagArticle(c *gin.Context, id int, tag string)
+	// Tag an article
+	// (PUT /article/{id}/tags/{tag})
+	TagArticle(c *gin.Context, id int, tag string)
+	// Execute operations in a single transaction
+	// (POST /batch)
+	ExecuteBatch(c *gin.Context)
+	// Create a new column
+	// (POST /column)
+	CreateColumn(c *gin.Context)
+	// Delete a column by ID
+	// (DELETE /column/{id})
+	DeleteColumnById(c *gin.Context, id int)
+	// Find column by ID
+	// (GET /column/{id})
+	GetColumnById(c *gin.Context, id int, params GetColumnByIdParams)
+	// Update a column by ID
+	// (PATCH /column/{id})
+	UpdateColumnById(c *gin.Context, id int)
+	// Stream article and newspaper events
+	// (GET /events/stream)
+	StreamEvents(c *gin.Context, params StreamEventsParams)
+	// Get the Atom feed of all newspapers
+	// (GET /feed.atom)
+	GetAtomFeed(c *gin.Context, params GetAtomFeedParams)
+	// Get the RSS feed of all newspapers
+	// (GET /feed.rss)
+	GetRssFeed(c *gin.Context, params GetRssFeedParams)
+	// List my practice sessions
+	// (GET /me/practice-sessions)
+	ListPracticeSessions(c *gin.Context, params ListPracticeSessionsParams)
+	// Find my practice session
+	// (GET /me/practice-sessions/{id})
+	GetPracticeSession(c *gin.Context, id int, params GetPracticeSessionParams)
+	// Get my progress
+	// (GET /me/progress)
+	GetProgress(c *gin.Context, params GetProgressParams)
+	// Get my monthly completion calendar
+	// (GET /me/progress/newspaper/{id}/calendar)
+	GetProgressCalendar(c *gin.Context, id int, params GetProgressCalendarParams)
+	// List my vocabulary
+	// (GET /me/vocabulary)
+	ListVocabularyEntries(c *gin.Context, params ListVocabularyEntriesParams)
+	// Add a word to my vocabulary
+	// (POST /me/vocabulary)
+	CreateVocabularyEntry(c *gin.Context, params CreateVocabularyEntryParams)
+	// List words due for review
+	// (GET /me/vocabulary/due)
+	ListDueVocabularyEntries(c *gin.Context, params ListDueVocabularyEntriesParams)
+	// Export my vocabulary as Anki CSV
+	// (GET /me/vocabulary/export)
+	ExportVocabulary(c *gin.Context, params ExportVocabularyParams)
+	// Delete a word from my vocabulary
+	// (DELETE /me/vocabulary/{id})
+	DeleteVocabularyEntry(c *gin.Context, id int, params DeleteVocabularyEntryParams)
+	// Find a word in my vocabulary
+	// (GET /me/vocabulary/{id})
+	GetVocabularyEntry(c *gin.Context, id int, params GetVocabularyEntryParams)
+	// Update a word in my vocabulary
+	// (PATCH /me/vocabulary/{id})
+	UpdateVocabularyEntry(c *gin.Context, id int, params UpdateVocabularyEntryParams)
+	// Review a word
+	// (POST /me/vocabulary/{id}/review)
+	ReviewVocabularyEntry(c *gin.Context, id int, params ReviewVocabularyEntryParams)
+	// Create a new newspaper
+	// (POST /newspaper)
+	CreateNewspaper(c *gin.Context)
+	// Delete a newspaper by ID
+	// (DELETE /newspaper/{id})
+	DeleteNewspaperById(c *gin.Context, id int)
+	// Find newspaper by ID
+	// (GET /newspaper/{id})
+	GetNewspaperById(c *gin.Context, id int, params GetNewspaperByIdParams)
+	// Update a newspaper by ID
+	// (PATCH /newspaper/{id})
+	UpdateNewspaperById(c *gin.Context, id int)
+	// List columns of a newspaper
+	// (GET /newspaper/{id}/columns)
+	ListNewspaperColumns(c *gin.Context, id int, params ListNewspaperColumnsParams)
+	// Get the Atom feed of a newspaper
+	// (GET /newspaper/{id}/feed.atom)
+	GetNewspaperAtomFeed(c *gin.Context, id int, params GetNewspaperAtomFeedParams)
+	// Get the RSS feed of a newspaper
+	// (GET /newspaper/{id}/feed.rss)
+	GetNewspaperRssFeed(c *gin.Context, id int, params GetNewspaperRssFeedParams)
+	// Count tags of a newspaper
+	// (GET /newspaper/{id}/tags)
+	ListNewspaperTags(c *gin.Context, id int)
+	// List webhook subscriptions
+	// (GET /webhooks)
+	ListWebhookSubscriptions(c *gin.Context, params ListWebhookSubscriptionsParams)
+	// Create a webhook subscription
+	// (POST /webhooks)
+	CreateWebhookSubscription(c *gin.Context)
+	// Delete a webhook subscription
+	// (DELETE /webhooks/{id})
+	DeleteWebhookSubscription(c *gin.Context, id int)
+	// Find a webhook subscription by ID
+	// (GET /webhooks/{id})
+	GetWebhookSubscription(c *gin.Context, id int, params GetWebhookSubscriptionParams)
+	// Update a webhook subscription
+	// (PATCH /webhooks/{id})
+	UpdateWebhookSubscription(c *gin.Context, id int)
+	// List deliveries of a webhook subscription
+	// (GET /webhooks/{id}/deliveries)
+	ListWebhookDeliveries(c *gin.Context, id int, params ListWebhookDeliveriesParams)
+	// Redeliver a webhook delivery
+	// (POST /webhooks/{id}/deliveries/{deliveryID}/redeliver)
+	RedeliverWebhook(c *gin.Context, id int, deliveryID int)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// ListArticles operation middleware
+func (siw *ServerInterfaceWrapper) ListArticles(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArticlesParams
+
+	// ------------- Optional query parameter "newspaperID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "newspaperID", c.Request.URL.Query(), &params.NewspaperID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter newspaperID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "columnID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "columnID", c.Request.URL.Query(), &params.ColumnID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter columnID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minCharacters" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minCharacters", c.Request.URL.Query(), &params.MinCharacters)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minCharacters: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxCharacters" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxCharacters", c.Request.URL.Query(), &params.MaxCharacters)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxCharacters: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListArticles(c, params)
+}
+
+// CreateArticle operation middleware
+func (siw *ServerInterfaceWrapper) CreateArticle(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateArticle(c)
+}
+
+// DeleteArticleById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteArticleById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteArticleById(c, id)
+}
+
+// GetArticleById operation middleware
+func (siw *ServerInterfaceWrapper) GetArticleById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArticleByIdParams
+
+	// ------------- Optional query parameter "annotate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "annotate", c.Request.URL.Query(), &params.Annotate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter annotate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetArticleById(c, id, params)
+}
+
+// UpdateArticleById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateArticleById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateArticleById(c, id)
+}
+
+// CreatePracticeSession operation middleware
+func (siw *ServerInterfaceWrapper) CreatePracticeSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePracticeSessionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreatePracticeSession(c, id, params)
+}
+
+// MarkArticleRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkArticleRead(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MarkArticleReadParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MarkArticleRead(c, id, params)
+}
+
+// ListRelatedArticles operation middleware
+func (siw *ServerInterfaceWrapper) ListRelatedArticles(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRelatedArticlesParams
+
+	// ------------- Optional query parameter "k" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "k", c.Request.URL.Query(), &params.K)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter k: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sameNewspaper" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sameNewspaper", c.Request.URL.Query(), &params.SameNewspaper)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sameNewspaper: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minCharacters" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minCharacters", c.Request.URL.Query(), &params.MinCharacters)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minCharacters: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "maxCharacters" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxCharacters", c.Request.URL.Query(), &params.MaxCharacters)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter maxCharacters: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListRelatedArticles(c, id, params)
+}
+
+// ListArticleRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListArticleRevisions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListArticleRevisionsParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListArticleRevisions(c, id, params)
+}
+
+// GetArticleRevision operation middleware
+func (siw *ServerInterfaceWrapper) GetArticleRevision(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", c.Param("rev"), &rev, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rev: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetArticleRevisionParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetArticleRevision(c, id, rev, params)
+}
+
+// DiffArticleRevisions operation middleware
+func (siw *ServerInterfaceWrapper) DiffArticleRevisions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", c.Param("rev"), &rev, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rev: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffArticleRevisionsParams
+
+	// ------------- Optional query parameter "against" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "against", c.Request.URL.Query(), &params.Against)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter against: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", c.Request.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter unit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DiffArticleRevisions(c, id, rev, params)
+}
+
+// RestoreArticleRevision operation middleware
+func (siw *ServerInterfaceWrapper) RestoreArticleRevision(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "rev" -------------
+	var rev int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "rev", c.Param("rev"), &rev, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter rev: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreArticleRevision(c, id, rev)
+}
+
+// UntagArticle operation middleware
+func (siw *ServerInterfaceWrapper) UntagArticle(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", c.Param("tag"), &tag, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UntagArticle(c, id, tag)
+}
+
+// TagArticle operation middleware
+func (siw *ServerInterfaceWrapper) TagArticle(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "tag" -------------
+	var tag string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tag", c.Param("tag"), &tag, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TagArticle(c, id, tag)
+}
+
+// ExecuteBatch operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteBatch(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExecuteBatch(c)
+}
+
+// CreateColumn operation middleware
+func (siw *ServerInterfaceWrapper) CreateColumn(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateColumn(c)
+}
+
+// DeleteColumnById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteColumnById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteColumnById(c, id)
+}
+
+// GetColumnById operation middleware
+func (siw *ServerInterfaceWrapper) GetColumnById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetColumnByIdParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetColumnById(c, id, params)
+}
+
+// UpdateColumnById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateColumnById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateColumnById(c, id)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Optional query parameter "newspaperID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "newspaperID", c.Request.URL.Query(), &params.NewspaperID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter newspaperID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StreamEvents(c, params)
+}
+
+// GetAtomFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetAtomFeed(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAtomFeedParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAtomFeed(c, params)
+}
+
+// GetRssFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetRssFeed(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRssFeedParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRssFeed(c, params)
+}
+
+// ListPracticeSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListPracticeSessions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPracticeSessionsParams
+
+	// ------------- Optional query parameter "articleID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "articleID", c.Request.URL.Query(), &params.ArticleID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter articleID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListPracticeSessions(c, params)
+}
+
+// GetPracticeSession operation middleware
+func (siw *ServerInterfaceWrapper) GetPracticeSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPracticeSessionParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPracticeSession(c, id, params)
+}
+
+// GetProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetProgress(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProgressParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProgress(c, params)
+}
+
+// GetProgressCalendar operation middleware
+func (siw *ServerInterfaceWrapper) GetProgressCalendar(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProgressCalendarParams
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := c.Query("year"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument year is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "year", c.Request.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter year: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "month" -------------
+
+	if paramValue := c.Query("month"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument month is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "month", c.Request.URL.Query(), &params.Month)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter month: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProgressCalendar(c, id, params)
+}
+
+// ListVocabularyEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListVocabularyEntries(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVocabularyEntriesParams
+
+	// ------------- Optional query parameter "articleID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "articleID", c.Request.URL.Query(), &params.ArticleID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter articleID: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListVocabularyEntries(c, params)
+}
+
+// CreateVocabularyEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateVocabularyEntry(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateVocabularyEntryParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateVocabularyEntry(c, params)
+}
+
+// ListDueVocabularyEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListDueVocabularyEntries(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDueVocabularyEntriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListDueVocabularyEntries(c, params)
+}
+
+// ExportVocabulary operation middleware
+func (siw *ServerInterfaceWrapper) ExportVocabulary(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportVocabularyParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportVocabulary(c, params)
+}
+
+// DeleteVocabularyEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVocabularyEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteVocabularyEntryParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteVocabularyEntry(c, id, params)
+}
+
+// GetVocabularyEntry operation middleware
+func (siw *ServerInterfaceWrapper) GetVocabularyEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVocabularyEntryParams
 
-// ParseDeleteArticleByIdResponse parses an HTTP response from a DeleteArticleByIdWithResponse call
-func ParseDeleteArticleByIdResponse(rsp *http.Response) (*DeleteArticleByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &DeleteArticleByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON404 = &dest
 
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.GetVocabularyEntry(c, id, params)
 }
 
-// ParseGetArticleByIdResponse parses an HTTP response from a GetArticleByIdWithResponse call
-func ParseGetArticleByIdResponse(rsp *http.Response) (*GetArticleByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// UpdateVocabularyEntry operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVocabularyEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetArticleByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateVocabularyEntryParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ArticleResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := c.Request.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON404 = &dest
 
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.UpdateVocabularyEntry(c, id, params)
 }
 
-// ParseUpdateArticleByIdResponse parses an HTTP response from a UpdateArticleByIdWithResponse call
-func ParseUpdateArticleByIdResponse(rsp *http.Response) (*UpdateArticleByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ReviewVocabularyEntry operation middleware
+func (siw *ServerInterfaceWrapper) ReviewVocabularyEntry(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &UpdateArticleByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReviewVocabularyEntryParams
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ArticleResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID UserIDHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON404 = &dest
+	}
+
+	siw.Handler.ReviewVocabularyEntry(c, id, params)
+}
+
+// CreateNewspaper operation middleware
+func (siw *ServerInterfaceWrapper) CreateNewspaper(c *gin.Context) {
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	return response, nil
+	siw.Handler.CreateNewspaper(c)
 }
 
-// ParseCreateNewspaperResponse parses an HTTP response from a CreateNewspaperWithResponse call
-func ParseCreateNewspaperResponse(rsp *http.Response) (*CreateNewspaperResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteNewspaperById operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNewspaperById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &CreateNewspaperResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest NewspaperResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	siw.Handler.DeleteNewspaperById(c, id)
+}
+
+// GetNewspaperById operation middleware
+func (siw *ServerInterfaceWrapper) GetNewspaperById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNewspaperByIdParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON201 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	siw.Handler.GetNewspaperById(c, id, params)
+}
+
+// UpdateNewspaperById operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNewspaperById(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON400 = &dest
+	}
+
+	siw.Handler.UpdateNewspaperById(c, id)
+}
+
+// ListNewspaperColumns operation middleware
+func (siw *ServerInterfaceWrapper) ListNewspaperColumns(c *gin.Context) {
 
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNewspaperColumnsParams
 
-// ParseDeleteNewspaperByIdResponse parses an HTTP response from a DeleteNewspaperByIdWithResponse call
-func ParseDeleteNewspaperByIdResponse(rsp *http.Response) (*DeleteNewspaperByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &DeleteNewspaperByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Optional query parameter "exclude" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
-		response.JSON404 = &dest
-
 	}
 
-	return response, nil
+	siw.Handler.ListNewspaperColumns(c, id, params)
 }
 
-// ParseGetNewspaperByIdResponse parses an HTTP response from a GetNewspaperByIdWithResponse call
-func ParseGetNewspaperByIdResponse(rsp *http.Response) (*GetNewspaperByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetNewspaperAtomFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetNewspaperAtomFeed(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	response := &GetNewspaperByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNewspaperAtomFeedParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NewspaperResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	headers := c.Request.Header
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
 		}
-		response.JSON400 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
 		}
-		response.JSON404 = &dest
+
+		params.IfNoneMatch = &IfNoneMatch
 
 	}
 
-	return response, nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetNewspaperAtomFeed(c, id, params)
 }
 
-// ParseUpdateNewspaperByIdResponse parses an HTTP response from a UpdateNewspaperByIdWithResponse call
-func ParseUpdateNewspaperByIdResponse(rsp *http.Response) (*UpdateNewspaperByIdResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetNewspaperRssFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetNewspaperRssFeed(c *gin.Context) {
 
-	response := &UpdateNewspaperByIdResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NewspaperResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest ErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNewspaperRssFeedParams
+
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	return response, nil
-}
+	headers := c.Request.Header
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create a new article
-	// (POST /article)
-	CreateArticle(c *gin.Context)
-	// Delete a article by ID
-	// (DELETE /article/{id})
-	DeleteArticleById(c *gin.Context, id int)
-	// Find article by ID
-	// (GET /article/{id})
-	GetArticleById(c *gin.Context, id int)
-	// Update a article by ID
-	// (PATCH /article/{id})
-	UpdateArticleById(c *gin.Context, id int)
-	// Create a new newspaper
-	// (POST /newspaper)
-	CreateNewspaper(c *gin.Context)
-	// Delete a newspaper by ID
-	// (DELETE /newspaper/{id})
-	DeleteNewspaperById(c *gin.Context, id int)
-	// Find newspaper by ID
-	// (GET /newspaper/{id})
-	GetNewspaperById(c *gin.Context, id int)
-	// Update a newspaper by ID
-	// (PATCH /newspaper/{id})
-	UpdateNewspaperById(c *gin.Context, id int)
-}
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type MiddlewareFunc func(c *gin.Context)
+		params.IfNoneMatch = &IfNoneMatch
 
-// CreateArticle operation middleware
-func (siw *ServerInterfaceWrapper) CreateArticle(c *gin.Context) {
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1352,11 +10838,11 @@ func (siw *ServerInterfaceWrapper) CreateArticle(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateArticle(c)
+	siw.Handler.GetNewspaperRssFeed(c, id, params)
 }
 
-// DeleteArticleById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteArticleById(c *gin.Context) {
+// ListNewspaperTags operation middleware
+func (siw *ServerInterfaceWrapper) ListNewspaperTags(c *gin.Context) {
 
 	var err error
 
@@ -1376,20 +10862,30 @@ func (siw *ServerInterfaceWrapper) DeleteArticleById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteArticleById(c, id)
+	siw.Handler.ListNewspaperTags(c, id)
 }
 
-// GetArticleById operation middleware
-func (siw *ServerInterfaceWrapper) GetArticleById(c *gin.Context) {
+// ListWebhookSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookSubscriptions(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookSubscriptionsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1400,11 +10896,24 @@ func (siw *ServerInterfaceWrapper) GetArticleById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetArticleById(c, id)
+	siw.Handler.ListWebhookSubscriptions(c, params)
 }
 
-// UpdateArticleById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateArticleById(c *gin.Context) {
+// CreateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookSubscription(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateWebhookSubscription(c)
+}
+
+// DeleteWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookSubscription(c *gin.Context) {
 
 	var err error
 
@@ -1424,11 +10933,41 @@ func (siw *ServerInterfaceWrapper) UpdateArticleById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateArticleById(c, id)
+	siw.Handler.DeleteWebhookSubscription(c, id)
 }
 
-// CreateNewspaper operation middleware
-func (siw *ServerInterfaceWrapper) CreateNewspaper(c *gin.Context) {
+// GetWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookSubscription(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookSubscriptionParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1437,11 +10976,11 @@ func (siw *ServerInterfaceWrapper) CreateNewspaper(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateNewspaper(c)
+	siw.Handler.GetWebhookSubscription(c, id, params)
 }
 
-// DeleteNewspaperById operation middleware
-func (siw *ServerInterfaceWrapper) DeleteNewspaperById(c *gin.Context) {
+// UpdateWebhookSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhookSubscription(c *gin.Context) {
 
 	var err error
 
@@ -1461,11 +11000,11 @@ func (siw *ServerInterfaceWrapper) DeleteNewspaperById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteNewspaperById(c, id)
+	siw.Handler.UpdateWebhookSubscription(c, id)
 }
 
-// GetNewspaperById operation middleware
-func (siw *ServerInterfaceWrapper) GetNewspaperById(c *gin.Context) {
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(c *gin.Context) {
 
 	var err error
 
@@ -1478,6 +11017,41 @@ func (siw *ServerInterfaceWrapper) GetNewspaperById(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", c.Request.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter fields: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "exclude" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude", c.Request.URL.Query(), &params.Exclude)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exclude: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1485,11 +11059,11 @@ func (siw *ServerInterfaceWrapper) GetNewspaperById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetNewspaperById(c, id)
+	siw.Handler.ListWebhookDeliveries(c, id, params)
 }
 
-// UpdateNewspaperById operation middleware
-func (siw *ServerInterfaceWrapper) UpdateNewspaperById(c *gin.Context) {
+// RedeliverWebhook operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhook(c *gin.Context) {
 
 	var err error
 
@@ -1502,6 +11076,15 @@ func (siw *ServerInterfaceWrapper) UpdateNewspaperById(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "deliveryID" -------------
+	var deliveryID int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryID", c.Param("deliveryID"), &deliveryID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter deliveryID: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1509,7 +11092,7 @@ func (siw *ServerInterfaceWrapper) UpdateNewspaperById(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateNewspaperById(c, id)
+	siw.Handler.RedeliverWebhook(c, id, deliveryID)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -1539,32 +11122,132 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/article", wrapper.ListArticles)
 	router.POST(options.BaseURL+"/article", wrapper.CreateArticle)
 	router.DELETE(options.BaseURL+"/article/:id", wrapper.DeleteArticleById)
 	router.GET(options.BaseURL+"/article/:id", wrapper.GetArticleById)
 	router.PATCH(options.BaseURL+"/article/:id", wrapper.UpdateArticleById)
+	router.POST(options.BaseURL+"/article/:id/practice-sessions", wrapper.CreatePracticeSession)
+	router.POST(options.BaseURL+"/article/:id/read", wrapper.MarkArticleRead)
+	router.GET(options.BaseURL+"/article/:id/related", wrapper.ListRelatedArticles)
+	router.GET(options.BaseURL+"/article/:id/revisions", wrapper.ListArticleRevisions)
+	router.GET(options.BaseURL+"/article/:id/revisions/:rev", wrapper.GetArticleRevision)
+	router.GET(options.BaseURL+"/article/:id/revisions/:rev/diff", wrapper.DiffArticleRevisions)
+	router.POST(options.BaseURL+"/article/:id/revisions/:rev/restore", wrapper.RestoreArticleRevision)
+	router.DELETE(options.BaseURL+"/article/:id/tags/:tag", wrapper.UntagArticle)
+	router.PUT(options.BaseURL+"/article/:id/tags/:tag", wrapper.TagArticle)
+	router.POST(options.BaseURL+"/batch", wrapper.ExecuteBatch)
+	router.POST(options.BaseURL+"/column", wrapper.CreateColumn)
+	router.DELETE(options.BaseURL+"/column/:id", wrapper.DeleteColumnById)
+	router.GET(options.BaseURL+"/column/:id", wrapper.GetColumnById)
+	router.PATCH(options.BaseURL+"/column/:id", wrapper.UpdateColumnById)
+	router.GET(options.BaseURL+"/events/stream", wrapper.StreamEvents)
+	router.GET(options.BaseURL+"/feed.atom", wrapper.GetAtomFeed)
+	router.GET(options.BaseURL+"/feed.rss", wrapper.GetRssFeed)
+	router.GET(options.BaseURL+"/me/practice-sessions", wrapper.ListPracticeSessions)
+	router.GET(options.BaseURL+"/me/practice-sessions/:id", wrapper.GetPracticeSession)
+	router.GET(options.BaseURL+"/me/progress", wrapper.GetProgress)
+	router.GET(options.BaseURL+"/me/progress/newspaper/:id/calendar", wrapper.GetProgressCalendar)
+	router.GET(options.BaseURL+"/me/vocabulary", wrapper.ListVocabularyEntries)
+	router.POST(options.BaseURL+"/me/vocabulary", wrapper.CreateVocabularyEntry)
+	router.GET(options.BaseURL+"/me/vocabulary/due", wrapper.ListDueVocabularyEntries)
+	router.GET(options.BaseURL+"/me/vocabulary/export", wrapper.ExportVocabulary)
+	router.DELETE(options.BaseURL+"/me/vocabulary/:id", wrapper.DeleteVocabularyEntry)
+	router.GET(options.BaseURL+"/me/vocabulary/:id", wrapper.GetVocabularyEntry)
+	router.PATCH(options.BaseURL+"/me/vocabulary/:id", wrapper.UpdateVocabularyEntry)
+	router.POST(options.BaseURL+"/me/vocabulary/:id/review", wrapper.ReviewVocabularyEntry)
 	router.POST(options.BaseURL+"/newspaper", wrapper.CreateNewspaper)
 	router.DELETE(options.BaseURL+"/newspaper/:id", wrapper.DeleteNewspaperById)
 	router.GET(options.BaseURL+"/newspaper/:id", wrapper.GetNewspaperById)
 	router.PATCH(options.BaseURL+"/newspaper/:id", wrapper.UpdateNewspaperById)
+	router.GET(options.BaseURL+"/newspaper/:id/columns", wrapper.ListNewspaperColumns)
+	router.GET(options.BaseURL+"/newspaper/:id/feed.atom", wrapper.GetNewspaperAtomFeed)
+	router.GET(options.BaseURL+"/newspaper/:id/feed.rss", wrapper.GetNewspaperRssFeed)
+	router.GET(options.BaseURL+"/newspaper/:id/tags", wrapper.ListNewspaperTags)
+	router.GET(options.BaseURL+"/webhooks", wrapper.ListWebhookSubscriptions)
+	router.POST(options.BaseURL+"/webhooks", wrapper.CreateWebhookSubscription)
+	router.DELETE(options.BaseURL+"/webhooks/:id", wrapper.DeleteWebhookSubscription)
+	router.GET(options.BaseURL+"/webhooks/:id", wrapper.GetWebhookSubscription)
+	router.PATCH(options.BaseURL+"/webhooks/:id", wrapper.UpdateWebhookSubscription)
+	router.GET(options.BaseURL+"/webhooks/:id/deliveries", wrapper.ListWebhookDeliveries)
+	router.POST(options.BaseURL+"/webhooks/:id/deliveries/:deliveryID/redeliver", wrapper.RedeliverWebhook)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xW30/bMBD+V6LbHqMmBaShvPFjoGpSN6HtCfFg4qM1SmxjX0FV1f99st30V1LabZAO",
-	"qeKByjn7Pt/33fmbQK5KrSRKspBNwOZDLJn/eWZI5AVeGGSEN/g0QktuXRul0ZBAH3Wv+Nj9p7FGyMCS",
-	"EXIA0xg4W14XknCAxn0olaRh8yeJL1YzjaZ32RwwRmaavkxjMPg0EgY5ZLcB1NJxMNtZJQ/o7uLqHHX/",
-	"iDm5BLNL36DVSlp8i/sKvrc6CA7xvxfjl+YfQgE1/F+NUWYzlSVaywbYgH2tjFVgU436FeAtfZKrYlTK",
-	"PiuxsVYkqNgBSQiLl097FdTmy2/Bs0m0O+L0wvtTsFt09vcVXEvploR8UD447IJrFZ396EU/sdQFI4f6",
-	"GY0VSkIG3U7aSV0KpVEyLSCD407aOYYYNKOhB5ew0CsetgrwHXhGQskehwyCPmYtBaFcaOl81j25koTS",
-	"72NaFyL3O5NH6yBUc9n9+mzwATL4lCwGdzKb2knjyJ6ukkNmhH4hKMOjP0q7b41hrjyfnqPNjdAUChrw",
-	"cVfSkzR9s8yr7d6Q95zxaFGVGOyoLJkZzxFFLJL4ElVUupCK12Qi+NQB4FggYZ3eS78+u/z5uMe9Ogwr",
-	"kdBYyG4nIBwGpxg3jb2MQ5+schMv3bY25O5qxJ0EUMvX7KvoYlbPvVbY5T5pL3dfUXSlRpKvcRuYiVjF",
-	"a3Q/jnqXDt4AG/r0Gql9FtM22+/7t4MuIIMrIXldE5pRPqyrIrxNrQjj3Z6F1fd1p2ehVV0GfPwgznkt",
-	"6kPLPUkLJ7/FbPSXLP976GqD923ZcNTN7sezHAtKVxne0XbMS3AwHv+j8ZjTud167IPJtN1WPNiPhf1o",
-	"UMarBqQlebzjU7FXE7KTPg82pMGG1ITq49A8V9IbmQIyGBLpLEnSjv/LTtPTNGFaJM9dmMZrQYXKWTFU",
-	"ll4P6x598ad1V8Pupr8DAAD//3ZtPum7FgAA",
+	"H4sIAAAAAAAC/+xdW3PbuJL+KyzuPOzu0JacSWYzenNsZ9Y7ua3lzG5VKqcKJlsyJ7wFAG3ruPTfT+FC",
+	"EiRBELQtWZqw/GKKuDS6P3Q3Gg3w3vXTOEsTSChxZ/duhjCKgQLmT8eYhn4E8xRT9hgm7sz9ngNeuZ6b",
+	"oBjcmUvYO88l/jXEiBUKYIHyiLozN4FbIOwlJHnszr5UP6RRIP4h1ymm4t8oTZbsv6+eS1cZb5riMFm6",
+	"67XnnqRRHifnp//LO+8gxZeFauTItsKEwhIwb+zszo/yAMq24C6L0gDc2QJFBDxt2yDq1JoOKcRE6aOg",
+	"txwAwhit2DOhq4j9sEhxzJ7fAgTvwjjs5GrEX6qdxegujBkbj6ZTz43DRD55uhG+DSEKyKABLniVJxrf",
+	"+eJDmsB7RP3r/wYUAC7HeS0ey27PFwes6AEvqxOcAoKBHCuB+GLqDWPfe3R3co0w8ot5oOswrhWqiapo",
+	"fapvPUwsWg+Th7X+AW5JhjLAPZMlqcr1zZePiwWBTsan4q2W81Ovj9xLtDSSSdHyQZhce+5nwgbXg7//",
+	"P2DFDjgTMHzPQwyBO6M4h8bkewfJkl67s6MXr/mgyue2uloXVYUOTZKUIhqmyTxDCfslw2kGmIbA36cl",
+	"d5vsYRShgLWpHS/cUf08qcbxRZTyik4q3Zpe/QU+Ze1IHV+R2SaRTWvEOys0Oc6vmJBIhhKi0diee03j",
+	"SEu2qKJK8icMC3fm/tukskQTyb9Jg3k6MaujlYQahnmCAVG4gO85ENoe6VUarLRkl7Zldu8meRShqwgK",
+	"nLTlFqCVXqBxmtBr/St1QmoLrADhjhmq8oAPwWvMb1636F7QZ2DSabhYXADJ0oRAm0cQhNRegqytsyCk",
+	"OrOxwGmsHytN9b/nSWgBet4ub0TW8CTRhjF3jxfVZoYRra2ptPbsIDUAQmGg//0brG5THJBhlvsxgCQU",
+	"UWLJkzkvyyrlcYywniMULQeSbzknwsD1rCdGMbCKViNsbkISpokBPqJgFxO74cE1VXBMVQ3sBojCAQ1j",
+	"cDVKF0tiLDhSUaVUK3lU9W0Y+rwQf328fs230eAUJeiCzY76uNKcadSytySPr8ryf4VDKrAlzBKj7JoY",
+	"jeplGMMc/DQJOsoRSCgkPhALdvo1X62sWCOmNhSVD1qSDIz/nAV/QxvWGu0btiQ4wzjF3ZMrTAK46yAT",
+	"CEFL6LcWRUFPtva1i5aPGeAODylAlDuKKAhCVgJFn5T3NRZXjXJNziml12mguldi/jHrxUXNFBNEQKHt",
+	"annu3QGrdXCDMHNpCavOqT0p2uBPn7NAeTqVrXFOLBoe7q8vexxcVomkOfZBpbmUvesV2mUIuR+U6vyH",
+	"46KNtrw4uxQqOiXWOUfSQpT2jkwDAmu+pjwXNYtFZfHY46YqvRtI78I8BpJHdCDhF7xSrwNdtG0ii7XT",
+	"pXQqQLUdf4pobqNLZUEdCScogiRA+BSteg1uh1ZnDGLgV72oqzSNACVGrcdUtP4NxSghPg6vILAYnfAw",
+	"Shplw/VWVCpNbOjmQVn/FK1Ip4NpjyEd45/Ym7R05YzuW33YcoxaDnJT2LMYDIAJJKN1j6pCtAgh3Pcp",
+	"y96RMyYHeQS9QuBEz4vSrGaU8+BAhigFnLgz9x9f0ME/pwe/ff353w/Kf//jP39yvR4rWOcrH5psv5t/",
+	"3fjrY13XIqZg6XMw0cwfvoToZpJXG7JCTjf35grFhRENUBgx/XAL8C0QGGb/RqsC5Py/EGNY5hHC2tCL",
+	"aL3HSXwibD8bdFs8LeMMGluvchi+5yjivh4BTA2elXWILc1cWVQn6x4HdrCXquvjf+YfP3ziAXRbfV7W",
+	"qDk0TXWuKdWODdZjOBXz6mxHgfDY4vQG+D9ZhHzgqOY/+Gm24mwk1NJrLIk75k2XjxdFH8ovRWflT+8b",
+	"RU5E9+XzJaeDLyZrBq0a3g2KciY2LRp4LZ2kSi+3x/TQkEYWoBDFjB19wukSAyFP6S8NMesGv6ljjEM9",
+	"qrpSFq0+1sMq2WdysZhK+yD1ZAAZBh/RavfA2uZZypoboGJ0SudG8nusgKHrVpufMPJp6ANXaBoU+ZQp",
+	"Vm3cAVFYpnil6oM4JERMbbijGPFg2xWhIc3rIqlagbsM/DoOq5dZSkJqF/kqqVFqKa17xUi+GngwB8IC",
+	"Zn3+Yy6UphJlMu2GVfZG3W6aTqdTz2aTx4Jegw7w/Rwjf2UZYuuJafopxowC/cvhcc0gJBQlPnQsZNps",
+	"bhcChlr79U4d7BrTKFA7KEBfQF77UkW/toCdL8KVhBrblft/pXwVZlZy8nqmY8m+vsiwhbHxaXgD3avS",
+	"RxkjP8cYEjqnGNA3fZEIEXosaaB66yMTUUytlCbHHlHdBlmDrqcKNtgbQU+VTJOTTZ7UGKDDwQVEHCW9",
+	"W2uigOUeksox4qcYrLSVniVu0YSO/Eu0PEnzhJqMf57QQUvYptMi1o6iHR0Nf6Y+umJrvNVZQvGqx9T0",
+	"KOQYUNKVR1DlH5iNkykXgW08Ng3Xb0d9eRJ1jvA2LDjx4J22hxievFtNACLwFvk0xfX2Oo1ml11g/+Kb",
+	"mu/UUFhskxFuh5FuJ/JhYsaQAQ3LsHm7coEDCyPFi1bdVQTXOKtwp959JZs+m9SAT49HbGLbJmaAgVwh",
+	"9U5KWRwjpKtabuCr3nwrVQ5FCzqu/R9cXafpt1OIwhswTjpKIc4oebo5J7ocVgluIKF9ZkSO6YyXNUxI",
+	"NuXKVY4mEnlHj8Wgh1CYoVWUoqDYIxHsnHfuiaj7JcWaKYNEzhWS+z5AwO32AoVRx5KJ+XBFlE+vFXUz",
+	"s1GrYG41hJI2r5K+yrW+GVmTgzI+qb8PZe3Kkz0U26DqLyJqV4vJKvWq36qa1W9FXR3LJG1zhQc9ppdz",
+	"x94PbILQsGvouQR8DM0l4YtXr+p65VfNOHIcNatNX77us8GslleMqOzeIEOVT31Ov37D7QEa4pEcbzK5",
+	"Sw1IFlrYsgbb5HAtp4HKwh7jZOLjvsOwwZ41d4wWqRKlcn9PneNP584lxFkkLP8NYJHv5B4dTg+nIuwM",
+	"CcpCd+b+cjg9/EUGYzk/JspyYynGU+7EnwfuzH0XEnpcLZnUsxBf9Oysikxaiddrr7dO/ViDRYUyXdqi",
+	"bD3P3KYCuhtWQT0eYlFc5O9bFJQJ5xYl1YMOFsVrJz/WXytDzPHxYjoVa7uESsuEsiwKfQ6QyV9EhBc1",
+	"uegD166NDIx1fe9w5n78g5V6OZAaExH1TShNl29Q4BRqZ61mafI54ZRxBBFq1UwdYSSPy8U1Fo29kblo",
+	"TzIKbQr3uq6RKc5h3ZLr0VPTYOLliXRDdkeGgiIHOQncFrLkRQqNOLkPg7XYxuCboS3xioQxOfg3q/Og",
+	"rR758Qq+8VUerggD46mKljPanpAvW3vV7ofUOZH8fFYOs75fbq/vDyl13qZ5EjRkKyTjoEKuztXKOT9l",
+	"5GlN3O9ANy1FT3+SR6bR14/QWZ4n2XVL8EiN8fGPEcruzH0bJkEbxlmR2FAHsnCVt6KRNmbJ6u4+Y0az",
+	"yQM++p+HtV6lgzSbjAEv4UFtdlBsYXu3OpM+y+X+DzqdWM+/ba/nkzRZRKHfdDeEENomqelwTDK5y3pA",
+	"xP60OE1i8C8b+9mbMl89tqN2sHRTKsKYarBlp7crjWBXnd8dsWfz/CoOqYOcYtOVV3DShYOSbjd8Umz+",
+	"6ifCe4S/lYoQBbszBfr89gvwUxyM0MArKUUFBQ4iDhe7Dg18K90Ys6pvt5NtevXf9Af/Xyk3LrzqvXBB",
+	"3zRBMaingjTdyPssmtHQvQuk7X4YqyOjYw+iWbu0uJGTWYmnaea7OIxLbKLUF2Xh5zEDexR+bRzTHoFr",
+	"C1weAC5RaeG9yJKTeww3604UV4Goi+r4+ebMVr0VDDd/q6nwoBkwIt4YhyoxPxTykyBcLDpxz84pbUt7",
+	"PxHytdHcJQoTQt2H1JVXsmj8OTcKE1AujJOP/rX2qNs2pk3tKpxxynTuQoSLhUNv04cbigkGQmVOr37l",
+	"eyEK7JHVeOZtBcmwccW9qpihLrppqmh5DUIpWpLJPUVL49bo54SiZbXxvS0wiovxrK6s673QY9z/2gmI",
+	"ciTVlKbnZrlGE16OgBsB9wSAu2zAjWnAq2K7VW+Ez+7Azynwu2A2lOZTu7lny1uM9at3toIczTVX24WP",
+	"HQG1XcZXWx//eUIBJyhy5oBvADu8QgPOEptOiVjihImDHHayMQKxC8M2sgpjLw5R9+05ihzNDWFddw/N",
+	"ljf3Gle57F9CmxSjIlLLdDYx8jGbbRez2YQg+5PZNizDPY779c/r0Quqwn1NvBmzzrahODZla541g6sf",
+	"kz94Apc+j6oOTmbnxGmfCaEYUNwZa+bH9eOz4lySDqkP/ipAx3367xChB7zHA30TA5aAFO6oGOlBNdDu",
+	"Bnf7HIWQRZXwkQROyWxHiFOIdgEQHCKaxsatM5rG7CMig08pVV8esTBW7c94DLRYbBg/34kvAQwTnCeR",
+	"xTs5u0TLnibWnvuL3r+izvs0CBfhTjmwvwN16DU4TI4OkzgPl0eRo1ysUaEBE2ICwwUhe4AFTMgIBQMU",
+	"LuZzIxJi0OfLduaINLI2h59orCf5dW0EKpcNPcqR/iFPB3Zm1u7jKcF45RQIdUqEdmK3XKR3qbVdTPbe",
+	"4/XggCTucWFYLQw1oFYwLa7yMqNYlnmc9t0sMpo3ku24tmEmk8tFsrYpj0lpO8VGri9vL7eRU3HT+a6o",
+	"G53NlbeePz6Lp7g33birJxO3X/RkcW80ltW88H5UWl1KS84NeVm4I2/4Y2ls5SwopstNedWU0Y+sX6AV",
+	"wuhI7qAj2XVJ3r46kgo2e+6caIz8Kezs08dejbc5bnnDrxMq+7Dzdxyw1Fx22x5L32oApaXXJkFuvu3n",
+	"NIcNqLenUlCj2tmq2mGoIk6Qg7NIMU8MhFsdqOAukx/E1uLqjL+uOLNxz5/HyX1ys8/hccG0+oRmpyKP",
+	"k2+hczL/UycHu13+XvuwqwdmT+U1haNXq+QFcM3PvjnSdhK6Vna7CIA9jiMNcB/GJZl6nohDN0w03q0p",
+	"12Bn1dfGneRnzVQYUf7gbIUOnOvt90S6WYZTP+z9DzkL6heAjxNg508YMXHJCSDwXn0TtyfJWL3nYhOg",
+	"6vju2JYjD+2vau1ftnEl0rqELVcjJQvGtONdXF5UKVm9mcebl+QeLxKsZvpoN6rlgQZ4xmXBlvTIBi3R",
+	"Hl1+2UnzVj0yqzk1XoC5SxdgtmZ122WQR5bMqXyV+yYLj9c92R0wGG95GnLLk4Qiz0A1O7p2WeolbLvT",
+	"1beD2zHpfUyKaafb20C8J/W+ssmE/DAAHzP5dxXftTMEPfCmaGnpdFyyktsJVWzAJWh9zHV0Cmxhxfnm",
+	"MKBoAXUrviFmRpHmK2fDM2x23/E0fQ9vP5NhxIAcUhNcTy6ehgsbCmj3fqdxy6Fto/z3KsitE3x9vluG",
+	"uvVgGAPeu5VPo5V2d9R7W0Ld49j3QFUw+hq1JBkNHi1D4lvVN1uxaM8abh5h/LgsGDszOpGfO5df+O1z",
+	"o0+r0s+jdX/IwzVdH8MfV5KDwssV1MVycvgUmdzL/1fnp+sJBvlkyh6TRaQIt3hfakXpY93ZF0+t09tA",
+	"bkvy2PchG9PPi9QuKU0FtYV8RTuEX0spIMU/eu5eU5rNJpPpIf+bvZ6+nk5QFk5ujriWqxWKUh9F1ymh",
+	"5mJHL/6Lt3ZUL/Z1/a8BAFLaqvvGrQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,4 @@
+// Package newspaperpb は api/newspaper.proto から生成したgRPCのコード
+package newspaperpb
+
+//go:generate protoc -I .. --go_out=../.. --go_opt=module=go-api-newspaper --go-grpc_out=../.. --go-grpc_opt=module=go-api-newspaper ../newspaper.proto
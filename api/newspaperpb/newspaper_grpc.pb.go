@@ -0,0 +1,531 @@
+// REST API（openapi.yaml）の新聞と記事の操作をgRPCで提供するためのサービス定義
+// 生成コードは api/newspaperpb/generate.go の go:generate で作り直す
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: newspaper.proto
+
+package newspaperpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	NewspaperService_CreateNewspaper_FullMethodName = "/newspaper.v1.NewspaperService/CreateNewspaper"
+	NewspaperService_GetNewspaper_FullMethodName    = "/newspaper.v1.NewspaperService/GetNewspaper"
+	NewspaperService_ListNewspapers_FullMethodName  = "/newspaper.v1.NewspaperService/ListNewspapers"
+	NewspaperService_UpdateNewspaper_FullMethodName = "/newspaper.v1.NewspaperService/UpdateNewspaper"
+	NewspaperService_DeleteNewspaper_FullMethodName = "/newspaper.v1.NewspaperService/DeleteNewspaper"
+)
+
+// NewspaperServiceClient is the client API for NewspaperService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NewspaperServiceClient interface {
+	CreateNewspaper(ctx context.Context, in *CreateNewspaperRequest, opts ...grpc.CallOption) (*Newspaper, error)
+	GetNewspaper(ctx context.Context, in *GetNewspaperRequest, opts ...grpc.CallOption) (*Newspaper, error)
+	ListNewspapers(ctx context.Context, in *ListNewspapersRequest, opts ...grpc.CallOption) (*ListNewspapersResponse, error)
+	UpdateNewspaper(ctx context.Context, in *UpdateNewspaperRequest, opts ...grpc.CallOption) (*Newspaper, error)
+	DeleteNewspaper(ctx context.Context, in *DeleteNewspaperRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type newspaperServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNewspaperServiceClient(cc grpc.ClientConnInterface) NewspaperServiceClient {
+	return &newspaperServiceClient{cc}
+}
+
+func (c *newspaperServiceClient) CreateNewspaper(ctx context.Context, in *CreateNewspaperRequest, opts ...grpc.CallOption) (*Newspaper, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Newspaper)
+	err := c.cc.Invoke(ctx, NewspaperService_CreateNewspaper_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newspaperServiceClient) GetNewspaper(ctx context.Context, in *GetNewspaperRequest, opts ...grpc.CallOption) (*Newspaper, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Newspaper)
+	err := c.cc.Invoke(ctx, NewspaperService_GetNewspaper_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newspaperServiceClient) ListNewspapers(ctx context.Context, in *ListNewspapersRequest, opts ...grpc.CallOption) (*ListNewspapersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNewspapersResponse)
+	err := c.cc.Invoke(ctx, NewspaperService_ListNewspapers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newspaperServiceClient) UpdateNewspaper(ctx context.Context, in *UpdateNewspaperRequest, opts ...grpc.CallOption) (*Newspaper, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Newspaper)
+	err := c.cc.Invoke(ctx, NewspaperService_UpdateNewspaper_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newspaperServiceClient) DeleteNewspaper(ctx context.Context, in *DeleteNewspaperRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NewspaperService_DeleteNewspaper_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NewspaperServiceServer is the server API for NewspaperService service.
+// All implementations must embed UnimplementedNewspaperServiceServer
+// for forward compatibility.
+type NewspaperServiceServer interface {
+	CreateNewspaper(context.Context, *CreateNewspaperRequest) (*Newspaper, error)
+	GetNewspaper(context.Context, *GetNewspaperRequest) (*Newspaper, error)
+	ListNewspapers(context.Context, *ListNewspapersRequest) (*ListNewspapersResponse, error)
+	UpdateNewspaper(context.Context, *UpdateNewspaperRequest) (*Newspaper, error)
+	DeleteNewspaper(context.Context, *DeleteNewspaperRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedNewspaperServiceServer()
+}
+
+// UnimplementedNewspaperServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNewspaperServiceServer struct{}
+
+func (UnimplementedNewspaperServiceServer) CreateNewspaper(context.Context, *CreateNewspaperRequest) (*Newspaper, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNewspaper not implemented")
+}
+func (UnimplementedNewspaperServiceServer) GetNewspaper(context.Context, *GetNewspaperRequest) (*Newspaper, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewspaper not implemented")
+}
+func (UnimplementedNewspaperServiceServer) ListNewspapers(context.Context, *ListNewspapersRequest) (*ListNewspapersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNewspapers not implemented")
+}
+func (UnimplementedNewspaperServiceServer) UpdateNewspaper(context.Context, *UpdateNewspaperRequest) (*Newspaper, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNewspaper not implemented")
+}
+func (UnimplementedNewspaperServiceServer) DeleteNewspaper(context.Context, *DeleteNewspaperRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNewspaper not implemented")
+}
+func (UnimplementedNewspaperServiceServer) mustEmbedUnimplementedNewspaperServiceServer() {}
+func (UnimplementedNewspaperServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeNewspaperServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NewspaperServiceServer will
+// result in compilation errors.
+type UnsafeNewspaperServiceServer interface {
+	mustEmbedUnimplementedNewspaperServiceServer()
+}
+
+func RegisterNewspaperServiceServer(s grpc.ServiceRegistrar, srv NewspaperServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNewspaperServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NewspaperService_ServiceDesc, srv)
+}
+
+func _NewspaperService_CreateNewspaper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNewspaperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewspaperServiceServer).CreateNewspaper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewspaperService_CreateNewspaper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewspaperServiceServer).CreateNewspaper(ctx, req.(*CreateNewspaperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewspaperService_GetNewspaper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewspaperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewspaperServiceServer).GetNewspaper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewspaperService_GetNewspaper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewspaperServiceServer).GetNewspaper(ctx, req.(*GetNewspaperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewspaperService_ListNewspapers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNewspapersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewspaperServiceServer).ListNewspapers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewspaperService_ListNewspapers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewspaperServiceServer).ListNewspapers(ctx, req.(*ListNewspapersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewspaperService_UpdateNewspaper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNewspaperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewspaperServiceServer).UpdateNewspaper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewspaperService_UpdateNewspaper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewspaperServiceServer).UpdateNewspaper(ctx, req.(*UpdateNewspaperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewspaperService_DeleteNewspaper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNewspaperRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewspaperServiceServer).DeleteNewspaper(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewspaperService_DeleteNewspaper_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewspaperServiceServer).DeleteNewspaper(ctx, req.(*DeleteNewspaperRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NewspaperService_ServiceDesc is the grpc.ServiceDesc for NewspaperService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NewspaperService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "newspaper.v1.NewspaperService",
+	HandlerType: (*NewspaperServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateNewspaper",
+			Handler:    _NewspaperService_CreateNewspaper_Handler,
+		},
+		{
+			MethodName: "GetNewspaper",
+			Handler:    _NewspaperService_GetNewspaper_Handler,
+		},
+		{
+			MethodName: "ListNewspapers",
+			Handler:    _NewspaperService_ListNewspapers_Handler,
+		},
+		{
+			MethodName: "UpdateNewspaper",
+			Handler:    _NewspaperService_UpdateNewspaper_Handler,
+		},
+		{
+			MethodName: "DeleteNewspaper",
+			Handler:    _NewspaperService_DeleteNewspaper_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "newspaper.proto",
+}
+
+const (
+	ArticleService_CreateArticle_FullMethodName = "/newspaper.v1.ArticleService/CreateArticle"
+	ArticleService_GetArticle_FullMethodName    = "/newspaper.v1.ArticleService/GetArticle"
+	ArticleService_ListArticles_FullMethodName  = "/newspaper.v1.ArticleService/ListArticles"
+	ArticleService_UpdateArticle_FullMethodName = "/newspaper.v1.ArticleService/UpdateArticle"
+	ArticleService_DeleteArticle_FullMethodName = "/newspaper.v1.ArticleService/DeleteArticle"
+)
+
+// ArticleServiceClient is the client API for ArticleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArticleServiceClient interface {
+	CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*ListArticlesResponse, error)
+	UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*Article, error)
+	DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type articleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArticleServiceClient(cc grpc.ClientConnInterface) ArticleServiceClient {
+	return &articleServiceClient{cc}
+}
+
+func (c *articleServiceClient) CreateArticle(ctx context.Context, in *CreateArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Article)
+	err := c.cc.Invoke(ctx, ArticleService_CreateArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Article)
+	err := c.cc.Invoke(ctx, ArticleService_GetArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) ListArticles(ctx context.Context, in *ListArticlesRequest, opts ...grpc.CallOption) (*ListArticlesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListArticlesResponse)
+	err := c.cc.Invoke(ctx, ArticleService_ListArticles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) UpdateArticle(ctx context.Context, in *UpdateArticleRequest, opts ...grpc.CallOption) (*Article, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Article)
+	err := c.cc.Invoke(ctx, ArticleService_UpdateArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *articleServiceClient) DeleteArticle(ctx context.Context, in *DeleteArticleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ArticleService_DeleteArticle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArticleServiceServer is the server API for ArticleService service.
+// All implementations must embed UnimplementedArticleServiceServer
+// for forward compatibility.
+type ArticleServiceServer interface {
+	CreateArticle(context.Context, *CreateArticleRequest) (*Article, error)
+	GetArticle(context.Context, *GetArticleRequest) (*Article, error)
+	ListArticles(context.Context, *ListArticlesRequest) (*ListArticlesResponse, error)
+	UpdateArticle(context.Context, *UpdateArticleRequest) (*Article, error)
+	DeleteArticle(context.Context, *DeleteArticleRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedArticleServiceServer()
+}
+
+// UnimplementedArticleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedArticleServiceServer struct{}
+
+func (UnimplementedArticleServiceServer) CreateArticle(context.Context, *CreateArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) GetArticle(context.Context, *GetArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) ListArticles(context.Context, *ListArticlesRequest) (*ListArticlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArticles not implemented")
+}
+func (UnimplementedArticleServiceServer) UpdateArticle(context.Context, *UpdateArticleRequest) (*Article, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) DeleteArticle(context.Context, *DeleteArticleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArticle not implemented")
+}
+func (UnimplementedArticleServiceServer) mustEmbedUnimplementedArticleServiceServer() {}
+func (UnimplementedArticleServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeArticleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArticleServiceServer will
+// result in compilation errors.
+type UnsafeArticleServiceServer interface {
+	mustEmbedUnimplementedArticleServiceServer()
+}
+
+func RegisterArticleServiceServer(s grpc.ServiceRegistrar, srv ArticleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedArticleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ArticleService_ServiceDesc, srv)
+}
+
+func _ArticleService_CreateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).CreateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_CreateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).CreateArticle(ctx, req.(*CreateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_GetArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).GetArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_GetArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).GetArticle(ctx, req.(*GetArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_ListArticles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArticlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).ListArticles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_ListArticles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).ListArticles(ctx, req.(*ListArticlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_UpdateArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).UpdateArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_UpdateArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).UpdateArticle(ctx, req.(*UpdateArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArticleService_DeleteArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).DeleteArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArticleService_DeleteArticle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).DeleteArticle(ctx, req.(*DeleteArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArticleService_ServiceDesc is the grpc.ServiceDesc for ArticleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArticleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "newspaper.v1.ArticleService",
+	HandlerType: (*ArticleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateArticle",
+			Handler:    _ArticleService_CreateArticle_Handler,
+		},
+		{
+			MethodName: "GetArticle",
+			Handler:    _ArticleService_GetArticle_Handler,
+		},
+		{
+			MethodName: "ListArticles",
+			Handler:    _ArticleService_ListArticles_Handler,
+		},
+		{
+			MethodName: "UpdateArticle",
+			Handler:    _ArticleService_UpdateArticle_Handler,
+		},
+		{
+			MethodName: "DeleteArticle",
+			Handler:    _ArticleService_DeleteArticle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "newspaper.proto",
+}
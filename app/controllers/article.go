@@ -1,9 +1,12 @@
 package controllers
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"net/http"
 
+	"gorm.io/gorm"
+
 	"go-api-newspaper/api"
 	"go-api-newspaper/app/models"
 	"go-api-newspaper/pkg/logger"
@@ -14,20 +17,27 @@ type ArticleHandler struct{}
 func (a *ArticleHandler) CreateArticle(c *gin.Context) {
 	var requestBody api.CreateArticleJSONRequestBody
 	if err := c.ShouldBindJSON(&requestBody); err != nil {
-		logger.Warn(err.Error())
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "error", err)
 		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
 		return
 	}
 
 	createdArticle, err := models.CreateArticle(
+		c.Request.Context(),
 		requestBody.Body,
 		requestBody.Year,
 		requestBody.Month,
 		requestBody.Day,
-		*requestBody.NewspaperID,
+		requestBody.NewspaperID,
+		requestBody.ColumnID,
 	)
+	if errors.Is(err, models.ErrColumnNotInNewspaper) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid column", "newspaper_id", requestBody.NewspaperID, "column_id", requestBody.ColumnID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
 	if err != nil {
-		logger.Error(err.Error())
+		logger.FromContext(c.Request.Context()).Errorw("failed to create article", "newspaper_id", requestBody.NewspaperID, "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
@@ -35,13 +45,182 @@ func (a *ArticleHandler) CreateArticle(c *gin.Context) {
 	c.JSON(http.StatusCreated, createdArticle) // 201 レスポンスに書き込み
 }
 
-func (a *ArticleHandler) GetArticleById(c *gin.Context, ID int) {
-	article, err := models.GetArticle(ID)
+func (a *ArticleHandler) GetArticleById(c *gin.Context, ID int, params api.GetArticleByIdParams) {
+	fields := requestFields(params.Fields, params.Exclude)
+	if params.Annotate != nil { // 読みを求めた場合は fields・exclude に関わらず読みを返す
+		fields = fields.With("annotation")
+	}
+	article, err := models.GetArticleWithFields(c.Request.Context(), ID, fields)
+	if abortUnknownField(c, err) {
+		return
+	}
 	if err != nil {
-		logger.Error(err.Error())
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
 
+	if params.Annotate != nil {
+		err = article.LoadAnnotation(c.Request.Context(), models.AnnotationFormat(*params.Annotate))
+		if errors.Is(err, models.ErrInvalidAnnotationFormat) {
+			logger.FromContext(c.Request.Context()).Warnw("invalid annotation format", "article_id", ID, "annotate", *params.Annotate, "error", err)
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Errorw("failed to annotate article", "article_id", ID, "error", err)
+			c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+	}
+
 	c.JSON(http.StatusOK, article)
 }
+
+// 一覧の取得件数の既定値（api.Limit の default と合わせる）
+const defaultListLimit = 20
+
+func (a *ArticleHandler) ListArticles(c *gin.Context, params api.ListArticlesParams) {
+	filter := models.ArticleFilter{
+		NewspaperID:   params.NewspaperID,
+		ColumnID:      params.ColumnID,
+		MinCharacters: params.MinCharacters,
+		MaxCharacters: params.MaxCharacters,
+	}
+	if params.Tag != nil {
+		filter.Tags = *params.Tag
+	}
+	order := models.OrderNewest
+	if params.Sort != nil {
+		order = models.ArticleOrder(*params.Sort)
+	}
+	limit, offset := defaultListLimit, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	articles, err := models.FindArticles(c.Request.Context(), filter, order, limit, offset, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list articles", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, articles)
+}
+
+func (a *ArticleHandler) UpdateArticleById(c *gin.Context, ID int) {
+	var requestBody api.UpdateArticleByIdJSONRequestBody
+	if !isPatchRequest(c) {
+		if err := c.ShouldBindJSON(&requestBody); err != nil {
+			logger.FromContext(c.Request.Context()).Warnw("invalid request body", "article_id", ID, "error", err)
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+	}
+
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if isPatchRequest(c) {
+		if err := patchArticle(c, article); err != nil {
+			status := patchErrorStatus(err)
+			if status == http.StatusInternalServerError {
+				logger.FromContext(c.Request.Context()).Errorw("failed to patch article", "article_id", ID, "error", err)
+			} else {
+				logger.FromContext(c.Request.Context()).Warnw("invalid patch", "article_id", ID, "error", err)
+			}
+			c.JSON(status, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+	} else {
+		applyArticleUpdate(article, requestBody)
+	}
+
+	err = article.Save(c.Request.Context()) // 本文が変わっていれば新しいリビジョンが記録される
+	if errors.Is(err, models.ErrColumnNotInNewspaper) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid column", "article_id", ID, "column_id", article.ColumnID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to update article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+func (a *ArticleHandler) DeleteArticleById(c *gin.Context, ID int) {
+	article := models.Article{ID: ID}
+
+	err := article.Delete(c.Request.Context())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.FromContext(c.Request.Context()).Warnw("article not found", "article_id", ID)
+		c.JSON(http.StatusNotFound, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to delete article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil) // 204
+}
+
+// 更新リクエストで指定されたフィールドだけを記事に反映する
+func applyArticleUpdate(article *models.Article, request api.ArticleUpdateRequest) {
+	if request.Body != nil {
+		article.Body = *request.Body
+	}
+	if request.Year != nil {
+		article.Year = *request.Year
+	}
+	if request.Month != nil {
+		article.Month = *request.Month
+	}
+	if request.Day != nil {
+		article.Day = *request.Day
+	}
+	if request.NewspaperID != nil {
+		article.NewspaperID = *request.NewspaperID
+	}
+	if request.ColumnID != nil {
+		article.ColumnID = request.ColumnID
+	}
+}
+
+// JSON Merge Patch・JSON Patch を記事に適用する（columnID を null にするか remove するとコラムから外す）
+func patchArticle(c *gin.Context, article *models.Article) error {
+	var patched api.ArticleCreateRequest
+	current := api.ArticleCreateRequest{
+		Body:        article.Body,
+		Year:        article.Year,
+		Month:       article.Month,
+		Day:         article.Day,
+		NewspaperID: article.NewspaperID,
+		ColumnID:    article.ColumnID,
+	}
+	if err := applyPatch(c, current, "ArticleCreateRequest", &patched); err != nil {
+		return err
+	}
+	article.Body = patched.Body
+	article.Year = patched.Year
+	article.Month = patched.Month
+	article.Day = patched.Day
+	article.NewspaperID = patched.NewspaperID
+	article.ColumnID = patched.ColumnID
+	return nil
+}
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+// 関連記事の取得件数の既定値（k の default と合わせる）
+const defaultRelatedLimit = 5
+
+func (a *ArticleHandler) ListRelatedArticles(c *gin.Context, ID int, params api.ListRelatedArticlesParams) {
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	k := defaultRelatedLimit
+	if params.K != nil {
+		k = *params.K
+	}
+	filter := models.ArticleFilter{
+		MinCharacters: params.MinCharacters,
+		MaxCharacters: params.MaxCharacters,
+	}
+	if params.SameNewspaper != nil && *params.SameNewspaper {
+		filter.NewspaperID = &article.NewspaperID
+	}
+	if params.Tag != nil {
+		filter.Tags = *params.Tag
+	}
+
+	related, err := models.FindRelatedArticles(c.Request.Context(), article, k, filter, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to find related articles", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, related)
+}
+
+// ReloadRelatedIndex は保存済みの語の出現回数から関連記事のインデックスを作り直す管理用のハンドラー
+// cmd/related で語の出現回数を作り直した後に呼び出す
+func ReloadRelatedIndex(c *gin.Context) {
+	articles, err := models.LoadRelatedIndex(c.Request.Context())
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to reload related index", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"articles": articles})
+}
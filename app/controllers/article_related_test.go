@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleRelatedControllersSuite struct {
+	tester.DBSQLiteSuite
+	articleHandler ArticleHandler
+}
+
+func TestArticleRelatedControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleRelatedControllersSuite))
+}
+
+func (suite *ArticleRelatedControllersSuite) TestListRelated() {
+	asahi, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	yomiuri, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	article, _ := models.CreateArticle(context.Background(), "桜の花見。", 2023, 4, 1, asahi.ID, nil)
+	models.CreateArticle(context.Background(), "桜の名所。", 2023, 4, 2, yomiuri.ID, nil)
+
+	// インデックスをデータベースから作り直す管理用のハンドラー
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request, _ = http.NewRequest("POST", "/admin/related-index/reload", nil)
+	ReloadRelatedIndex(ginContext)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().JSONEq(`{"articles":2}`, w.Body.String())
+
+	for _, tc := range []struct {
+		sameNewspaper bool
+		expected      int
+	}{
+		{false, 1},
+		{true, 0}, // 同じ新聞には似た記事がない
+	} {
+		params := api.ListRelatedArticlesParams{SameNewspaper: &tc.sameNewspaper}
+		request, _ := api.NewListRelatedArticlesRequest("/api/v1", article.ID, &params)
+		w = httptest.NewRecorder()
+		ginContext, _ = gin.CreateTestContext(w)
+		ginContext.Request = request
+		suite.articleHandler.ListRelatedArticles(ginContext, article.ID, params)
+
+		bodyBytes, _ := io.ReadAll(w.Body)
+		var related []api.RelatedArticleResponse
+		err := json.Unmarshal(bodyBytes, &related)
+		suite.Assert().Nil(err)
+		suite.Assert().Equal(http.StatusOK, w.Code)
+		suite.Assert().Len(related, tc.expected)
+	}
+}
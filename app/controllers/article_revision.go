@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+	"go-api-newspaper/pkg/textdiff"
+)
+
+func (a *ArticleHandler) ListArticleRevisions(c *gin.Context, ID int, params api.ListArticleRevisionsParams) {
+	if _, err := models.GetArticle(c.Request.Context(), ID); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	revisions, err := models.GetArticleRevisions(c.Request.Context(), ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list article revisions", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, revisions)
+}
+
+func (a *ArticleHandler) GetArticleRevision(c *gin.Context, ID int, rev int, params api.GetArticleRevisionParams) {
+	revision, err := models.GetArticleRevisionWithFields(c.Request.Context(), ID, rev, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article revision", "article_id", ID, "revision", rev, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, revision)
+}
+
+func (a *ArticleHandler) DiffArticleRevisions(c *gin.Context, ID int, rev int, params api.DiffArticleRevisionsParams) {
+	against := rev - 1 // 比較元が指定されていなければ直前のリビジョンと比較する
+	if params.Against != nil {
+		against = *params.Against
+	}
+	unit := api.Line
+	if params.Unit != nil {
+		unit = *params.Unit
+	}
+	if against < 1 {
+		message := fmt.Sprintf("revision %d has no previous revision to compare against", rev)
+		logger.FromContext(c.Request.Context()).Warnw(message, "article_id", ID, "revision", rev)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: message})
+		return
+	}
+
+	from, err := models.GetArticleRevision(c.Request.Context(), ID, against)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article revision", "article_id", ID, "revision", against, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	to, err := models.GetArticleRevision(c.Request.Context(), ID, rev)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article revision", "article_id", ID, "revision", rev, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	var edits []textdiff.Edit
+	if unit == api.Char {
+		edits = textdiff.Runes(from.Body, to.Body)
+	} else {
+		edits = textdiff.Lines(from.Body, to.Body)
+	}
+
+	response := api.ArticleDiffResponse{
+		From:  from.Revision,
+		To:    to.Revision,
+		Unit:  string(unit),
+		Edits: make([]api.DiffEdit, len(edits)),
+	}
+	for i, edit := range edits {
+		response.Edits[i] = api.DiffEdit{Op: api.DiffEditOp(edit.Op), Text: edit.Text}
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (a *ArticleHandler) RestoreArticleRevision(c *gin.Context, ID int, rev int) {
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if err := article.RestoreRevision(c.Request.Context(), rev); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to restore article revision", "article_id", ID, "revision", rev, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleRevisionControllersSuite struct {
+	tester.DBSQLiteSuite
+	articleHandler ArticleHandler
+}
+
+func TestArticleRevisionControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleRevisionControllersSuite))
+}
+
+// 2つのリビジョンを持つ記事を作成する
+func (suite *ArticleRevisionControllersSuite) createArticleWithRevisions() *models.Article {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "test")
+	article, _ := models.CreateArticle(context.Background(), "春はあけぼの。", 2023, 10, 1, newspaper.ID, nil)
+	article.Body = "夏はよる。"
+	suite.Assert().Nil(article.Save(context.Background()))
+	return article
+}
+
+func (suite *ArticleRevisionControllersSuite) TestList() {
+	article := suite.createArticleWithRevisions()
+
+	request, _ := api.NewListArticleRevisionsRequest("/api/v1", article.ID, &api.ListArticleRevisionsParams{})
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.ListArticleRevisions(ginContext, article.ID, api.ListArticleRevisionsParams{})
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var revisions []api.ArticleRevisionResponse
+	err := json.Unmarshal(bodyBytes, &revisions)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Len(revisions, 2)
+	suite.Assert().Equal("春はあけぼの。", revisions[0].Body)
+}
+
+func (suite *ArticleRevisionControllersSuite) TestDiffByChar() {
+	article := suite.createArticleWithRevisions()
+
+	unit := api.Char
+	request, _ := api.NewDiffArticleRevisionsRequest("/api/v1", article.ID, 2, &api.DiffArticleRevisionsParams{Unit: &unit})
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.DiffArticleRevisions(ginContext, article.ID, 2, api.DiffArticleRevisionsParams{Unit: &unit})
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var diff api.ArticleDiffResponse
+	err := json.Unmarshal(bodyBytes, &diff)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal(1, diff.From)
+	suite.Assert().Equal(2, diff.To)
+	suite.Assert().Equal("char", diff.Unit)
+	suite.Assert().Equal(api.DiffEdit{Op: api.Delete, Text: "春"}, diff.Edits[0])
+	suite.Assert().Equal(api.DiffEdit{Op: api.Insert, Text: "夏"}, diff.Edits[1])
+}
+
+func (suite *ArticleRevisionControllersSuite) TestDiffFirstRevisionFailure() {
+	article := suite.createArticleWithRevisions()
+
+	request, _ := api.NewDiffArticleRevisionsRequest("/api/v1", article.ID, 1, nil)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.DiffArticleRevisions(ginContext, article.ID, 1, api.DiffArticleRevisionsParams{})
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *ArticleRevisionControllersSuite) TestRestore() {
+	article := suite.createArticleWithRevisions()
+
+	request, _ := api.NewRestoreArticleRevisionRequest("/api/v1", article.ID, 1)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.RestoreArticleRevision(ginContext, article.ID, 1)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var articleResponse api.ArticleResponse
+	err := json.Unmarshal(bodyBytes, &articleResponse)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("春はあけぼの。", articleResponse.Body)
+}
@@ -0,0 +1,312 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+var (
+	errInvalidBatchOperation = errors.New("invalid batch operation")
+	errUnknownBatchRef       = errors.New("unknown batch ref")
+)
+
+type BatchHandler struct{}
+
+// 名前を付けた操作の結果（参照は同じリソースを指す場所にだけ使える）
+type batchRef struct {
+	resource api.BatchOperationResource
+	ID       int
+}
+
+// data の中で参照を使えるフィールドと、そのフィールドが指すリソース
+var batchRefFields = map[string]api.BatchOperationResource{
+	"newspaperID": api.BatchNewspaper,
+}
+
+// バッチで操作できるリソース（data は検証済みで参照を解決したJSON）
+type batchResource struct {
+	createSchema string
+	updateSchema string
+	create       func(ctx context.Context, data []byte) (int, interface{}, error)
+	update       func(ctx context.Context, ID int, data []byte) (interface{}, error)
+	delete       func(ctx context.Context, ID int) error
+}
+
+var batchResources = map[api.BatchOperationResource]batchResource{
+	api.BatchNewspaper: {
+		createSchema: "NewspaperCreateRequest",
+		updateSchema: "NewspaperUpdateRequest",
+		create: func(ctx context.Context, data []byte) (int, interface{}, error) {
+			var request api.NewspaperCreateRequest
+			if err := json.Unmarshal(data, &request); err != nil {
+				return 0, nil, err
+			}
+			newspaper, err := models.CreateNewspaper(ctx, request.Title)
+			if err != nil {
+				return 0, nil, err
+			}
+			return newspaper.ID, newspaper, nil
+		},
+		update: func(ctx context.Context, ID int, data []byte) (interface{}, error) {
+			var request api.NewspaperUpdateRequest
+			if err := json.Unmarshal(data, &request); err != nil {
+				return nil, err
+			}
+			newspaper, err := models.GetNewspaper(ctx, ID)
+			if err != nil {
+				return nil, err
+			}
+			applyNewspaperUpdate(newspaper, request)
+			if err := newspaper.Save(ctx); err != nil {
+				return nil, err
+			}
+			return newspaper, nil
+		},
+		delete: func(ctx context.Context, ID int) error {
+			newspaper := models.Newspaper{ID: ID}
+			return newspaper.Delete(ctx)
+		},
+	},
+	api.BatchArticle: {
+		createSchema: "ArticleCreateRequest",
+		updateSchema: "ArticleUpdateRequest",
+		create: func(ctx context.Context, data []byte) (int, interface{}, error) {
+			var request api.ArticleCreateRequest
+			if err := json.Unmarshal(data, &request); err != nil {
+				return 0, nil, err
+			}
+			article, err := models.CreateArticle(ctx, request.Body, request.Year, request.Month, request.Day, request.NewspaperID, request.ColumnID)
+			if err != nil {
+				return 0, nil, err
+			}
+			return article.ID, article, nil
+		},
+		update: func(ctx context.Context, ID int, data []byte) (interface{}, error) {
+			var request api.ArticleUpdateRequest
+			if err := json.Unmarshal(data, &request); err != nil {
+				return nil, err
+			}
+			article, err := models.GetArticle(ctx, ID)
+			if err != nil {
+				return nil, err
+			}
+			applyArticleUpdate(article, request)
+			if err := article.Save(ctx); err != nil {
+				return nil, err
+			}
+			return article, nil
+		},
+		delete: func(ctx context.Context, ID int) error {
+			article := models.Article{ID: ID}
+			return article.Delete(ctx)
+		},
+	},
+}
+
+// バッチの操作の失敗（失敗した操作の位置を持つ）
+type batchError struct {
+	index int
+	err   error
+}
+
+func (e *batchError) Error() string { return e.err.Error() }
+
+func (e *batchError) Unwrap() error { return e.err }
+
+// 操作の内容が不正な場合は 400、対象が存在しない場合は 404、実行に失敗した場合は 500 を返す
+func (e *batchError) status() int {
+	if errors.Is(e.err, errInvalidBatchOperation) || errors.Is(e.err, errUnknownBatchRef) || errors.Is(e.err, models.ErrColumnNotInNewspaper) {
+		return http.StatusBadRequest
+	}
+	if errors.Is(e.err, gorm.ErrRecordNotFound) {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func (b *BatchHandler) ExecuteBatch(c *gin.Context) {
+	var requestBody api.ExecuteBatchJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, api.BatchErrorResponse{Message: err.Error(), Index: -1})
+		return
+	}
+
+	// 途中の操作が失敗すると、それまでの操作も含めて全て取り消す
+	var results []api.BatchResult
+	err := models.Transaction(c.Request.Context(), func(ctx context.Context) error {
+		results = make([]api.BatchResult, 0, len(requestBody.Operations))
+		refs := map[string]batchRef{}
+		for i, operation := range requestBody.Operations {
+			result, err := executeBatchOperation(ctx, operation, refs)
+			if err != nil {
+				return &batchError{index: i, err: err}
+			}
+			results = append(results, result)
+		}
+		return nil
+	})
+	var failed *batchError
+	if errors.As(err, &failed) {
+		if failed.status() == http.StatusBadRequest {
+			logger.FromContext(c.Request.Context()).Warnw("invalid batch operation", "index", failed.index, "error", failed.err)
+		} else {
+			logger.FromContext(c.Request.Context()).Errorw("failed to execute batch operation", "index", failed.index, "error", failed.err)
+		}
+		c.JSON(failed.status(), api.BatchErrorResponse{Message: failed.Error(), Index: failed.index})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to commit batch", "error", err)
+		c.JSON(http.StatusInternalServerError, api.BatchErrorResponse{Message: err.Error(), Index: -1})
+		return
+	}
+
+	c.JSON(http.StatusOK, api.BatchResponse{Results: results})
+}
+
+// 1つの操作を実行し、名前が付いていれば結果のリソースとIDを refs に記録する
+func executeBatchOperation(ctx context.Context, operation api.BatchOperation, refs map[string]batchRef) (api.BatchResult, error) {
+	result := api.BatchResult{Ref: operation.Ref}
+	if operation.Ref != nil {
+		if _, ok := refs[*operation.Ref]; ok {
+			return result, fmt.Errorf("%w: ref %q is already used", errInvalidBatchOperation, *operation.Ref)
+		}
+	}
+	resource, ok := batchResources[operation.Resource]
+	if !ok {
+		return result, fmt.Errorf("%w: unknown resource %q", errInvalidBatchOperation, operation.Resource)
+	}
+
+	var ID int
+	if operation.Method == api.BatchCreate {
+		if operation.Id != nil {
+			return result, fmt.Errorf("%w: id is not allowed for create", errInvalidBatchOperation)
+		}
+	} else {
+		if operation.Id == nil {
+			return result, fmt.Errorf("%w: id is required for %s", errInvalidBatchOperation, operation.Method)
+		}
+		var err error
+		if ID, err = batchOperationID(*operation.Id, operation.Resource, refs); err != nil {
+			return result, err
+		}
+	}
+
+	var body interface{}
+	switch operation.Method {
+	case api.BatchCreate:
+		data, err := batchOperationData(operation, resource.createSchema, refs)
+		if err != nil {
+			return result, err
+		}
+		if ID, body, err = resource.create(ctx, data); err != nil {
+			return result, err
+		}
+		result.Status = http.StatusCreated
+	case api.BatchUpdate:
+		data, err := batchOperationData(operation, resource.updateSchema, refs)
+		if err != nil {
+			return result, err
+		}
+		if body, err = resource.update(ctx, ID, data); err != nil {
+			return result, err
+		}
+		result.Status = http.StatusOK
+	case api.BatchDelete:
+		if err := resource.delete(ctx, ID); err != nil {
+			return result, err
+		}
+		result.Status = http.StatusNoContent
+	default:
+		return result, fmt.Errorf("%w: unknown method %q", errInvalidBatchOperation, operation.Method)
+	}
+
+	if body != nil {
+		result.Body = &body
+	}
+	if operation.Ref != nil {
+		refs[*operation.Ref] = batchRef{resource: operation.Resource, ID: ID}
+	}
+	return result, nil
+}
+
+// 対象のIDを整数か {"$ref": "名前"}（同じリソースの操作の名前）から求める
+func batchOperationID(value interface{}, resource api.BatchOperationResource, refs map[string]batchRef) (int, error) {
+	resolved, err := resolveBatchRefs(value, resource, refs)
+	if err != nil {
+		return 0, err
+	}
+	ID, ok := resolved.(float64)
+	if !ok || ID != math.Trunc(ID) {
+		return 0, fmt.Errorf("%w: id must be an integer or a ref", errInvalidBatchOperation)
+	}
+	return int(ID), nil
+}
+
+// 操作の内容の参照を解決し、リクエストボディのスキーマで検証したJSONを返す
+func batchOperationData(operation api.BatchOperation, schema string, refs map[string]batchRef) ([]byte, error) {
+	if operation.Data == nil {
+		return nil, fmt.Errorf("%w: data is required for %s", errInvalidBatchOperation, operation.Method)
+	}
+	resolved, err := resolveBatchRefs(*operation.Data, "", refs)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSchema(schema, resolved); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidBatchOperation, err)
+	}
+	return json.Marshal(resolved)
+}
+
+// 値の中の {"$ref": "名前"} を、その名前の操作の結果のIDに置き換える
+// 参照は resource のリソースを指す場所（data では batchRefFields のフィールド）にだけ使え、別のリソースの操作の名前はエラーにする
+func resolveBatchRefs(value interface{}, resource api.BatchOperationResource, refs map[string]batchRef) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if name, ok := v["$ref"].(string); ok && len(v) == 1 {
+			ref, ok := refs[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", errUnknownBatchRef, name)
+			}
+			if resource == "" {
+				return nil, fmt.Errorf("%w: ref %q is not allowed here", errInvalidBatchOperation, name)
+			}
+			if ref.resource != resource {
+				return nil, fmt.Errorf("%w: ref %q is a %s, not a %s", errInvalidBatchOperation, name, ref.resource, resource)
+			}
+			return float64(ref.ID), nil
+		}
+		resolved := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			r, err := resolveBatchRefs(item, batchRefFields[key], refs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[key] = r
+		}
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, item := range v {
+			r, err := resolveBatchRefs(item, resource, refs)
+			if err != nil {
+				return nil, err
+			}
+			resolved[i] = r
+		}
+		return resolved, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type BatchControllersSuite struct {
+	tester.DBSQLiteSuite
+	batchHandler BatchHandler
+}
+
+func TestBatchControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(BatchControllersSuite))
+}
+
+func (suite *BatchControllersSuite) executeBatch(body string) *httptest.ResponseRecorder {
+	var requestBody api.ExecuteBatchJSONRequestBody
+	suite.Require().Nil(json.Unmarshal([]byte(body), &requestBody))
+	request, _ := api.NewExecuteBatchRequest("/api/v1", requestBody)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.batchHandler.ExecuteBatch(ginContext)
+	return w
+}
+
+func (suite *BatchControllersSuite) TestExecuteWithRefs() {
+	w := suite.executeBatch(`{"operations": [
+		{"ref": "paper", "method": "create", "resource": "newspaper", "data": {"title": "毎日新聞"}},
+		{"ref": "first", "method": "create", "resource": "article", "data": {"body": "梅が咲いた。", "year": 2024, "month": 2, "day": 1, "newspaperID": {"$ref": "paper"}}},
+		{"method": "create", "resource": "article", "data": {"body": "雪が降った。", "year": 2024, "month": 2, "day": 2, "newspaperID": {"$ref": "paper"}}},
+		{"method": "update", "resource": "article", "id": {"$ref": "first"}, "data": {"body": "梅が満開になった。"}},
+		{"method": "update", "resource": "newspaper", "id": {"$ref": "paper"}, "data": {"title": "毎日新聞 朝刊"}}
+	]}`)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var response struct {
+		Results []struct {
+			Ref    *string         `json:"ref"`
+			Status int             `json:"status"`
+			Body   json.RawMessage `json:"body"`
+		} `json:"results"`
+	}
+	err := json.Unmarshal(w.Body.Bytes(), &response)
+	suite.Assert().Nil(err)
+	suite.Require().Len(response.Results, 5)
+	suite.Assert().Equal("paper", *response.Results[0].Ref)
+	suite.Assert().Equal(http.StatusCreated, response.Results[0].Status)
+	suite.Assert().Equal(http.StatusOK, response.Results[3].Status)
+
+	var newspaper api.NewspaperResponse
+	suite.Assert().Nil(json.Unmarshal(response.Results[4].Body, &newspaper))
+	suite.Assert().Equal("毎日新聞 朝刊", newspaper.Title)
+	var first, updated api.ArticleResponse
+	suite.Assert().Nil(json.Unmarshal(response.Results[1].Body, &first))
+	suite.Assert().Nil(json.Unmarshal(response.Results[3].Body, &updated))
+	suite.Assert().Equal(newspaper.Id, first.NewspaperID)
+	suite.Assert().Equal(first.Id, updated.Id)
+	suite.Assert().Equal("梅が満開になった。", updated.Body)
+
+	articles, err := models.FindArticles(context.Background(), models.ArticleFilter{NewspaperID: &newspaper.Id}, models.OrderOldest, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 2)
+
+	w = suite.executeBatch(fmt.Sprintf(`{"operations": [
+		{"method": "delete", "resource": "article", "id": %d}
+	]}`, first.Id))
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().JSONEq(`{"results": [{"status": 204}]}`, w.Body.String())
+	_, err = models.GetArticle(context.Background(), first.Id)
+	suite.Assert().NotNil(err)
+}
+
+func (suite *BatchControllersSuite) TestRollbackOnInvalidOperation() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	other, _ := models.CreateNewspaper(context.Background(), "産経新聞")
+	column, _ := models.CreateColumn(context.Background(), other.ID, "産経抄", "sankeisho", "", "")
+
+	w := suite.executeBatch(fmt.Sprintf(`{"operations": [
+		{"method": "update", "resource": "newspaper", "id": %d, "data": {"title": "読売新聞 夕刊"}},
+		{"method": "create", "resource": "article", "data": {"body": "台風が来た。", "year": 2024, "month": 9, "day": 1, "newspaperID": %d}},
+		{"method": "create", "resource": "article", "data": {"body": "虫の声がする。", "year": 2024, "month": 9, "day": 2, "newspaperID": %d, "columnID": %d}}
+	]}`, newspaper.ID, newspaper.ID, newspaper.ID, column.ID))
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+	var response api.BatchErrorResponse
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(2, response.Index)
+
+	// 成功していた操作も取り消される
+	unchanged, err := models.GetNewspaper(context.Background(), newspaper.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("読売新聞", unchanged.Title)
+	articles, err := models.FindArticles(context.Background(), models.ArticleFilter{NewspaperID: &newspaper.ID}, models.OrderOldest, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(articles)
+}
+
+func (suite *BatchControllersSuite) TestInvalidOperations() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "東京新聞")
+
+	for name, testCase := range map[string]struct {
+		body  string
+		index int
+	}{
+		"unknown ref": {`{"operations": [
+			{"method": "create", "resource": "article", "data": {"body": "本文", "year": 2024, "month": 1, "day": 1, "newspaperID": {"$ref": "missing"}}}
+		]}`, 0},
+		"duplicate ref": {`{"operations": [
+			{"ref": "paper", "method": "create", "resource": "newspaper", "data": {"title": "A"}},
+			{"ref": "paper", "method": "create", "resource": "newspaper", "data": {"title": "B"}}
+		]}`, 1},
+		"missing required field": {`{"operations": [
+			{"method": "create", "resource": "newspaper", "data": {}}
+		]}`, 0},
+		"wrong field type": {fmt.Sprintf(`{"operations": [
+			{"method": "update", "resource": "newspaper", "id": %d, "data": {"title": 1}}
+		]}`, newspaper.ID), 0},
+		"missing id": {`{"operations": [
+			{"method": "delete", "resource": "article"}
+		]}`, 0},
+		"id for create": {`{"operations": [
+			{"method": "create", "resource": "newspaper", "id": 1, "data": {"title": "A"}}
+		]}`, 0},
+		"ref to another resource as id": {`{"operations": [
+			{"ref": "paper", "method": "create", "resource": "newspaper", "data": {"title": "A"}},
+			{"method": "delete", "resource": "article", "id": {"$ref": "paper"}}
+		]}`, 1},
+		"ref to another resource in data": {fmt.Sprintf(`{"operations": [
+			{"ref": "story", "method": "create", "resource": "article", "data": {"body": "本文", "year": 2024, "month": 1, "day": 1, "newspaperID": %d}},
+			{"method": "create", "resource": "article", "data": {"body": "本文", "year": 2024, "month": 1, "day": 2, "newspaperID": {"$ref": "story"}}}
+		]}`, newspaper.ID), 1},
+		"ref in a field that is not a reference": {`{"operations": [
+			{"ref": "paper", "method": "create", "resource": "newspaper", "data": {"title": "A"}},
+			{"method": "update", "resource": "newspaper", "id": {"$ref": "paper"}, "data": {"title": {"$ref": "paper"}}}
+		]}`, 1},
+		"missing data": {fmt.Sprintf(`{"operations": [
+			{"method": "update", "resource": "newspaper", "id": %d}
+		]}`, newspaper.ID), 0},
+	} {
+		w := suite.executeBatch(testCase.body)
+		suite.Assert().Equal(http.StatusBadRequest, w.Code, name)
+		var response api.BatchErrorResponse
+		suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response), name)
+		suite.Assert().Equal(testCase.index, response.Index, name)
+	}
+}
+
+func (suite *BatchControllersSuite) TestNotFound() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "日本経済新聞")
+
+	w := suite.executeBatch(fmt.Sprintf(`{"operations": [
+		{"method": "update", "resource": "newspaper", "id": %d, "data": {"title": "日経"}},
+		{"method": "update", "resource": "article", "id": 0, "data": {"body": "本文"}}
+	]}`, newspaper.ID))
+
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+	var response api.BatchErrorResponse
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(1, response.Index)
+	unchanged, _ := models.GetNewspaper(context.Background(), newspaper.ID)
+	suite.Assert().Equal("日本経済新聞", unchanged.Title)
+}
+
+func (suite *BatchControllersSuite) TestDeleteMissing() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "北海道新聞")
+	article, _ := models.CreateArticle(ctx, "流氷が来た。", 2024, 2, 1, newspaper.ID, nil)
+	suite.Assert().Nil(article.Delete(ctx))
+	pending, _ := models.OutboxStore{}.Pending(ctx, 1000)
+
+	// 存在しない記事の削除は 404 になり、それまでの操作も含めてイベントは書かれない
+	w := suite.executeBatch(fmt.Sprintf(`{"operations": [
+		{"method": "delete", "resource": "newspaper", "id": %d},
+		{"method": "delete", "resource": "article", "id": %d}
+	]}`, newspaper.ID, article.ID))
+
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
+	var response api.BatchErrorResponse
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal(1, response.Index)
+	after, _ := models.OutboxStore{}.Pending(ctx, 1000)
+	suite.Assert().Len(after, len(pending))
+	_, err := models.GetNewspaper(ctx, newspaper.ID)
+	suite.Assert().Nil(err)
+}
@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+type ColumnHandler struct{}
+
+func (a *ColumnHandler) CreateColumn(c *gin.Context) {
+	var requestBody api.CreateColumnJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	var description, schedule string
+	if requestBody.Description != nil {
+		description = *requestBody.Description
+	}
+	if requestBody.Schedule != nil {
+		schedule = string(*requestBody.Schedule)
+	}
+
+	createdColumn, err := models.CreateColumn(
+		c.Request.Context(),
+		requestBody.NewspaperID,
+		requestBody.Name,
+		requestBody.Slug,
+		description,
+		schedule,
+	)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to create column", "newspaper_id", requestBody.NewspaperID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, createdColumn)
+}
+
+func (a *ColumnHandler) GetColumnById(c *gin.Context, ID int, params api.GetColumnByIdParams) {
+	column, err := models.GetColumnWithFields(c.Request.Context(), ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get column", "column_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, column)
+}
+
+func (a *ColumnHandler) ListNewspaperColumns(c *gin.Context, ID int, params api.ListNewspaperColumnsParams) {
+	if _, err := models.GetNewspaper(c.Request.Context(), ID); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get newspaper", "newspaper_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	columns, err := models.GetNewspaperColumns(c.Request.Context(), ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list columns", "newspaper_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, columns)
+}
+
+func (a *ColumnHandler) UpdateColumnById(c *gin.Context, ID int) {
+	var requestBody api.UpdateColumnByIdJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "column_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	column, err := models.GetColumn(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get column", "column_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if requestBody.Name != nil {
+		column.Name = *requestBody.Name
+	}
+	if requestBody.Slug != nil {
+		column.Slug = *requestBody.Slug
+	}
+	if requestBody.Description != nil {
+		column.Description = *requestBody.Description
+	}
+	if requestBody.Schedule != nil {
+		column.Schedule = string(*requestBody.Schedule)
+	}
+
+	if err := column.Save(c.Request.Context()); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to update column", "column_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, column)
+}
+
+func (a *ColumnHandler) DeleteColumnById(c *gin.Context, ID int) {
+	column := models.Column{ID: ID}
+
+	if err := column.Delete(c.Request.Context()); err != nil { // 記事はコラムとの関連だけが外れる
+		logger.FromContext(c.Request.Context()).Errorw("failed to delete column", "column_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil) // 204
+}
@@ -0,0 +1,109 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ColumnControllersSuite struct {
+	tester.DBSQLiteSuite
+	columnHandler  ColumnHandler
+	articleHandler ArticleHandler
+}
+
+func TestColumnControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(ColumnControllersSuite))
+}
+
+func (suite *ColumnControllersSuite) TestCreateAndList() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+
+	schedule := api.Weekdays
+	request, _ := api.NewCreateColumnRequest("/api/v1", api.CreateColumnJSONRequestBody{
+		NewspaperID: newspaper.ID,
+		Name:        "天声人語",
+		Slug:        "tensei-jingo",
+		Schedule:    &schedule,
+	})
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.columnHandler.CreateColumn(ginContext)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var column api.ColumnResponse
+	err := json.Unmarshal(bodyBytes, &column)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusCreated, w.Code)
+	suite.Assert().Equal("tensei-jingo", column.Slug)
+	suite.Assert().Equal(api.Weekdays, column.Schedule)
+
+	request, _ = api.NewListNewspaperColumnsRequest("/api/v1", newspaper.ID, &api.ListNewspaperColumnsParams{})
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.columnHandler.ListNewspaperColumns(ginContext, newspaper.ID, api.ListNewspaperColumnsParams{})
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var columns []api.ColumnResponse
+	err = json.Unmarshal(bodyBytes, &columns)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Len(columns, 1)
+	suite.Assert().Equal(column.Id, columns[0].Id)
+}
+
+func (suite *ColumnControllersSuite) TestUpdate() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	column, _ := models.CreateColumn(context.Background(), newspaper.ID, "編集手帳", "henshu-techo", "", "")
+
+	description := "朝刊1面のコラム"
+	request, _ := api.NewUpdateColumnByIdRequest("/api/v1", column.ID, api.UpdateColumnByIdJSONRequestBody{
+		Description: &description,
+	})
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.columnHandler.UpdateColumnById(ginContext, column.ID)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var updated api.ColumnResponse
+	err := json.Unmarshal(bodyBytes, &updated)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("編集手帳", updated.Name)
+	suite.Assert().Equal(description, updated.Description)
+}
+
+// 別の新聞のコラムを参照する記事は作成できない
+func (suite *ColumnControllersSuite) TestCreateArticleWithOtherNewspaperColumn() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "毎日新聞")
+	other, _ := models.CreateNewspaper(context.Background(), "日経新聞")
+	column, _ := models.CreateColumn(context.Background(), newspaper.ID, "余録", "yoroku", "", "")
+
+	request, _ := api.NewCreateArticleRequest("/api/v1", api.CreateArticleJSONRequestBody{
+		Body:        "本文",
+		Year:        2023,
+		Month:       10,
+		Day:         1,
+		NewspaperID: other.ID,
+		ColumnID:    &column.ID,
+	})
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.CreateArticle(ginContext)
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,35 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 本文のハッシュをETagとして付けてレスポンスを返す
+// If-None-Match のETagと一致する場合は本文を省略して304を返す
+func writeWithETag(c *gin.Context, ifNoneMatch *string, contentType string, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	c.Header("ETag", etag)
+	if ifNoneMatch != nil && etagMatches(*ifNoneMatch, etag) {
+		c.Status(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	c.Data(http.StatusOK, contentType, body)
+}
+
+// If-None-Match に列挙されたETagのいずれかが一致するかを判定する（GETなので弱い比較で W/ は無視する）
+func etagMatches(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+	"go-api-newspaper/pkg/outbox"
+)
+
+// 何も送るイベントがない間に接続を保つためのコメントを送る間隔（プロキシに切断されないように）
+var eventStreamHeartbeat = 15 * time.Second
+
+type EventHandler struct{}
+
+// StreamEvents は記事・新聞のイベントをServer-Sent Eventsで送り続ける
+// イベントのIDにはアウトボックスのイベントに届けた順に付けた番号を使う（トランザクションが確定する順に増えるため）
+// Last-Event-ID を指定した場合は、その番号より後のイベントをアウトボックスから送り直してから、新しいイベントを送る
+func (e *EventHandler) StreamEvents(c *gin.Context, params api.StreamEventsParams) {
+	ctx := c.Request.Context()
+	lastSequence := 0
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		sequence, err := strconv.Atoi(*params.LastEventID)
+		if err != nil || sequence < 0 {
+			logger.FromContext(ctx).Warnw("invalid Last-Event-ID", "lastEventID", *params.LastEventID)
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: "Last-Event-ID must be an event ID"})
+			return
+		}
+		lastSequence = sequence
+	}
+
+	// 送り直すイベントを読む前に購読し、読んでいる間に届いたイベントを取りこぼさないようにする
+	// 両方から届いたイベントは番号で重複を除く
+	live, unsubscribe := models.EventBroker.Subscribe()
+	defer unsubscribe()
+
+	var replay []outbox.Event
+	truncated := false
+	if params.LastEventID != nil {
+		var err error
+		replay, truncated, err = models.GetEventsAfter(ctx, lastSequence, models.EventReplayLimit)
+		if err != nil {
+			logger.FromContext(ctx).Errorw("failed to get events", "lastEventID", lastSequence, "error", err)
+			c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no") // nginxなどでバッファリングせずに送る
+	c.Status(http.StatusOK)
+	c.Writer.WriteHeaderNow()
+
+	send := func(event outbox.Event) {
+		if event.Sequence <= lastSequence {
+			return
+		}
+		lastSequence = event.Sequence
+		if params.NewspaperID != nil {
+			if newspaperID, ok := models.EventNewspaperID(event); !ok || newspaperID != *params.NewspaperID {
+				return
+			}
+		}
+		c.Render(-1, sse.Event{Id: strconv.Itoa(event.Sequence), Event: event.Type, Data: event.Payload})
+	}
+
+	// 送り直すイベントが多すぎて古いものを省いた場合は、クライアントに状態を読み直させる
+	if truncated {
+		c.Render(-1, sse.Event{Event: "reset", Data: "{}"})
+	}
+	for _, event := range replay {
+		send(event)
+	}
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(eventStreamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-live:
+			if !ok { // 受け取りが遅れて購読が打ち切られたか、サーバーが終了する（クライアントは Last-Event-ID を付けて再接続する）
+				return
+			}
+			send(event)
+		case <-heartbeat.C:
+			c.Writer.WriteString(": heartbeat\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
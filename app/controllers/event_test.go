@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/tester"
+)
+
+type EventControllersSuite struct {
+	tester.DBSQLiteSuite
+	eventHandler EventHandler
+}
+
+func TestEventControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(EventControllersSuite))
+}
+
+// アウトボックスのイベントに届けた順の番号を付け、最後に付けた番号を返す
+func (suite *EventControllersSuite) lastEventID() int {
+	_, err := outbox.NewDispatcher(models.OutboxStore{}, 1000).DispatchOnce(context.Background())
+	suite.Require().Nil(err)
+	events, _, err := models.GetEventsAfter(context.Background(), 0, 1)
+	suite.Require().Nil(err)
+	if len(events) == 0 {
+		return 0
+	}
+	return events[0].Sequence
+}
+
+// ストリームに接続し、live のイベントを配ってから切断するまでに送られた本文を返す
+func (suite *EventControllersSuite) stream(params api.StreamEventsParams, live ...outbox.Event) *httptest.ResponseRecorder {
+	ctx, cancel := context.WithCancel(context.Background())
+	request, _ := api.NewStreamEventsRequest("/api/v1", &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request.WithContext(ctx)
+
+	done := make(chan struct{})
+	go func() {
+		suite.eventHandler.StreamEvents(ginContext, params)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond) // 購読を始めるのを待つ
+	for _, event := range live {
+		models.EventBroker.Publish(ctx, event)
+	}
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+	return w
+}
+
+// 送られたイベントの id と event の組
+func eventIDs(body string) []string {
+	var ids []string
+	for _, block := range strings.Split(body, "\n\n") {
+		var id, event string
+		for _, line := range strings.Split(block, "\n") {
+			if strings.HasPrefix(line, "id:") {
+				id = line[len("id:"):]
+			}
+			if strings.HasPrefix(line, "event:") {
+				event = line[len("event:"):]
+			}
+		}
+		if event != "" {
+			ids = append(ids, id+" "+event)
+		}
+	}
+	return ids
+}
+
+func (suite *EventControllersSuite) TestStreamEvents() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	other, _ := models.CreateNewspaper(ctx, "毎日新聞")
+	lastID := strconv.Itoa(suite.lastEventID())
+
+	article, _ := models.CreateArticle(ctx, "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+	models.CreateArticle(ctx, "雨が降った。", 2023, 4, 1, other.ID, nil)
+	newArticle, _ := models.CreateArticle(ctx, "桜が散った。", 2023, 4, 8, newspaper.ID, nil)
+	events, _, _ := models.GetEventsAfter(ctx, suite.lastEventID()-1, 1)
+
+	// 指定した新聞のイベントだけを送り直す（送り直したイベントが後から配られても一度だけ送る）
+	params := api.StreamEventsParams{NewspaperID: &newspaper.ID, LastEventID: &lastID}
+	w := suite.stream(params, events[0], events[0])
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("text/event-stream", w.Header().Get("Content-Type"))
+	created, _ := strconv.Atoi(lastID)
+	suite.Assert().Equal([]string{
+		strconv.Itoa(created+1) + " article.created",
+		strconv.Itoa(events[0].Sequence) + " article.created",
+	}, eventIDs(w.Body.String()))
+	suite.Assert().Contains(w.Body.String(), `"id":`+strconv.Itoa(article.ID))
+	suite.Assert().Contains(w.Body.String(), `"id":`+strconv.Itoa(newArticle.ID))
+}
+
+func (suite *EventControllersSuite) TestStreamEventsWithoutLastEventID() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "読売新聞")
+	models.CreateArticle(ctx, "梅雨が明けた。", 2023, 7, 20, newspaper.ID, nil)
+	events, _, _ := models.GetEventsAfter(ctx, suite.lastEventID()-1, 1)
+
+	// 接続前のイベントは送らない
+	w := suite.stream(api.StreamEventsParams{}, events[0])
+	suite.Assert().Equal([]string{strconv.Itoa(events[0].Sequence) + " article.created"}, eventIDs(w.Body.String()))
+}
+
+func (suite *EventControllersSuite) TestStreamEventsReset() {
+	ctx := context.Background()
+	lastID := strconv.Itoa(suite.lastEventID())
+	models.CreateNewspaper(ctx, "東京新聞")
+	models.CreateNewspaper(ctx, "中日新聞")
+	latest := strconv.Itoa(suite.lastEventID())
+
+	limit := models.EventReplayLimit
+	models.EventReplayLimit = 1
+	defer func() { models.EventReplayLimit = limit }()
+
+	// 送り直せないイベントがある場合は reset を送ってから最新のイベントを送る
+	w := suite.stream(api.StreamEventsParams{LastEventID: &lastID})
+	suite.Assert().Equal([]string{" reset", latest + " newspaper.created"}, eventIDs(w.Body.String()))
+}
+
+func (suite *EventControllersSuite) TestStreamEventsCommitOrder() {
+	lastID := suite.lastEventID()
+	lastEventID := strconv.Itoa(lastID)
+
+	// 後から確定したイベント（IDが小さい）も、届けた順の番号が大きければ送る
+	w := suite.stream(api.StreamEventsParams{LastEventID: &lastEventID},
+		outbox.Event{ID: 1001, Sequence: lastID + 1, Type: "newspaper.created", Payload: []byte(`{"id":1}`)},
+		outbox.Event{ID: 1000, Sequence: lastID + 2, Type: "newspaper.created", Payload: []byte(`{"id":2}`)},
+	)
+	suite.Assert().Equal([]string{
+		strconv.Itoa(lastID+1) + " newspaper.created",
+		strconv.Itoa(lastID+2) + " newspaper.created",
+	}, eventIDs(w.Body.String()))
+}
+
+func (suite *EventControllersSuite) TestStreamEventsBrokerClosed() {
+	broker := models.EventBroker
+	models.EventBroker = outbox.NewBroker(64)
+	defer func() { models.EventBroker = broker }()
+
+	// サーバーの終了時に購読を打ち切ると、ストリームを終える
+	params := api.StreamEventsParams{}
+	request, _ := api.NewStreamEventsRequest("/api/v1", &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	done := make(chan struct{})
+	go func() {
+		suite.eventHandler.StreamEvents(ginContext, params)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	models.EventBroker.Close()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		suite.Fail("stream did not end after the broker was closed")
+	}
+}
+
+func (suite *EventControllersSuite) TestStreamEventsInvalidLastEventID() {
+	lastID := "abc"
+	params := api.StreamEventsParams{LastEventID: &lastID}
+	request, _ := api.NewStreamEventsRequest("/api/v1", &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.eventHandler.StreamEvents(ginContext, params)
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
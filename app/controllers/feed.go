@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/feeds"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/configs"
+	"go-api-newspaper/pkg/logger"
+)
+
+type FeedHandler struct{}
+
+// フィードの形式
+type feedFormat string
+
+const (
+	feedRSS  feedFormat = "rss"
+	feedAtom feedFormat = "atom"
+)
+
+func (f *FeedHandler) GetRssFeed(c *gin.Context, params api.GetRssFeedParams) {
+	writeFeed(c, nil, feedRSS, params.Limit, params.IfNoneMatch)
+}
+
+func (f *FeedHandler) GetAtomFeed(c *gin.Context, params api.GetAtomFeedParams) {
+	writeFeed(c, nil, feedAtom, params.Limit, params.IfNoneMatch)
+}
+
+func (f *FeedHandler) GetNewspaperRssFeed(c *gin.Context, ID int, params api.GetNewspaperRssFeedParams) {
+	writeFeed(c, &ID, feedRSS, params.Limit, params.IfNoneMatch)
+}
+
+func (f *FeedHandler) GetNewspaperAtomFeed(c *gin.Context, ID int, params api.GetNewspaperAtomFeedParams) {
+	writeFeed(c, &ID, feedAtom, params.Limit, params.IfNoneMatch)
+}
+
+// 最新の記事のフィードを作って返す（newspaperIDがnilの場合は全ての新聞の記事）
+func writeFeed(c *gin.Context, newspaperID *int, format feedFormat, limit *int, ifNoneMatch *string) {
+	count := configs.Config.FeedItems
+	if limit != nil {
+		count = *limit
+	}
+
+	feed := &feeds.Feed{
+		Title:       "新聞の最新記事",
+		Link:        &feeds.Link{Href: configs.Config.PublicURL + "/api/v1/article"},
+		Description: "全ての新聞の最新の記事",
+	}
+	if newspaperID != nil {
+		newspaper, err := models.GetNewspaper(c.Request.Context(), *newspaperID)
+		if err != nil {
+			logger.FromContext(c.Request.Context()).Errorw("failed to get newspaper", "newspaper_id", *newspaperID, "error", err)
+			c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+		feed.Title = newspaper.Title
+		feed.Link = &feeds.Link{Href: fmt.Sprintf("%s/api/v1/newspaper/%d", configs.Config.PublicURL, newspaper.ID)}
+		feed.Description = newspaper.Title + "の最新の記事"
+	}
+
+	articles, err := models.GetLatestArticles(c.Request.Context(), newspaperID, count)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get latest articles", "newspaper_id", newspaperID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	for _, article := range articles {
+		// 記事のURLはIDが変わらない限り変わらないため、GUIDにもそのまま使う
+		link := fmt.Sprintf("%s/api/v1/article/%d", configs.Config.PublicURL, article.ID)
+		feed.Items = append(feed.Items, &feeds.Item{
+			Title:       article.Title(),
+			Link:        &feeds.Link{Href: link},
+			Id:          link,
+			IsPermaLink: "true",
+			Description: html.EscapeString(article.Summary),
+			Content:     html.EscapeString(article.Body),
+			Created:     article.PublishedAt(),
+		})
+	}
+	if len(articles) > 0 {
+		feed.Updated = articles[0].PublishedAt()
+	}
+
+	var body, contentType string
+	switch format {
+	case feedAtom:
+		body, err = feed.ToAtom()
+		contentType = "application/atom+xml; charset=utf-8"
+	default:
+		body, err = feed.ToRss()
+		contentType = "application/rss+xml; charset=utf-8"
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to render feed", "newspaper_id", newspaperID, "format", format, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	writeWithETag(c, ifNoneMatch, contentType, []byte(body))
+}
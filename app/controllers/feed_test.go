@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/configs"
+	"go-api-newspaper/pkg/tester"
+)
+
+type FeedControllersSuite struct {
+	tester.DBSQLiteSuite
+	feedHandler FeedHandler
+}
+
+func TestFeedControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedControllersSuite))
+}
+
+// RSS 2.0 のうちテストで確認する要素
+type rssFeed struct {
+	Channel struct {
+		Title string `xml:"title"`
+		Items []struct {
+			Title   string `xml:"title"`
+			GUID    string `xml:"guid"`
+			PubDate string `xml:"pubDate"`
+		} `xml:"item"`
+	} `xml:"channel"`
+}
+
+func (suite *FeedControllersSuite) TestNewspaperRssFeed() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	other, _ := models.CreateNewspaper(context.Background(), "毎日新聞")
+	older, _ := models.CreateArticle(context.Background(), "春の訪れ。", 2023, 3, 31, newspaper.ID, nil)
+	newer, _ := models.CreateArticle(context.Background(), "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+	models.CreateArticle(context.Background(), "雨が降った。", 2023, 4, 2, other.ID, nil)
+
+	limit := 1
+	params := api.GetNewspaperRssFeedParams{Limit: &limit}
+	request, _ := api.NewGetNewspaperRssFeedRequest("/api/v1", newspaper.ID, &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.feedHandler.GetNewspaperRssFeed(ginContext, newspaper.ID, params)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	var feed rssFeed
+	err := xml.Unmarshal(w.Body.Bytes(), &feed)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("朝日新聞", feed.Channel.Title)
+	suite.Assert().Len(feed.Channel.Items, 1)
+	suite.Assert().Equal("朝日新聞 2023年4月1日", feed.Channel.Items[0].Title)
+	suite.Assert().Equal(fmt.Sprintf("%s/api/v1/article/%d", configs.Config.PublicURL, newer.ID), feed.Channel.Items[0].GUID)
+	suite.Assert().Equal("Sat, 01 Apr 2023 00:00:00 +0900", feed.Channel.Items[0].PubDate)
+
+	// 記事の一覧が変わらなければ同じETagになり、304を返す
+	etag := w.Header().Get("ETag")
+	suite.Assert().NotEmpty(etag)
+	params.IfNoneMatch = &etag
+	request, _ = api.NewGetNewspaperRssFeedRequest("/api/v1", newspaper.ID, &params)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.feedHandler.GetNewspaperRssFeed(ginContext, newspaper.ID, params)
+
+	suite.Assert().Equal(http.StatusNotModified, w.Code)
+	suite.Assert().Empty(w.Body.String())
+
+	// 記事を更新するとETagも変わる
+	older.Year = 2024
+	suite.Assert().Nil(older.Save(context.Background()))
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.feedHandler.GetNewspaperRssFeed(ginContext, newspaper.ID, params)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().NotEqual(etag, w.Header().Get("ETag"))
+}
+
+func (suite *FeedControllersSuite) TestAtomFeed() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	article, _ := models.CreateArticle(context.Background(), "秋の夜長。", 2022, 10, 1, newspaper.ID, nil)
+
+	params := api.GetAtomFeedParams{}
+	request, _ := api.NewGetAtomFeedRequest("/api/v1", &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.feedHandler.GetAtomFeed(ginContext, params)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("application/atom+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	var feed struct {
+		Entries []struct {
+			ID string `xml:"id"`
+		} `xml:"entry"`
+	}
+	err := xml.Unmarshal(w.Body.Bytes(), &feed)
+	suite.Assert().Nil(err)
+	suite.Assert().Contains(w.Body.String(), "<title>読売新聞 2022年10月1日</title>")
+	var ids []string
+	for _, entry := range feed.Entries {
+		ids = append(ids, entry.ID)
+	}
+	suite.Assert().Contains(ids, fmt.Sprintf("%s/api/v1/article/%d", configs.Config.PublicURL, article.ID))
+}
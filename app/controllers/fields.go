@@ -0,0 +1,34 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+// fields・exclude クエリパラメータをレスポンスに含めるフィールドの指定に変換する
+func requestFields(include *api.FieldsQuery, exclude *api.ExcludeQuery) models.Fields {
+	fields := models.Fields{}
+	if include != nil {
+		fields.Include = *include
+	}
+	if exclude != nil {
+		fields.Exclude = *exclude
+	}
+	return fields
+}
+
+// レスポンスにないフィールドが指定された場合に 400 を返し、true を返す
+func abortUnknownField(c *gin.Context, err error) bool {
+	if !errors.Is(err, models.ErrUnknownField) {
+		return false
+	}
+	logger.FromContext(c.Request.Context()).Warnw("invalid fields", "error", err)
+	c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+	return true
+}
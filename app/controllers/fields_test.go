@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type FieldsControllersSuite struct {
+	tester.DBSQLiteSuite
+	articleHandler ArticleHandler
+}
+
+func TestFieldsControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldsControllersSuite))
+}
+
+func (suite *FieldsControllersSuite) TestListArticlesFields() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "フィールド新聞")
+	models.CreateArticle(context.Background(), "桜の花見。", 2023, 4, 1, newspaper.ID, nil)
+
+	newspaperID := newspaper.ID
+	fields := api.FieldsQuery{"id", "year", "month", "day"}
+	params := api.ListArticlesParams{NewspaperID: &newspaperID, Fields: &fields}
+	request, _ := api.NewListArticlesRequest("/api/v1", &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.ListArticles(ginContext, params)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var articles []map[string]interface{}
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &articles))
+	suite.Assert().Len(articles, 1)
+	suite.Assert().Len(articles[0], 4)
+	suite.Assert().Equal(float64(2023), articles[0]["year"])
+}
+
+func (suite *FieldsControllersSuite) TestGetArticleExclude() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "除外新聞")
+	article, _ := models.CreateArticle(context.Background(), "桜の名所。", 2023, 4, 2, newspaper.ID, nil)
+
+	exclude := api.ExcludeQuery{"body"}
+	params := api.GetArticleByIdParams{Exclude: &exclude}
+	request, _ := api.NewGetArticleByIdRequest("/api/v1", article.ID, &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.GetArticleById(ginContext, article.ID, params)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var response map[string]interface{}
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().NotContains(response, "body")
+	suite.Assert().Contains(response, "newspaperID")
+}
+
+func (suite *FieldsControllersSuite) TestUnknownField() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "不明新聞")
+	article, _ := models.CreateArticle(context.Background(), "桜。", 2023, 4, 3, newspaper.ID, nil)
+
+	fields := api.FieldsQuery{"id", "author"}
+	params := api.GetArticleByIdParams{Fields: &fields}
+	request, _ := api.NewGetArticleByIdRequest("/api/v1", article.ID, &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.GetArticleById(ginContext, article.ID, params)
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
+
+func (suite *FieldsControllersSuite) TestAnnotateWithFields() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読み新聞")
+	article, _ := models.CreateArticle(context.Background(), "日本の新聞。", 2023, 4, 4, newspaper.ID, nil)
+
+	get := func(params api.GetArticleByIdParams) map[string]interface{} {
+		request, _ := api.NewGetArticleByIdRequest("/api/v1", article.ID, &params)
+		w := httptest.NewRecorder()
+		ginContext, _ := gin.CreateTestContext(w)
+		ginContext.Request = request
+		suite.articleHandler.GetArticleById(ginContext, article.ID, params)
+		suite.Require().Equal(http.StatusOK, w.Code)
+		var response map[string]interface{}
+		suite.Require().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+		return response
+	}
+
+	// 読みを求めた場合は fields に関わらず読みを返し、本文を欠いた読みを保存しない
+	annotate := api.GetArticleByIdParamsAnnotateSpans
+	fields := api.FieldsQuery{"id"}
+	sparse := get(api.GetArticleByIdParams{Annotate: &annotate, Fields: &fields})
+	suite.Assert().NotContains(sparse, "body")
+	suite.Assert().NotEmpty(sparse["annotation"].(map[string]interface{})["spans"])
+
+	full := get(api.GetArticleByIdParams{Annotate: &annotate})
+	suite.Assert().Equal(sparse["annotation"], full["annotation"])
+}
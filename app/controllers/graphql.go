@@ -0,0 +1,33 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/graph"
+	"go-api-newspaper/configs"
+	"go-api-newspaper/pkg/logger"
+)
+
+// GraphQL は新聞と記事のGraphQLのクエリを実行するハンドラー
+// OpenAPIの仕様の外にあるため、ルーターに直接登録する。クエリのエラーはGraphQLの慣習に従い200で errors として返す
+func GraphQL(c *gin.Context) {
+	var request graph.Request
+	if err := c.ShouldBindJSON(&request); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid graphql request", "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	result := graph.Execute(c.Request.Context(), request, graph.Limits{
+		MaxDepth:      configs.Config.GraphQLMaxDepth,
+		MaxComplexity: configs.Config.GraphQLMaxComplexity,
+	})
+	if result.HasErrors() {
+		logger.FromContext(c.Request.Context()).Warnw("graphql query failed", "operation", request.OperationName, "errors", result.Errors)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type GraphQLControllersSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestGraphQLControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphQLControllersSuite))
+}
+
+func (suite *GraphQLControllersSuite) graphQL(body string) *httptest.ResponseRecorder {
+	request, _ := http.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	request.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	GraphQL(ginContext)
+	return w
+}
+
+func (suite *GraphQLControllersSuite) TestGraphQL() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	models.CreateArticle(context.Background(), "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+
+	w := suite.graphQL(fmt.Sprintf(`{"query": "query($id: Int!) { newspaper(id: $id) { title articles { body } } }", "variables": {"id": %d}}`, newspaper.ID))
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().JSONEq(`{"data": {"newspaper": {"title": "朝日新聞", "articles": [{"body": "桜が咲いた。"}]}}}`, w.Body.String())
+
+	// クエリのエラーは200で errors として返す
+	w = suite.graphQL(`{"query": "{ newspapers { unknown } }"}`)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var result struct {
+		Errors []struct{ Message string }
+	}
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &result))
+	suite.Assert().Len(result.Errors, 1)
+}
+
+func (suite *GraphQLControllersSuite) TestGraphQLInvalidRequest() {
+	w := suite.graphQL(`{"variables": {}}`)
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,16 @@
+package controllers
+
+// Handler は各リソースのハンドラーを埋め込み、api.ServerInterface をまとめて満たす
+type Handler struct {
+	NewspaperHandler
+	ColumnHandler
+	ArticleHandler
+	TagHandler
+	PracticeSessionHandler
+	ProgressHandler
+	VocabularyHandler
+	FeedHandler
+	WebhookHandler
+	EventHandler
+	BatchHandler
+}
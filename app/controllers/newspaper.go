@@ -1,9 +1,11 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
 
 	"go-api-newspaper/api"
 	"go-api-newspaper/app/models"
@@ -15,16 +17,16 @@ type NewspaperHandler struct{}
 func (a *NewspaperHandler) CreateNewspaper(c *gin.Context) { //*gin.Context リクエストやレスポンスの情報を保持する
 	var requestBody api.CreateNewspaperJSONRequestBody         // 自動生成済み
 	if err := c.ShouldBindJSON(&requestBody); err != nil { // JSONリクエストボディを構造体にバインド（マッピング）
-		logger.Warn(err.Error())
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "error", err)
 		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
 		return
 	}
 
 	createdNewspaper, err := models.CreateNewspaper(
-		requestBody.Title,
-		requestBody.ColumnName)
+		c.Request.Context(),
+		requestBody.Title)
 	if err != nil {
-		logger.Error(err.Error())
+		logger.FromContext(c.Request.Context()).Errorw("failed to create newspaper", "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
@@ -32,10 +34,13 @@ func (a *NewspaperHandler) CreateNewspaper(c *gin.Context) { //*gin.Context リ
 	c.JSON(http.StatusCreated, createdNewspaper) // 201 レスポンスに書き込み
 }
 
-func (a *NewspaperHandler) GetNewspaperById(c *gin.Context, ID int) {
-	newspaper, err := models.GetNewspaper(ID)
+func (a *NewspaperHandler) GetNewspaperById(c *gin.Context, ID int, params api.GetNewspaperByIdParams) {
+	newspaper, err := models.GetNewspaperWithFields(c.Request.Context(), ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
 	if err != nil {
-		logger.Error(err.Error())
+		logger.FromContext(c.Request.Context()).Errorw("failed to get newspaper", "newspaper_id", ID, "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
@@ -45,28 +50,38 @@ func (a *NewspaperHandler) GetNewspaperById(c *gin.Context, ID int) {
 
 func (a *NewspaperHandler) UpdateNewspaperById(c *gin.Context, ID int) {
 	var requestBody api.UpdateNewspaperByIdJSONRequestBody
-	if err := c.ShouldBindJSON(&requestBody); err != nil { // 引数cの内容をrequestBodyに格納
-		logger.Warn(err.Error())
-		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
-		return
+	if !isPatchRequest(c) {
+		if err := c.ShouldBindJSON(&requestBody); err != nil { // 引数cの内容をrequestBodyに格納
+			logger.FromContext(c.Request.Context()).Warnw("invalid request body", "newspaper_id", ID, "error", err)
+			c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+			return
+		}
 	}
 
-	newspaper, err := models.GetNewspaper(ID)
+	newspaper, err := models.GetNewspaper(c.Request.Context(), ID)
 	if err != nil {
-		logger.Error(err.Error())
+		logger.FromContext(c.Request.Context()).Errorw("failed to get newspaper", "newspaper_id", ID, "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
 
-	if requestBody.Title != nil {
-		newspaper.Title = *requestBody.Title
-	}
-	if requestBody.ColumnName != nil {
-		newspaper.ColumnName = *requestBody.ColumnName
+	if isPatchRequest(c) {
+		if err := patchNewspaper(c, newspaper); err != nil {
+			status := patchErrorStatus(err)
+			if status == http.StatusInternalServerError {
+				logger.FromContext(c.Request.Context()).Errorw("failed to patch newspaper", "newspaper_id", ID, "error", err)
+			} else {
+				logger.FromContext(c.Request.Context()).Warnw("invalid patch", "newspaper_id", ID, "error", err)
+			}
+			c.JSON(status, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+	} else {
+		applyNewspaperUpdate(newspaper, requestBody)
 	}
 
-	if err := newspaper.Save(); err != nil {
-		logger.Error(err.Error())
+	if err := newspaper.Save(c.Request.Context()); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to update newspaper", "newspaper_id", ID, "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
@@ -77,11 +92,35 @@ func (a *NewspaperHandler) UpdateNewspaperById(c *gin.Context, ID int) {
 func (a *NewspaperHandler) DeleteNewspaperById(c *gin.Context, ID int) {
 	newspaper := models.Newspaper{ID: ID}
 
-	if err := newspaper.Delete(); err != nil {
-		logger.Error(err.Error())
+	err := newspaper.Delete(c.Request.Context())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		logger.FromContext(c.Request.Context()).Warnw("newspaper not found", "newspaper_id", ID)
+		c.JSON(http.StatusNotFound, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to delete newspaper", "newspaper_id", ID, "error", err)
 		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
 		return
 	}
 
 	c.JSON(http.StatusNoContent, nil) // 204
 }
+
+// 更新リクエストで指定されたフィールドだけを新聞に反映する
+func applyNewspaperUpdate(newspaper *models.Newspaper, request api.NewspaperUpdateRequest) {
+	if request.Title != nil {
+		newspaper.Title = *request.Title
+	}
+}
+
+// JSON Merge Patch・JSON Patch を新聞に適用する（null や remove で必須のフィールドを消すことはできない）
+func patchNewspaper(c *gin.Context, newspaper *models.Newspaper) error {
+	var patched api.NewspaperCreateRequest
+	current := api.NewspaperCreateRequest{Title: newspaper.Title}
+	if err := applyPatch(c, current, "NewspaperCreateRequest", &patched); err != nil {
+		return err
+	}
+	newspaper.Title = patched.Title
+	return nil
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
@@ -51,10 +52,9 @@ func (suite *NewspaperControllersSuite) AfterTest(suiteName, testName string) {
 // TestCreate は CreateNewspaper メソッドの正常系テスト。
 func (suite *NewspaperControllersSuite) TestCreate() {
 	// リクエストの準備
-	request, _ := api.NewCreateNewspaperRequest("/api/v1", api.CreateNewspaperJSONRequestBody{
-		Title:       "test",
-		ColumnName:  "sports",
-	})
+	// 非推奨の columnName は書き込めず、指定しても無視する
+	request, _ := api.NewCreateNewspaperRequestWithBody("/api/v1", "application/json",
+		strings.NewReader(`{"title": "test", "columnName": "sports"}`))
 	w := httptest.NewRecorder() // レスポンス記録用の HTTP テストレコーダー
 	ginContext, _ := gin.CreateTestContext(w) // Gin のテストコンテキスト作成
 	ginContext.Request = request // テスト用リクエストを Gin のコンテキストに設定
@@ -69,7 +69,7 @@ func (suite *NewspaperControllersSuite) TestCreate() {
 	suite.Assert().Nil(err) // JSON のパースが成功していることを確認
 	suite.Assert().Equal(http.StatusCreated, w.Code)
 	suite.Assert().Equal("test", newspaperGetResponse.Title)
-	suite.Assert().Equal("sports", newspaperGetResponse.ColumnName)
+	suite.Assert().Equal("", newspaperGetResponse.ColumnName)
 }
 
 // TestCreateRequestBodyFailure はリクエストボディが不正な場合のテスト。
@@ -91,11 +91,10 @@ func (suite *NewspaperControllersSuite) TestCreateRequestBodyFailure() {
 func (suite *NewspaperControllersSuite) TestCreateFailure() {
 	mockDB := suite.MockDB()
 	// INSERT クエリを実行した際にエラーを返すよう設定
-	mockDB.ExpectExec("INSERT INTO `newspapers`").WithArgs("Test", "sports").WillReturnError(errors.New("create error"))
+	mockDB.ExpectExec("INSERT INTO `newspapers`").WithArgs("Test", "").WillReturnError(errors.New("create error"))
 
 	request, _ := api.NewCreateNewspaperRequest("/api/v1", api.CreateNewspaperJSONRequestBody{
 		Title:       "test",
-		ColumnName:    "sports",
 	})
 	w := httptest.NewRecorder()
 	ginContext, _ := gin.CreateTestContext(w)
@@ -109,37 +108,37 @@ func (suite *NewspaperControllersSuite) TestCreateFailure() {
 
 func (suite *NewspaperControllersSuite) TestGet() {
 	// 新聞データを作成
-	createdNewspaper, _ := models.CreateNewspaper("test", "sports")
+	createdNewspaper, _ := models.CreateNewspaper(context.Background(), "test")
 
 	// HTTPリクエストを作成
-	request, _ := api.NewGetNewspaperByIdRequest("/api/v1", createdNewspaper.ID)
+	request, _ := api.NewGetNewspaperByIdRequest("/api/v1", createdNewspaper.ID, &api.GetNewspaperByIdParams{})
 	w := httptest.NewRecorder()
 	ginContext, _ := gin.CreateTestContext(w)
 	ginContext.Request = request
 	// GetNewspaperById メソッドを呼び出し
-	suite.newspaperHandler.GetNewspaperById(ginContext, createdNewspaper.ID)
+	suite.newspaperHandler.GetNewspaperById(ginContext, createdNewspaper.ID, api.GetNewspaperByIdParams{})
 	bodyBytes, _ := io.ReadAll(w.Body)
 	var newspaperGetResponse api.NewspaperResponse
 	err := json.Unmarshal(bodyBytes, &newspaperGetResponse)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(http.StatusOK, w.Code)
 	suite.Assert().Equal("test", newspaperGetResponse.Title)
-	suite.Assert().Equal("sports", newspaperGetResponse.ColumnName)
+	suite.Assert().Equal("", newspaperGetResponse.ColumnName)
 }
 
 func (suite *NewspaperControllersSuite) TestGetNoNewspaperFailure() {
 	// 存在しないIDを設定
 	doesNotExistNewspaperID := 1111
 	// 存在しないIDでGetNewspaperを呼び出し、エラーが発生することを確認
-	deletedNewspaper, err := models.GetNewspaper(doesNotExistNewspaperID)
+	deletedNewspaper, err := models.GetNewspaper(context.Background(), doesNotExistNewspaperID)
 	suite.Assert().NotNil(err)
 	suite.Assert().Nil(deletedNewspaper)
 
-	request, _ := api.NewGetNewspaperByIdRequest("/api/v1", doesNotExistNewspaperID)
+	request, _ := api.NewGetNewspaperByIdRequest("/api/v1", doesNotExistNewspaperID, &api.GetNewspaperByIdParams{})
 	w := httptest.NewRecorder()
 	ginContext, _ := gin.CreateTestContext(w)
 	ginContext.Request = request
-	suite.newspaperHandler.GetNewspaperById(ginContext, doesNotExistNewspaperID)
+	suite.newspaperHandler.GetNewspaperById(ginContext, doesNotExistNewspaperID, api.GetNewspaperByIdParams{})
 	bodyBytes, _ := io.ReadAll(w.Body)
 	var newspaperGetResponse api.NewspaperResponse
 	err = json.Unmarshal(bodyBytes, &newspaperGetResponse)
@@ -148,7 +147,7 @@ func (suite *NewspaperControllersSuite) TestGetNoNewspaperFailure() {
 }
 
 func (suite *NewspaperControllersSuite) TestUpdate() {
-	createdNewspaper, _ := models.CreateNewspaper("test", "sports")
+	createdNewspaper, _ := models.CreateNewspaper(context.Background(), "test")
 
 	// 更新データを設定
 	title := "updated"
@@ -167,7 +166,7 @@ func (suite *NewspaperControllersSuite) TestUpdate() {
 	suite.Assert().Nil(err)
 	suite.Assert().Equal(http.StatusOK, w.Code)
 	suite.Assert().Equal("updated", newspaperGetResponse.Title)
-	suite.Assert().Equal("sports", newspaperGetResponse.ColumnName)
+	suite.Assert().Equal("", newspaperGetResponse.ColumnName)
 }
 
 func (suite *NewspaperControllersSuite) TestUpdateRequestBodyFailure() {
@@ -186,7 +185,7 @@ func (suite *NewspaperControllersSuite) TestUpdateRequestBodyFailure() {
 
 func (suite *NewspaperControllersSuite) TestUpdateNoNewspaperFailure() {
 	doesNotExistNewspaperID := 1111
-	deletedNewspaper, err := models.GetNewspaper(doesNotExistNewspaperID)
+	deletedNewspaper, err := models.GetNewspaper(context.Background(), doesNotExistNewspaperID)
 	suite.Assert().NotNil(err)
 	suite.Assert().Nil(deletedNewspaper)
 
@@ -229,7 +228,7 @@ func (suite *NewspaperControllersSuite) TestUpdateFailure() {
 }
 
 func (suite *NewspaperControllersSuite) TestDelete() {
-	createdNewspaper, _ := models.CreateNewspaper("test", "sports")
+	createdNewspaper, _ := models.CreateNewspaper(context.Background(), "test")
 
 	request, _ := api.NewDeleteNewspaperByIdRequest("/api/v1", createdNewspaper.ID)
 	w := httptest.NewRecorder()
@@ -239,7 +238,7 @@ func (suite *NewspaperControllersSuite) TestDelete() {
 	suite.Assert().Equal(http.StatusNoContent, w.Code)
 
 	// 削除後に存在しないことを確認
-	deletedNewspaper, err := models.GetNewspaper(createdNewspaper.ID)
+	deletedNewspaper, err := models.GetNewspaper(context.Background(), createdNewspaper.ID)
 	suite.Assert().NotNil(err)
 	suite.Assert().Nil(deletedNewspaper)
 }
@@ -247,7 +246,7 @@ func (suite *NewspaperControllersSuite) TestDelete() {
 func (suite *NewspaperControllersSuite) TestDeleteNoNewspaperFailure() {
 	doesNotExistNewspaperID := 1111
 	// 削除対象が存在しないことを確認
-	deletedNewspaper, err := models.GetNewspaper(doesNotExistNewspaperID)
+	deletedNewspaper, err := models.GetNewspaper(context.Background(), doesNotExistNewspaperID)
 	suite.Assert().NotNil(err)
 	suite.Assert().Nil(deletedNewspaper)
 
@@ -256,7 +255,7 @@ func (suite *NewspaperControllersSuite) TestDeleteNoNewspaperFailure() {
 	ginContext, _ := gin.CreateTestContext(w)
 	ginContext.Request = request
 	suite.newspaperHandler.DeleteNewspaperById(ginContext, doesNotExistNewspaperID)
-	suite.Assert().Equal(http.StatusNoContent, w.Code)
+	suite.Assert().Equal(http.StatusNotFound, w.Code)
 }
 
 func (suite *NewspaperControllersSuite) TestDeleteNewspaperFailure() {
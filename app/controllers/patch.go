@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	mergePatchContentType = "application/merge-patch+json" // JSON Merge Patch（RFC 7396）
+	jsonPatchContentType  = "application/json-patch+json"  // JSON Patch（RFC 6902）
+)
+
+var (
+	errInvalidPatch = errors.New("invalid patch")
+	errPatchTest    = errors.New("patch test failed")
+)
+
+// リクエストボディが JSON Merge Patch か JSON Patch かを判定する
+func isPatchRequest(c *gin.Context) bool {
+	contentType := c.ContentType()
+	return contentType == mergePatchContentType || contentType == jsonPatchContentType
+}
+
+// リクエストボディのパッチを現在のリソースに適用し、スキーマで検証した結果を target にデコードする
+// current は作成リクエストの形式のリソースで、パッチの適用後も同じスキーマを満たす必要がある
+func applyPatch(c *gin.Context, current interface{}, schema string, target interface{}) error {
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	document, err := json.Marshal(current)
+	if err != nil {
+		return err
+	}
+
+	var patched []byte
+	if c.ContentType() == mergePatchContentType {
+		patched, err = jsonpatch.MergePatch(document, patch)
+	} else {
+		var operations jsonpatch.Patch
+		if operations, err = jsonpatch.DecodePatch(patch); err == nil {
+			patched, err = operations.Apply(document)
+		}
+	}
+	if errors.Is(err, jsonpatch.ErrTestFailed) {
+		return fmt.Errorf("%w: %v", errPatchTest, err)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidPatch, err)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(patched, &value); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidPatch, err)
+	}
+	if err := validateDocument(schema, value); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidPatch, err)
+	}
+	return json.Unmarshal(patched, target)
+}
+
+// パッチの適用に失敗した理由に応じたステータスコードを返す（test 操作の不一致は 409、パッチや結果が不正な場合は 400）
+func patchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, errPatchTest):
+		return http.StatusConflict
+	case errors.Is(err, errInvalidPatch):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type PatchControllersSuite struct {
+	tester.DBSQLiteSuite
+	newspaperHandler NewspaperHandler
+	articleHandler   ArticleHandler
+}
+
+func TestPatchControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(PatchControllersSuite))
+}
+
+func (suite *PatchControllersSuite) patchNewspaper(ID int, contentType string, patch string) *httptest.ResponseRecorder {
+	request, _ := api.NewUpdateNewspaperByIdRequestWithBody("/api/v1", ID, contentType, strings.NewReader(patch))
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.newspaperHandler.UpdateNewspaperById(ginContext, ID)
+	return w
+}
+
+func (suite *PatchControllersSuite) patchArticle(ID int, contentType string, patch string) *httptest.ResponseRecorder {
+	request, _ := api.NewUpdateArticleByIdRequestWithBody("/api/v1", ID, contentType, strings.NewReader(patch))
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.UpdateArticleById(ginContext, ID)
+	return w
+}
+
+func (suite *PatchControllersSuite) TestMergePatchNewspaper() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "北海道新聞")
+
+	w := suite.patchNewspaper(newspaper.ID, mergePatchContentType, `{"title": "北海道新聞 朝刊"}`)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var response api.NewspaperResponse
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal("北海道新聞 朝刊", response.Title)
+	suite.Assert().Equal("", response.ColumnName)
+
+	// 非推奨の columnName は書き込めない
+	w = suite.patchNewspaper(newspaper.ID, mergePatchContentType, `{"columnName": "sports"}`)
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+
+	// 必須のフィールドは削除できない
+	w = suite.patchNewspaper(newspaper.ID, mergePatchContentType, `{"title": null}`)
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+	unchanged, _ := models.GetNewspaper(context.Background(), newspaper.ID)
+	suite.Assert().Equal("北海道新聞 朝刊", unchanged.Title)
+}
+
+func (suite *PatchControllersSuite) TestMergePatchArticleColumn() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "中日新聞")
+	column, _ := models.CreateColumn(context.Background(), newspaper.ID, "中日春秋", "chunichi-shunju", "", "")
+	article, _ := models.CreateArticle(context.Background(), "桜が散った。", 2024, 4, 10, newspaper.ID, &column.ID)
+
+	w := suite.patchArticle(article.ID, mergePatchContentType, `{"columnID": null, "day": 11}`)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var response api.ArticleResponse
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Nil(response.ColumnID)
+	suite.Assert().Equal(11, response.Day)
+	suite.Assert().Equal("桜が散った。", response.Body)
+
+	updated, _ := models.GetArticle(context.Background(), article.ID)
+	suite.Assert().Nil(updated.ColumnID)
+}
+
+func (suite *PatchControllersSuite) TestJSONPatchArticle() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "西日本新聞")
+	column, _ := models.CreateColumn(context.Background(), newspaper.ID, "春秋", "shunju", "", "")
+	article, _ := models.CreateArticle(context.Background(), "梅雨が明けた。", 2024, 7, 1, newspaper.ID, nil)
+
+	w := suite.patchArticle(article.ID, jsonPatchContentType, fmt.Sprintf(`[
+		{"op": "test", "path": "/body", "value": "梅雨が明けた。"},
+		{"op": "replace", "path": "/body", "value": "梅雨が明けて暑くなった。"},
+		{"op": "add", "path": "/columnID", "value": %d}
+	]`, column.ID))
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	var response api.ArticleResponse
+	suite.Assert().Nil(json.Unmarshal(w.Body.Bytes(), &response))
+	suite.Assert().Equal("梅雨が明けて暑くなった。", response.Body)
+	suite.Require().NotNil(response.ColumnID)
+	suite.Assert().Equal(column.ID, *response.ColumnID)
+
+	// 本文が変わっているため test 操作が一致せず、何も更新しない
+	w = suite.patchArticle(article.ID, jsonPatchContentType, `[
+		{"op": "test", "path": "/body", "value": "梅雨が明けた。"},
+		{"op": "remove", "path": "/columnID"}
+	]`)
+	suite.Assert().Equal(http.StatusConflict, w.Code)
+	unchanged, _ := models.GetArticle(context.Background(), article.ID)
+	suite.Assert().Equal("梅雨が明けて暑くなった。", unchanged.Body)
+	suite.Assert().NotNil(unchanged.ColumnID)
+
+	w = suite.patchArticle(article.ID, jsonPatchContentType, `[{"op": "remove", "path": "/columnID"}]`)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	updated, _ := models.GetArticle(context.Background(), article.ID)
+	suite.Assert().Nil(updated.ColumnID)
+}
+
+func (suite *PatchControllersSuite) TestInvalidPatches() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "河北新報")
+	article, _ := models.CreateArticle(context.Background(), "稲刈りが始まった。", 2024, 9, 20, newspaper.ID, nil)
+
+	for name, testCase := range map[string]struct {
+		contentType string
+		patch       string
+	}{
+		"unknown property":      {mergePatchContentType, `{"title": "河北新報"}`},
+		"wrong type":            {mergePatchContentType, `{"year": "2024"}`},
+		"remove required field": {jsonPatchContentType, `[{"op": "remove", "path": "/body"}]`},
+		"missing path":          {jsonPatchContentType, `[{"op": "replace", "path": "/summary", "value": "要約"}]`},
+		"malformed patch":       {jsonPatchContentType, `{"op": "replace"}`},
+		"malformed merge patch": {mergePatchContentType, `{"body":`},
+	} {
+		w := suite.patchArticle(article.ID, testCase.contentType, testCase.patch)
+		suite.Assert().Equal(http.StatusBadRequest, w.Code, name)
+	}
+
+	unchanged, _ := models.GetArticle(context.Background(), article.ID)
+	suite.Assert().Equal("稲刈りが始まった。", unchanged.Body)
+	suite.Assert().Equal(2024, unchanged.Year)
+}
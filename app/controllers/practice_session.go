@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+type PracticeSessionHandler struct{}
+
+func (p *PracticeSessionHandler) CreatePracticeSession(c *gin.Context, ID int, params api.CreatePracticeSessionParams) {
+	var requestBody api.CreatePracticeSessionJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "article_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	session, err := models.CreatePracticeSession(c.Request.Context(), params.XUserID, article, requestBody.Text, requestBody.DurationSeconds)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to create practice session", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, session)
+}
+
+func (p *PracticeSessionHandler) ListPracticeSessions(c *gin.Context, params api.ListPracticeSessionsParams) {
+	limit, offset := defaultListLimit, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	sessions, err := models.GetPracticeSessions(c.Request.Context(), params.XUserID, params.ArticleID, limit, offset, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list practice sessions", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+func (p *PracticeSessionHandler) GetPracticeSession(c *gin.Context, ID int, params api.GetPracticeSessionParams) {
+	session, err := models.GetPracticeSessionWithFields(c.Request.Context(), params.XUserID, ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get practice session", "practice_session_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
@@ -0,0 +1,72 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type PracticeSessionControllersSuite struct {
+	tester.DBSQLiteSuite
+	practiceSessionHandler PracticeSessionHandler
+}
+
+func TestPracticeSessionControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(PracticeSessionControllersSuite))
+}
+
+func (suite *PracticeSessionControllersSuite) TestCreateAndList() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	article, _ := models.CreateArticle(context.Background(), "春はあけぼの。", 2023, 4, 1, newspaper.ID, nil)
+
+	params := api.CreatePracticeSessionParams{XUserID: "alice"}
+	request, _ := api.NewCreatePracticeSessionRequest("/api/v1", article.ID, &params, api.PracticeSessionCreateRequest{Text: "春はあけぼのだ。"})
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.practiceSessionHandler.CreatePracticeSession(ginContext, article.ID, params)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var created api.PracticeSessionResponse
+	err := json.Unmarshal(bodyBytes, &created)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusCreated, w.Code)
+	suite.Assert().Equal(article.ID, created.ArticleID)
+	suite.Assert().Equal(1, created.Distance)
+	suite.Assert().Equal([]api.PracticeError{{Category: api.Extra, Position: 6, Actual: "だ"}}, created.Errors)
+
+	listParams := api.ListPracticeSessionsParams{XUserID: "alice"}
+	request, _ = api.NewListPracticeSessionsRequest("/api/v1", &listParams)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.practiceSessionHandler.ListPracticeSessions(ginContext, listParams)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var sessions []api.PracticeSessionResponse
+	err = json.Unmarshal(bodyBytes, &sessions)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Len(sessions, 1)
+	suite.Assert().Equal(created.Id, sessions[0].Id)
+
+	// 他のユーザーの練習は取得できない
+	getParams := api.GetPracticeSessionParams{XUserID: "bob"}
+	request, _ = api.NewGetPracticeSessionRequest("/api/v1", created.Id, &getParams)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.practiceSessionHandler.GetPracticeSession(ginContext, created.Id, getParams)
+
+	suite.Assert().Equal(http.StatusInternalServerError, w.Code)
+}
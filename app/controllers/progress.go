@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+type ProgressHandler struct{}
+
+func (p *ProgressHandler) MarkArticleRead(c *gin.Context, ID int, params api.MarkArticleReadParams) {
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if err := article.MarkRead(c.Request.Context(), params.XUserID, time.Now()); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to mark article as read", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil) // 204
+}
+
+func (p *ProgressHandler) GetProgress(c *gin.Context, params api.GetProgressParams) {
+	progress, err := models.GetProgress(c.Request.Context(), params.XUserID, time.Now())
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get progress", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, progress)
+}
+
+func (p *ProgressHandler) GetProgressCalendar(c *gin.Context, ID int, params api.GetProgressCalendarParams) {
+	if _, err := models.GetNewspaper(c.Request.Context(), ID); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get newspaper", "newspaper_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	calendar, err := models.GetMonthlyCalendar(c.Request.Context(), params.XUserID, ID, params.Year, params.Month)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get progress calendar", "newspaper_id", ID, "year", params.Year, "month", params.Month, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, calendar)
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ProgressControllersSuite struct {
+	tester.DBSQLiteSuite
+	progressHandler ProgressHandler
+}
+
+func TestProgressControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(ProgressControllersSuite))
+}
+
+func (suite *ProgressControllersSuite) TestMarkReadAndProgress() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	article, _ := models.CreateArticle(context.Background(), "春はあけぼの。", 2023, 4, 1, newspaper.ID, nil)
+
+	params := api.MarkArticleReadParams{XUserID: "alice"}
+	request, _ := api.NewMarkArticleReadRequest("/api/v1", article.ID, &params)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.progressHandler.MarkArticleRead(ginContext, article.ID, params)
+	suite.Assert().Equal(http.StatusNoContent, w.Code)
+
+	progressParams := api.GetProgressParams{XUserID: "alice"}
+	request, _ = api.NewGetProgressRequest("/api/v1", &progressParams)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.progressHandler.GetProgress(ginContext, progressParams)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var progress api.ProgressResponse
+	err := json.Unmarshal(bodyBytes, &progress)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal(1, progress.Read)
+	suite.Assert().Equal(1, progress.CurrentStreak)
+
+	calendarParams := api.GetProgressCalendarParams{XUserID: "alice", Year: 2023, Month: 4}
+	request, _ = api.NewGetProgressCalendarRequest("/api/v1", newspaper.ID, &calendarParams)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.progressHandler.GetProgressCalendar(ginContext, newspaper.ID, calendarParams)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var calendar api.CalendarResponse
+	err = json.Unmarshal(bodyBytes, &calendar)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Len(calendar.Days, 30)
+	suite.Assert().True(calendar.Days[0].Completed)
+	suite.Assert().Equal(1, calendar.CompletedDays)
+}
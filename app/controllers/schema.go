@@ -0,0 +1,51 @@
+package controllers
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"go-api-newspaper/api"
+)
+
+var (
+	swaggerOnce sync.Once
+	swagger     *openapi3.T
+	swaggerErr  error
+)
+
+// openapi.yaml の components.schemas の定義で値を検証する
+// リクエストバリデーションのミドルウェアを通らない値（バッチの操作の内容など）に使う
+func validateSchema(name string, value interface{}) error {
+	swaggerOnce.Do(func() {
+		swagger, swaggerErr = api.GetSwagger()
+	})
+	if swaggerErr != nil {
+		return swaggerErr
+	}
+	schema, ok := swagger.Components.Schemas[name]
+	if !ok || schema.Value == nil {
+		return fmt.Errorf("schema %s is not defined", name)
+	}
+	return schema.Value.VisitJSON(value)
+}
+
+// validateSchema に加えて、スキーマに定義されていないプロパティも不正とする
+// パッチを適用した結果のように、クライアントが任意のプロパティを追加できる値に使う
+func validateDocument(name string, value interface{}) error {
+	if err := validateSchema(name, value); err != nil {
+		return err
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	properties := swagger.Components.Schemas[name].Value.Properties
+	for key := range object {
+		if _, ok := properties[key]; !ok {
+			return fmt.Errorf("property %q is not defined in %s", key, name)
+		}
+	}
+	return nil
+}
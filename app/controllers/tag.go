@@ -0,0 +1,77 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+type TagHandler struct{}
+
+func (a *TagHandler) TagArticle(c *gin.Context, ID int, tag string) {
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	err = article.AddTag(c.Request.Context(), tag)
+	if errors.Is(err, models.ErrInvalidTagName) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid tag", "article_id", ID, "tag", tag, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to tag article", "article_id", ID, "tag", tag, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+func (a *TagHandler) UntagArticle(c *gin.Context, ID int, tag string) {
+	article, err := models.GetArticle(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get article", "article_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	err = article.RemoveTag(c.Request.Context(), tag)
+	if errors.Is(err, models.ErrInvalidTagName) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid tag", "article_id", ID, "tag", tag, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to untag article", "article_id", ID, "tag", tag, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+func (a *TagHandler) ListNewspaperTags(c *gin.Context, ID int) {
+	if _, err := models.GetNewspaper(c.Request.Context(), ID); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get newspaper", "newspaper_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	counts, err := models.GetNewspaperTagCounts(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to count tags", "newspaper_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, counts)
+}
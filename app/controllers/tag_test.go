@@ -0,0 +1,87 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type TagControllersSuite struct {
+	tester.DBSQLiteSuite
+	tagHandler     TagHandler
+	articleHandler ArticleHandler
+}
+
+func TestTagControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(TagControllersSuite))
+}
+
+func (suite *TagControllersSuite) TestTagAndList() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	article, _ := models.CreateArticle(context.Background(), "春", 2023, 4, 1, newspaper.ID, nil)
+	models.CreateArticle(context.Background(), "夏", 2023, 7, 1, newspaper.ID, nil)
+
+	request, _ := api.NewTagArticleRequest("/api/v1", article.ID, "季節")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.tagHandler.TagArticle(ginContext, article.ID, "季節")
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var tagged api.ArticleResponse
+	err := json.Unmarshal(bodyBytes, &tagged)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal([]string{"季節"}, *tagged.Tags)
+
+	tags := []string{"季節"}
+	request, _ = api.NewListArticlesRequest("/api/v1", &api.ListArticlesParams{Tag: &tags})
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.articleHandler.ListArticles(ginContext, api.ListArticlesParams{Tag: &tags})
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var articles []api.ArticleResponse
+	err = json.Unmarshal(bodyBytes, &articles)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Len(articles, 1)
+	suite.Assert().Equal(article.ID, articles[0].Id)
+
+	request, _ = api.NewListNewspaperTagsRequest("/api/v1", newspaper.ID)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.tagHandler.ListNewspaperTags(ginContext, newspaper.ID)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var counts []api.TagCountResponse
+	err = json.Unmarshal(bodyBytes, &counts)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal([]api.TagCountResponse{{Name: "季節", Count: 1}}, counts)
+}
+
+func (suite *TagControllersSuite) TestTagInvalidName() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	article, _ := models.CreateArticle(context.Background(), "秋", 2023, 10, 1, newspaper.ID, nil)
+
+	request, _ := api.NewTagArticleRequest("/api/v1", article.ID, " ")
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.tagHandler.TagArticle(ginContext, article.ID, " ")
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,217 @@
+package controllers
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/anki"
+	"go-api-newspaper/pkg/logger"
+)
+
+type VocabularyHandler struct{}
+
+// Ankiに書き出す列
+var vocabularyColumns = []string{"Word", "Reading", "Meaning", "Source"}
+
+func (v *VocabularyHandler) CreateVocabularyEntry(c *gin.Context, params api.CreateVocabularyEntryParams) {
+	var requestBody api.CreateVocabularyEntryJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	var reading, meaning string
+	if requestBody.Reading != nil {
+		reading = *requestBody.Reading
+	}
+	if requestBody.Meaning != nil {
+		meaning = *requestBody.Meaning
+	}
+
+	entry, err := models.CreateVocabularyEntry(
+		c.Request.Context(),
+		params.XUserID,
+		requestBody.Word,
+		reading,
+		meaning,
+		requestBody.ArticleID,
+		requestBody.Offset,
+		time.Now(),
+	)
+	if errors.Is(err, models.ErrInvalidVocabularyWord) || errors.Is(err, models.ErrWordNotInArticle) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid vocabulary entry", "article_id", requestBody.ArticleID, "offset", requestBody.Offset, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to create vocabulary entry", "article_id", requestBody.ArticleID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, entry)
+}
+
+func (v *VocabularyHandler) ListVocabularyEntries(c *gin.Context, params api.ListVocabularyEntriesParams) {
+	limit, offset := defaultListLimit, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	entries, err := models.GetVocabularyEntries(c.Request.Context(), params.XUserID, params.ArticleID, limit, offset, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list vocabulary entries", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+func (v *VocabularyHandler) ListDueVocabularyEntries(c *gin.Context, params api.ListDueVocabularyEntriesParams) {
+	limit := defaultListLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	entries, err := models.GetDueVocabularyEntries(c.Request.Context(), params.XUserID, time.Now(), limit, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list due vocabulary entries", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entries)
+}
+
+func (v *VocabularyHandler) ExportVocabulary(c *gin.Context, params api.ExportVocabularyParams) {
+	entries, err := models.GetAllVocabularyEntries(c.Request.Context(), params.XUserID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get vocabulary entries", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	var buf bytes.Buffer
+	w := anki.NewWriter(&buf, vocabularyColumns)
+	for _, entry := range entries {
+		if err := w.Write([]string{entry.Word, entry.Reading, entry.Meaning, entry.Source()}); err != nil {
+			logger.FromContext(c.Request.Context()).Errorw("failed to export vocabulary", "error", err)
+			c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+			return
+		}
+	}
+	if err := w.Flush(); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to export vocabulary", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="vocabulary.csv"`)
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+func (v *VocabularyHandler) GetVocabularyEntry(c *gin.Context, ID int, params api.GetVocabularyEntryParams) {
+	entry, err := models.GetVocabularyEntryWithFields(c.Request.Context(), params.XUserID, ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+func (v *VocabularyHandler) UpdateVocabularyEntry(c *gin.Context, ID int, params api.UpdateVocabularyEntryParams) {
+	var requestBody api.UpdateVocabularyEntryJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	entry, err := models.GetVocabularyEntry(c.Request.Context(), params.XUserID, ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if requestBody.Word != nil {
+		entry.Word = *requestBody.Word
+	}
+	if requestBody.Reading != nil {
+		entry.Reading = *requestBody.Reading
+	}
+	if requestBody.Meaning != nil {
+		entry.Meaning = *requestBody.Meaning
+	}
+
+	err = entry.Save(c.Request.Context())
+	if errors.Is(err, models.ErrInvalidVocabularyWord) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to update vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
+
+func (v *VocabularyHandler) DeleteVocabularyEntry(c *gin.Context, ID int, params api.DeleteVocabularyEntryParams) {
+	entry := models.VocabularyEntry{ID: ID, UserID: params.XUserID}
+
+	if err := entry.Delete(c.Request.Context()); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to delete vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil) // 204
+}
+
+func (v *VocabularyHandler) ReviewVocabularyEntry(c *gin.Context, ID int, params api.ReviewVocabularyEntryParams) {
+	var requestBody api.ReviewVocabularyEntryJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	entry, err := models.GetVocabularyEntry(c.Request.Context(), params.XUserID, ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if err := entry.Review(c.Request.Context(), requestBody.Quality, time.Now()); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to review vocabulary entry", "vocabulary_entry_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, entry)
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type VocabularyControllersSuite struct {
+	tester.DBSQLiteSuite
+	vocabularyHandler VocabularyHandler
+}
+
+func TestVocabularyControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(VocabularyControllersSuite))
+}
+
+func (suite *VocabularyControllersSuite) TestCreateReviewAndExport() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	article, _ := models.CreateArticle(context.Background(), "国会で議論が続く。", 2023, 4, 1, newspaper.ID, nil)
+
+	params := api.CreateVocabularyEntryParams{XUserID: "alice"}
+	offset, meaning := 0, "国の議会"
+	body := api.VocabularyEntryCreateRequest{Word: "国会", Meaning: &meaning, ArticleID: &article.ID, Offset: &offset}
+	request, _ := api.NewCreateVocabularyEntryRequest("/api/v1", &params, body)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.vocabularyHandler.CreateVocabularyEntry(ginContext, params)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var created api.VocabularyEntryResponse
+	err := json.Unmarshal(bodyBytes, &created)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusCreated, w.Code)
+	suite.Assert().Equal("こっかい", created.Reading)
+	suite.Assert().Equal(article.ID, *created.ArticleID)
+
+	// 登録した日に復習する
+	dueParams := api.ListDueVocabularyEntriesParams{XUserID: "alice"}
+	request, _ = api.NewListDueVocabularyEntriesRequest("/api/v1", &dueParams)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.vocabularyHandler.ListDueVocabularyEntries(ginContext, dueParams)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var due []api.VocabularyEntryResponse
+	err = json.Unmarshal(bodyBytes, &due)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(due, 1)
+
+	reviewParams := api.ReviewVocabularyEntryParams{XUserID: "alice"}
+	request, _ = api.NewReviewVocabularyEntryRequest("/api/v1", created.Id, &reviewParams, api.VocabularyReviewRequest{Quality: 5})
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.vocabularyHandler.ReviewVocabularyEntry(ginContext, created.Id, reviewParams)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var reviewed api.VocabularyEntryResponse
+	err = json.Unmarshal(bodyBytes, &reviewed)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal(1, reviewed.Interval)
+	suite.Assert().NotNil(reviewed.LastReviewedAt)
+
+	exportParams := api.ExportVocabularyParams{XUserID: "alice"}
+	request, _ = api.NewExportVocabularyRequest("/api/v1", &exportParams)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.vocabularyHandler.ExportVocabulary(ginContext, exportParams)
+
+	suite.Assert().Equal(http.StatusOK, w.Code)
+	suite.Assert().Equal("text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+	suite.Assert().Equal("#separator:Comma\n#html:false\n#columns:Word,Reading,Meaning,Source\n"+
+		"国会,こっかい,国の議会,朝日新聞 2023年4月1日\n", w.Body.String())
+}
+
+func (suite *VocabularyControllersSuite) TestCreateWordNotInArticle() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	article, _ := models.CreateArticle(context.Background(), "春の訪れ", 2023, 4, 1, newspaper.ID, nil)
+
+	params := api.CreateVocabularyEntryParams{XUserID: "bob"}
+	offset := 2
+	body := api.VocabularyEntryCreateRequest{Word: "春", ArticleID: &article.ID, Offset: &offset}
+	request, _ := api.NewCreateVocabularyEntryRequest("/api/v1", &params, body)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.vocabularyHandler.CreateVocabularyEntry(ginContext, params)
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
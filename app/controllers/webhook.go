@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+type WebhookHandler struct{}
+
+func webhookEvents(events []api.WebhookEvent) []models.EventType {
+	converted := make([]models.EventType, len(events))
+	for i, event := range events {
+		converted[i] = models.EventType(event)
+	}
+	return converted
+}
+
+func (w *WebhookHandler) CreateWebhookSubscription(c *gin.Context) {
+	var requestBody api.CreateWebhookSubscriptionJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	subscription, err := models.CreateWebhookSubscription(c.Request.Context(), requestBody.Url, webhookEvents(requestBody.Events), requestBody.Secret)
+	if errors.Is(err, models.ErrInvalidWebhookURL) || errors.Is(err, models.ErrInvalidWebhookEvent) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid webhook subscription", "url", requestBody.Url, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to create webhook subscription", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, subscription)
+}
+
+func (w *WebhookHandler) ListWebhookSubscriptions(c *gin.Context, params api.ListWebhookSubscriptionsParams) {
+	subscriptions, err := models.GetWebhookSubscriptions(c.Request.Context(), requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list webhook subscriptions", "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, subscriptions)
+}
+
+func (w *WebhookHandler) GetWebhookSubscription(c *gin.Context, ID int, params api.GetWebhookSubscriptionParams) {
+	subscription, err := models.GetWebhookSubscriptionWithFields(c.Request.Context(), ID, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get webhook subscription", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
+
+func (w *WebhookHandler) UpdateWebhookSubscription(c *gin.Context, ID int) {
+	var requestBody api.UpdateWebhookSubscriptionJSONRequestBody
+	if err := c.ShouldBindJSON(&requestBody); err != nil {
+		logger.FromContext(c.Request.Context()).Warnw("invalid request body", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	subscription, err := models.GetWebhookSubscription(c.Request.Context(), ID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get webhook subscription", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	if requestBody.Url != nil {
+		subscription.URL = *requestBody.Url
+	}
+	if requestBody.Events != nil {
+		subscription.Events = webhookEvents(*requestBody.Events)
+	}
+	if requestBody.Secret != nil {
+		subscription.Secret = *requestBody.Secret
+	}
+	if requestBody.Active != nil {
+		subscription.Active = *requestBody.Active
+	}
+
+	err = subscription.Save(c.Request.Context())
+	if errors.Is(err, models.ErrInvalidWebhookURL) || errors.Is(err, models.ErrInvalidWebhookEvent) {
+		logger.FromContext(c.Request.Context()).Warnw("invalid webhook subscription", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusBadRequest, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to update webhook subscription", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
+
+func (w *WebhookHandler) DeleteWebhookSubscription(c *gin.Context, ID int) {
+	subscription := models.WebhookSubscription{ID: ID}
+
+	if err := subscription.Delete(c.Request.Context()); err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to delete webhook subscription", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusNoContent, nil) // 204
+}
+
+func (w *WebhookHandler) ListWebhookDeliveries(c *gin.Context, ID int, params api.ListWebhookDeliveriesParams) {
+	limit, offset := defaultListLimit, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	deliveries, err := models.GetWebhookDeliveries(c.Request.Context(), ID, limit, offset, requestFields(params.Fields, params.Exclude))
+	if abortUnknownField(c, err) {
+		return
+	}
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to list webhook deliveries", "webhook_id", ID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+func (w *WebhookHandler) RedeliverWebhook(c *gin.Context, ID int, deliveryID int) {
+	delivery, err := models.GetWebhookDelivery(c.Request.Context(), ID, deliveryID)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to get webhook delivery", "webhook_id", ID, "delivery_id", deliveryID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	redelivery, err := delivery.Redeliver(c.Request.Context(), time.Now())
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Errorw("failed to redeliver webhook", "webhook_id", ID, "delivery_id", deliveryID, "error", err)
+		c.JSON(http.StatusInternalServerError, api.ErrorResponse{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, redelivery)
+}
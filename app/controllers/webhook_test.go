@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/tester"
+)
+
+type WebhookControllersSuite struct {
+	tester.DBSQLiteSuite
+	webhookHandler WebhookHandler
+}
+
+func TestWebhookControllersTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookControllersSuite))
+}
+
+func (suite *WebhookControllersSuite) TestCreateAndRedeliver() {
+	body := api.WebhookSubscriptionCreateRequest{
+		Url:    "https://example.com/hooks/articles",
+		Events: []api.WebhookEvent{api.ArticleCreated},
+		Secret: "0123456789abcdef",
+	}
+	request, _ := api.NewCreateWebhookSubscriptionRequest("/api/v1", body)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.webhookHandler.CreateWebhookSubscription(ginContext)
+
+	bodyBytes, _ := io.ReadAll(w.Body)
+	var created api.WebhookSubscriptionResponse
+	err := json.Unmarshal(bodyBytes, &created)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusCreated, w.Code)
+	suite.Assert().True(created.Active)
+	suite.Assert().NotContains(string(bodyBytes), "0123456789abcdef") // 秘密鍵は返さない
+
+	newspaper, _ := models.CreateNewspaper(context.Background(), "朝日新聞")
+	models.CreateArticle(context.Background(), "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+	outbox.NewDispatcher(models.OutboxStore{}, 100, models.WebhookSink{}).DispatchOnce(context.Background())
+
+	params := api.ListWebhookDeliveriesParams{}
+	request, _ = api.NewListWebhookDeliveriesRequest("/api/v1", created.Id, &params)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.webhookHandler.ListWebhookDeliveries(ginContext, created.Id, params)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var deliveries []api.WebhookDeliveryResponse
+	err = json.Unmarshal(bodyBytes, &deliveries)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(deliveries, 1)
+	suite.Assert().Equal(api.ArticleCreated, deliveries[0].Event)
+	suite.Assert().Equal(api.WebhookDeliveryResponseStatus("pending"), deliveries[0].Status)
+
+	request, _ = api.NewRedeliverWebhookRequest("/api/v1", created.Id, deliveries[0].Id)
+	w = httptest.NewRecorder()
+	ginContext, _ = gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.webhookHandler.RedeliverWebhook(ginContext, created.Id, deliveries[0].Id)
+
+	bodyBytes, _ = io.ReadAll(w.Body)
+	var redelivery api.WebhookDeliveryResponse
+	err = json.Unmarshal(bodyBytes, &redelivery)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(http.StatusAccepted, w.Code)
+	suite.Assert().NotEqual(deliveries[0].Id, redelivery.Id)
+	suite.Assert().Equal(deliveries[0].Payload, redelivery.Payload)
+}
+
+func (suite *WebhookControllersSuite) TestCreateInvalidURL() {
+	body := api.WebhookSubscriptionCreateRequest{
+		Url:    "not a url",
+		Events: []api.WebhookEvent{api.NewspaperCreated},
+		Secret: "0123456789abcdef",
+	}
+	request, _ := api.NewCreateWebhookSubscriptionRequest("/api/v1", body)
+	w := httptest.NewRecorder()
+	ginContext, _ := gin.CreateTestContext(w)
+	ginContext.Request = request
+	suite.webhookHandler.CreateWebhookSubscription(ginContext)
+
+	suite.Assert().Equal(http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,55 @@
+// Package graph はREST APIと並べて公開する、新聞と記事のGraphQL API
+//
+// リゾルバーは app/models の関数をそのまま使い、一覧の要素ごとの関連（記事のタグや新聞など）はデータローダーでまとめて読み込む。
+// 深すぎるクエリや取得するデータが多すぎるクエリは、実行する前に深さと複雑さを見積もって拒否する
+package graph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Request はGraphQLのリクエスト
+type Request struct {
+	Query         string                 `json:"query" binding:"required"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// Limits はクエリの深さと複雑さの上限
+type Limits struct {
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// Execute はクエリを検証し、上限を超えていなければ実行する
+func Execute(ctx context.Context, request Request, limits Limits) *graphql.Result {
+	document, err := parser.Parse(parser.ParseParams{Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"})})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+	if validation := graphql.ValidateDocument(&Schema, document, nil); !validation.IsValid {
+		return &graphql.Result{Errors: validation.Errors}
+	}
+
+	measured := measure(document, request.OperationName, request.Variables)
+	if measured.depth > limits.MaxDepth {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(fmt.Errorf("query depth %d exceeds the limit of %d", measured.depth, limits.MaxDepth))}
+	}
+	if measured.complexity > limits.MaxComplexity {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(fmt.Errorf("query complexity %d exceeds the limit of %d", measured.complexity, limits.MaxComplexity))}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        Schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       withLoaders(ctx),
+	})
+}
@@ -0,0 +1,226 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type GraphTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestGraphTestSuite(t *testing.T) {
+	suite.Run(t, new(GraphTestSuite))
+}
+
+var testLimits = Limits{MaxDepth: 6, MaxComplexity: 5000}
+
+// クエリを実行し、結果のデータをJSONにしてvに読み込む
+func (suite *GraphTestSuite) execute(query string, variables map[string]interface{}, v interface{}) *graphql.Result {
+	result := Execute(context.Background(), Request{Query: query, Variables: variables}, testLimits)
+	if v != nil {
+		data, _ := json.Marshal(result.Data)
+		suite.Require().Nil(json.Unmarshal(data, v))
+	}
+	return result
+}
+
+// fn の実行中にデータベースに送った検索の数を数える
+func countQueries(fn func()) int {
+	count := 0
+	name := fmt.Sprintf("test:count_queries_%p", &count)
+	models.DB.Callback().Query().After("gorm:query").Register(name, func(*gorm.DB) { count++ })
+	defer models.DB.Callback().Query().Remove(name)
+	fn()
+	return count
+}
+
+func (suite *GraphTestSuite) TestNewspaperWithArticlesAndTags() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	older, _ := models.CreateArticle(ctx, "春の訪れ。", 2023, 3, 31, newspaper.ID, nil)
+	newer, _ := models.CreateArticle(ctx, "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+	newer.AddTag(ctx, "季節")
+	newer.AddTag(ctx, "花")
+
+	var data struct {
+		Newspaper struct {
+			Title    string
+			Articles []struct {
+				ID        int
+				Body      string
+				Tags      []string
+				Newspaper struct{ Title string }
+			}
+		}
+	}
+	result := suite.execute(`query($id: Int!) {
+		newspaper(id: $id) { title articles(limit: 2) { id body tags newspaper { title } } }
+	}`, map[string]interface{}{"id": newspaper.ID}, &data)
+
+	suite.Assert().Empty(result.Errors)
+	suite.Assert().Equal("朝日新聞", data.Newspaper.Title)
+	suite.Assert().Len(data.Newspaper.Articles, 2)
+	suite.Assert().Equal(newer.ID, data.Newspaper.Articles[0].ID)
+	suite.Assert().Equal([]string{"季節", "花"}, data.Newspaper.Articles[0].Tags)
+	suite.Assert().Equal("朝日新聞", data.Newspaper.Articles[0].Newspaper.Title)
+	suite.Assert().Equal(older.ID, data.Newspaper.Articles[1].ID)
+	suite.Assert().Equal([]string{}, data.Newspaper.Articles[1].Tags)
+}
+
+func (suite *GraphTestSuite) TestBatchesAssociations() {
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		newspaper, _ := models.CreateNewspaper(ctx, fmt.Sprintf("地方紙%d", i))
+		for day := 1; day <= 3; day++ {
+			article, _ := models.CreateArticle(ctx, "雪が降った。", 2023, 12, day, newspaper.ID, nil)
+			article.AddTag(ctx, "天気")
+		}
+	}
+
+	var data struct {
+		Newspapers []struct {
+			Articles []struct {
+				Tags      []string
+				Newspaper struct{ ID int }
+			}
+		}
+	}
+	// 新聞ごと・記事ごとに検索せず、新聞の一覧・記事・タグの3回で取得する
+	queries := countQueries(func() {
+		result := suite.execute(`{ newspapers(limit: 50) { articles { tags newspaper { id } } } }`, nil, &data)
+		suite.Assert().Empty(result.Errors)
+	})
+	suite.Assert().Equal(3, queries)
+	suite.Assert().GreaterOrEqual(len(data.Newspapers), 3)
+	last := data.Newspapers[len(data.Newspapers)-1]
+	suite.Assert().Len(last.Articles, 3)
+	suite.Assert().Equal([]string{"天気"}, last.Articles[0].Tags)
+}
+
+func (suite *GraphTestSuite) TestArticles() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "毎日新聞")
+	short, _ := models.CreateArticle(ctx, "晴れ。", 2023, 5, 1, newspaper.ID, nil)
+	models.CreateArticle(ctx, "新緑がまぶしい季節になった。", 2023, 5, 2, newspaper.ID, nil)
+
+	var data struct {
+		Articles []struct {
+			ID        int
+			Newspaper struct{ Title string }
+		}
+	}
+	result := suite.execute(`query($id: Int) { articles(newspaperID: $id, sort: shortest, limit: 1) { id newspaper { title } } }`,
+		map[string]interface{}{"id": newspaper.ID}, &data)
+	suite.Assert().Empty(result.Errors)
+	suite.Assert().Len(data.Articles, 1)
+	suite.Assert().Equal(short.ID, data.Articles[0].ID)
+	suite.Assert().Equal("毎日新聞", data.Articles[0].Newspaper.Title)
+
+	// limit が範囲外の場合はエラー
+	result = suite.execute(`{ articles(limit: 101) { id } }`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+	suite.Assert().Contains(result.Errors[0].Message, "limit must be between 1 and 100")
+}
+
+func (suite *GraphTestSuite) TestNotFound() {
+	var data struct {
+		Newspaper *struct{ ID int }
+		Article   *struct{ ID int }
+	}
+	result := suite.execute(`{ newspaper(id: -1) { id } article(id: -1) { id } }`, nil, &data)
+	suite.Assert().Empty(result.Errors)
+	suite.Assert().Nil(data.Newspaper)
+	suite.Assert().Nil(data.Article)
+}
+
+func (suite *GraphTestSuite) TestMutations() {
+	var created struct {
+		CreateNewspaper struct {
+			ID    int
+			Title string
+		}
+	}
+	result := suite.execute(`mutation { createNewspaper(title: "読売新聞") { id title } }`, nil, &created)
+	suite.Assert().Empty(result.Errors)
+	newspaperID := created.CreateNewspaper.ID
+
+	var article struct {
+		CreateArticle struct {
+			ID      int
+			Summary string
+			Tags    []string
+		}
+	}
+	result = suite.execute(`mutation($newspaperID: Int!) {
+		createArticle(body: "紅葉が見頃だ。", year: 2023, month: 11, day: 20, newspaperID: $newspaperID) { id summary tags }
+	}`, map[string]interface{}{"newspaperID": newspaperID}, &article)
+	suite.Assert().Empty(result.Errors)
+	suite.Assert().Equal("紅葉が見頃だ。", article.CreateArticle.Summary)
+	suite.Assert().Equal([]string{}, article.CreateArticle.Tags)
+
+	var updated struct {
+		UpdateArticle struct{ Body string }
+	}
+	result = suite.execute(`mutation($id: Int!) { updateArticle(id: $id, body: "紅葉が散った。") { body } }`,
+		map[string]interface{}{"id": article.CreateArticle.ID}, &updated)
+	suite.Assert().Empty(result.Errors)
+	suite.Assert().Equal("紅葉が散った。", updated.UpdateArticle.Body)
+	revisions, _ := models.GetArticleRevisions(context.Background(), article.CreateArticle.ID, models.Fields{})
+	suite.Assert().Len(revisions, 2)
+
+	result = suite.execute(`mutation($id: Int!, $articleID: Int!) {
+		deleteArticle(id: $articleID)
+		deleteNewspaper(id: $id)
+	}`, map[string]interface{}{"id": newspaperID, "articleID": article.CreateArticle.ID}, nil)
+	suite.Assert().Empty(result.Errors)
+	_, err := models.GetNewspaper(context.Background(), newspaperID)
+	suite.Assert().ErrorIs(err, gorm.ErrRecordNotFound)
+
+	// 更新する記事が見つからない場合はエラー
+	result = suite.execute(`mutation { updateArticle(id: -1, body: "雨") { id } }`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+}
+
+func (suite *GraphTestSuite) TestLimits() {
+	// 深さは新聞 → 記事 → 新聞 → 記事 → 新聞 → 記事 → id で7
+	result := suite.execute(`{ newspapers { articles { newspaper { articles { newspaper { articles { id } } } } } } }`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+	suite.Assert().Equal("query depth 7 exceeds the limit of 6", result.Errors[0].Message)
+
+	// 複雑さは一覧の件数を掛けて見積もる（1 + 100 × (1 + 100 × 1)）
+	result = suite.execute(`{ newspapers(limit: 100) { articles(limit: 100) { id } } }`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+	suite.Assert().Equal("query complexity 10101 exceeds the limit of 5000", result.Errors[0].Message)
+
+	// フラグメントや変数で指定した件数も数える（1 + 50 × (1 + 100 × 2)）
+	result = suite.execute(`query($limit: Int) { newspapers(limit: $limit) { ...fields } }
+		fragment fields on Newspaper { articles(limit: 100) { id body } }`, map[string]interface{}{"limit": float64(50)}, nil)
+	suite.Assert().Len(result.Errors, 1)
+	suite.Assert().Equal("query complexity 10051 exceeds the limit of 5000", result.Errors[0].Message)
+
+	// 変数を渡さない場合は操作で宣言した既定値を数える（1 + 100 × (1 + 100 × 2)）
+	result = suite.execute(`query Q($n: Int = 100) { newspapers(limit: $n) { articles(limit: $n) { id body } } }`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+	suite.Assert().Equal("query complexity 20101 exceeds the limit of 5000", result.Errors[0].Message)
+
+	// 既定の件数（20）の場合は実行する
+	result = suite.execute(`{ newspapers { articles { id tags } } }`, nil, nil)
+	suite.Assert().Empty(result.Errors)
+}
+
+func (suite *GraphTestSuite) TestInvalidQuery() {
+	result := suite.execute(`{ newspapers { unknown } }`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+	result = suite.execute(`{ newspapers {`, nil, nil)
+	suite.Assert().Len(result.Errors, 1)
+}
@@ -0,0 +1,150 @@
+package graph
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// cost はクエリの深さと複雑さ（取得しうるフィールドの数の見積もり）
+// 一覧のフィールドは limit 件（省略した場合は既定の件数）の要素があるものとして、要素のフィールドの数を掛ける
+type cost struct {
+	depth      int
+	complexity int
+}
+
+// 実行する前にクエリを調べ、深さと複雑さを見積もる（検証済みのドキュメントに使う）
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+	defaults  map[string]ast.Value // 調べている操作の変数の既定値
+}
+
+func measure(document *ast.Document, operationName string, variables map[string]interface{}) cost {
+	a := &analyzer{fragments: map[string]*ast.FragmentDefinition{}, variables: variables}
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			a.fragments[fragment.Name.Value] = fragment
+		}
+	}
+	var total cost
+	for _, definition := range document.Definitions {
+		operation, ok := definition.(*ast.OperationDefinition)
+		if !ok || (operationName != "" && (operation.Name == nil || operation.Name.Value != operationName)) {
+			continue
+		}
+		a.defaults = map[string]ast.Value{}
+		for _, variable := range operation.VariableDefinitions {
+			if variable.DefaultValue != nil {
+				a.defaults[variable.Variable.Name.Value] = variable.DefaultValue
+			}
+		}
+		root := Schema.QueryType()
+		if operation.Operation == ast.OperationTypeMutation {
+			root = Schema.MutationType()
+		}
+		c := a.selectionSet(root, operation.SelectionSet)
+		total.depth = max(total.depth, c.depth)
+		total.complexity = max(total.complexity, c.complexity)
+	}
+	return total
+}
+
+func (a *analyzer) selectionSet(parent *graphql.Object, set *ast.SelectionSet) cost {
+	var total cost
+	if set == nil {
+		return total
+	}
+	for _, selection := range set.Selections {
+		var c cost
+		switch selection := selection.(type) {
+		case *ast.Field:
+			c = a.field(parent, selection)
+		case *ast.FragmentSpread:
+			fragment := a.fragments[selection.Name.Value]
+			c = a.selectionSet(a.object(parent, fragment.TypeCondition), fragment.SelectionSet)
+		case *ast.InlineFragment:
+			c = a.selectionSet(a.object(parent, selection.TypeCondition), selection.SelectionSet)
+		}
+		total.depth = max(total.depth, c.depth)
+		total.complexity += c.complexity
+	}
+	return total
+}
+
+func (a *analyzer) field(parent *graphql.Object, field *ast.Field) cost {
+	// __typename や __schema などのイントロスペクションは数えない
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return cost{depth: 1, complexity: 1}
+	}
+	definition, ok := parent.Fields()[field.Name.Value]
+	if !ok {
+		return cost{depth: 1, complexity: 1}
+	}
+	fieldType, multiplier := definition.Type, 1
+	if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+		fieldType = nonNull.OfType
+	}
+	if list, ok := fieldType.(*graphql.List); ok {
+		fieldType, multiplier = list.OfType, max(a.limit(definition, field), 1) // 範囲外の limit はリゾルバーがエラーにする
+		if nonNull, ok := fieldType.(*graphql.NonNull); ok {
+			fieldType = nonNull.OfType
+		}
+	}
+	object, ok := fieldType.(*graphql.Object)
+	if !ok {
+		return cost{depth: 1, complexity: 1}
+	}
+	children := a.selectionSet(object, field.SelectionSet)
+	return cost{depth: 1 + children.depth, complexity: 1 + multiplier*children.complexity}
+}
+
+// 一覧のフィールドの limit 引数の値（引数がないフィールドは1件とする）
+// 変数は渡された値、渡されていなければ操作で宣言した既定値を使い、値を読み取れない場合は上限の件数とみなす
+func (a *analyzer) limit(definition *graphql.FieldDefinition, field *ast.Field) int {
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "limit" {
+			continue
+		}
+		value := argument.Value
+		if variable, ok := value.(*ast.Variable); ok {
+			if provided, ok := a.variables[variable.Name.Value]; ok {
+				switch limit := provided.(type) {
+				case int:
+					return limit
+				case float64: // JSONから読み込んだ変数
+					return int(limit)
+				}
+				return maxLimit
+			}
+			if value, ok = a.defaults[variable.Name.Value]; !ok {
+				break // 変数も既定値もない場合は引数を省略したものとして扱う
+			}
+		}
+		if value, ok := value.(*ast.IntValue); ok {
+			if limit, err := strconv.Atoi(value.Value); err == nil {
+				return limit
+			}
+		}
+		return maxLimit
+	}
+	for _, argument := range definition.Args {
+		if limit, ok := argument.DefaultValue.(int); ok && argument.Name() == "limit" {
+			return limit
+		}
+	}
+	return 1
+}
+
+// フラグメントの型（型を指定していない場合は親の型）
+func (a *analyzer) object(parent *graphql.Object, condition *ast.Named) *graphql.Object {
+	if condition == nil {
+		return parent
+	}
+	if object, ok := Schema.Type(condition.Name.Value).(*graphql.Object); ok {
+		return object
+	}
+	return parent
+}
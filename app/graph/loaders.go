@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/graph-gophers/dataloader"
+
+	"go-api-newspaper/app/models"
+)
+
+// loaders はリクエストごとに作るデータローダー
+// 一覧の各要素のフィールドで関連を1件ずつ読み込まず、同じ深さで必要になったものをまとめて1回で読み込む（N+1問題を避ける）
+type loaders struct {
+	newspapers  *dataloader.Loader // 新聞のID → *models.Newspaper
+	articleTags *dataloader.Loader // 記事のID → []*models.Tag
+
+	mu                sync.Mutex
+	newspaperArticles map[articlePage]*dataloader.Loader // 新聞のID → []*models.Article（並び順とページごと）
+}
+
+// 新聞の記事の並び順とページ（同じページを要求した新聞どうしをまとめて読み込む）
+type articlePage struct {
+	order  models.ArticleOrder
+	limit  int
+	offset int
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		newspapers:        dataloader.NewBatchedLoader(batchNewspapers),
+		articleTags:       dataloader.NewBatchedLoader(batchArticleTags),
+		newspaperArticles: map[articlePage]*dataloader.Loader{},
+	})
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func (l *loaders) articlesLoader(page articlePage) *dataloader.Loader {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	loader, ok := l.newspaperArticles[page]
+	if !ok {
+		loader = dataloader.NewBatchedLoader(func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+			return batchNewspaperArticles(ctx, keys, page)
+		})
+		l.newspaperArticles[page] = loader
+	}
+	return loader
+}
+
+func idKey(ID int) dataloader.Key {
+	return dataloader.StringKey(strconv.Itoa(ID))
+}
+
+func keyIDs(keys dataloader.Keys) []int {
+	IDs := make([]int, len(keys))
+	for i, key := range keys {
+		IDs[i], _ = strconv.Atoi(key.String())
+	}
+	return IDs
+}
+
+// 全てのキーに同じエラーを返す
+func failAll(keys dataloader.Keys, err error) []*dataloader.Result {
+	results := make([]*dataloader.Result, len(keys))
+	for i := range results {
+		results[i] = &dataloader.Result{Error: err}
+	}
+	return results
+}
+
+func batchNewspapers(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	IDs := keyIDs(keys)
+	newspapers, err := models.GetNewspapersByIDs(ctx, IDs)
+	if err != nil {
+		return failAll(keys, err)
+	}
+	results := make([]*dataloader.Result, len(keys))
+	for i, ID := range IDs {
+		if newspaper, ok := newspapers[ID]; ok {
+			results[i] = &dataloader.Result{Data: newspaper}
+		} else {
+			results[i] = &dataloader.Result{Error: fmt.Errorf("newspaper %d not found", ID)}
+		}
+	}
+	return results
+}
+
+func batchArticleTags(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	IDs := keyIDs(keys)
+	tags, err := models.GetArticlesTags(ctx, IDs)
+	if err != nil {
+		return failAll(keys, err)
+	}
+	results := make([]*dataloader.Result, len(keys))
+	for i, ID := range IDs {
+		results[i] = &dataloader.Result{Data: tags[ID]}
+	}
+	return results
+}
+
+func batchNewspaperArticles(ctx context.Context, keys dataloader.Keys, page articlePage) []*dataloader.Result {
+	IDs := keyIDs(keys)
+	articles, err := models.GetNewspapersArticles(ctx, IDs, page.order, page.limit, page.offset)
+	if err != nil {
+		return failAll(keys, err)
+	}
+	results := make([]*dataloader.Result, len(keys))
+	for i, ID := range IDs {
+		results[i] = &dataloader.Result{Data: articles[ID]}
+	}
+	return results
+}
@@ -0,0 +1,155 @@
+package graph
+
+import (
+	"github.com/graphql-go/graphql"
+
+	"go-api-newspaper/app/models"
+)
+
+func resolveNewspaper(p graphql.ResolveParams) (interface{}, error) {
+	return nullIfNotFound(models.GetNewspaper(p.Context, p.Args["id"].(int)))
+}
+
+func resolveNewspapers(p graphql.ResolveParams) (interface{}, error) {
+	limit, offset, err := page(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	return models.GetNewspapers(p.Context, limit, offset)
+}
+
+// 新聞の記事は同じページを要求した新聞の分をまとめて読み込む
+func resolveNewspaperArticles(p graphql.ResolveParams) (interface{}, error) {
+	limit, offset, err := page(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	newspaper := p.Source.(*models.Newspaper)
+	loader := loadersFrom(p.Context).articlesLoader(articlePage{order: p.Args["sort"].(models.ArticleOrder), limit: limit, offset: offset})
+	thunk := loader.Load(p.Context, idKey(newspaper.ID))
+	return func() (interface{}, error) {
+		articles, err := thunk()
+		if err != nil || articles == nil {
+			return []*models.Article{}, err
+		}
+		for _, article := range articles.([]*models.Article) {
+			article.Newspaper = newspaper // 記事から新聞をたどる場合に読み込み直さない
+		}
+		return articles, nil
+	}, nil
+}
+
+func resolveArticle(p graphql.ResolveParams) (interface{}, error) {
+	return nullIfNotFound(models.GetArticle(p.Context, p.Args["id"].(int)))
+}
+
+func resolveArticles(p graphql.ResolveParams) (interface{}, error) {
+	limit, offset, err := page(p.Args)
+	if err != nil {
+		return nil, err
+	}
+	filter := models.ArticleFilter{
+		NewspaperID: optionalInt(p.Args, "newspaperID"),
+		ColumnID:    optionalInt(p.Args, "columnID"),
+	}
+	if tags, ok := p.Args["tags"].([]interface{}); ok {
+		for _, tag := range tags {
+			filter.Tags = append(filter.Tags, tag.(string))
+		}
+	}
+	return models.FindArticles(p.Context, filter, p.Args["sort"].(models.ArticleOrder), limit, offset, models.Fields{})
+}
+
+func resolveArticleNewspaper(p graphql.ResolveParams) (interface{}, error) {
+	article := p.Source.(*models.Article)
+	if article.Newspaper != nil {
+		return article.Newspaper, nil
+	}
+	thunk := loadersFrom(p.Context).newspapers.Load(p.Context, idKey(article.NewspaperID))
+	return func() (interface{}, error) {
+		return thunk()
+	}, nil
+}
+
+func resolveArticleTags(p graphql.ResolveParams) (interface{}, error) {
+	article := p.Source.(*models.Article)
+	thunk := loadersFrom(p.Context).articleTags.Load(p.Context, idKey(article.ID))
+	return func() (interface{}, error) {
+		tags, err := thunk()
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		if tags != nil {
+			for _, tag := range tags.([]*models.Tag) {
+				names = append(names, tag.Name)
+			}
+		}
+		return names, nil
+	}, nil
+}
+
+func resolveCreateNewspaper(p graphql.ResolveParams) (interface{}, error) {
+	return models.CreateNewspaper(p.Context, p.Args["title"].(string))
+}
+
+func resolveUpdateNewspaper(p graphql.ResolveParams) (interface{}, error) {
+	newspaper, err := models.GetNewspaper(p.Context, p.Args["id"].(int))
+	if err != nil {
+		return nil, err
+	}
+	if title, ok := p.Args["title"].(string); ok {
+		newspaper.Title = title
+	}
+	if err := newspaper.Save(p.Context); err != nil {
+		return nil, err
+	}
+	return newspaper, nil
+}
+
+func resolveDeleteNewspaper(p graphql.ResolveParams) (interface{}, error) {
+	newspaper := &models.Newspaper{ID: p.Args["id"].(int)}
+	if err := newspaper.Delete(p.Context); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
+
+func resolveCreateArticle(p graphql.ResolveParams) (interface{}, error) {
+	return models.CreateArticle(p.Context, p.Args["body"].(string), p.Args["year"].(int), p.Args["month"].(int), p.Args["day"].(int),
+		p.Args["newspaperID"].(int), optionalInt(p.Args, "columnID"))
+}
+
+func resolveUpdateArticle(p graphql.ResolveParams) (interface{}, error) {
+	article, err := models.GetArticle(p.Context, p.Args["id"].(int))
+	if err != nil {
+		return nil, err
+	}
+	if body, ok := p.Args["body"].(string); ok {
+		article.Body = body
+	}
+	if year := optionalInt(p.Args, "year"); year != nil {
+		article.Year = *year
+	}
+	if month := optionalInt(p.Args, "month"); month != nil {
+		article.Month = *month
+	}
+	if day := optionalInt(p.Args, "day"); day != nil {
+		article.Day = *day
+	}
+	if columnID := optionalInt(p.Args, "columnID"); columnID != nil {
+		article.ColumnID = columnID
+	}
+	if err := article.Save(p.Context); err != nil { // 本文が変わっていれば新しいリビジョンが記録される
+		return nil, err
+	}
+	return article, nil
+}
+
+func resolveDeleteArticle(p graphql.ResolveParams) (interface{}, error) {
+	article := &models.Article{ID: p.Args["id"].(int)}
+	if err := article.Delete(p.Context); err != nil {
+		return nil, err
+	}
+	return true, nil
+}
@@ -0,0 +1,211 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/app/models"
+)
+
+const (
+	defaultLimit = 20  // limit を省略した場合の件数（REST APIの一覧と同じ）
+	maxLimit     = 100 // limit に指定できる件数の上限
+)
+
+var articleSortEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name:        "ArticleSort",
+	Description: "記事の並び順。newest/oldest は発行日、shortest/longest は本文の文字数で並べる",
+	Values: graphql.EnumValueConfigMap{
+		"newest":   &graphql.EnumValueConfig{Value: models.OrderNewest},
+		"oldest":   &graphql.EnumValueConfig{Value: models.OrderOldest},
+		"shortest": &graphql.EnumValueConfig{Value: models.OrderShortest},
+		"longest":  &graphql.EnumValueConfig{Value: models.OrderLongest},
+	},
+})
+
+// 一覧のページを指定する引数
+var pageArgs = graphql.FieldConfigArgument{
+	"limit":  &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultLimit, Description: "1〜100"},
+	"offset": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+}
+
+func withPageArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	for name, arg := range pageArgs {
+		args[name] = arg
+	}
+	return args
+}
+
+// 引数のページを取り出す
+func page(args map[string]interface{}) (limit int, offset int, err error) {
+	limit, offset = args["limit"].(int), args["offset"].(int)
+	if limit < 1 || limit > maxLimit {
+		return 0, 0, fmt.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+	if offset < 0 {
+		return 0, 0, errors.New("offset must not be negative")
+	}
+	return limit, offset, nil
+}
+
+// 引数の整数を取り出す（省略した場合はnil）
+func optionalInt(args map[string]interface{}, name string) *int {
+	if value, ok := args[name].(int); ok {
+		return &value
+	}
+	return nil
+}
+
+// 見つからない場合はエラーではなくnullを返す
+func nullIfNotFound(value interface{}, err error) (interface{}, error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+var newspaperType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Newspaper",
+	Fields: graphql.Fields{
+		"id":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"title":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"columnName": &graphql.Field{Type: graphql.NewNonNull(graphql.String), DeprecationReason: "コラムは Column リソースで管理する"},
+	},
+})
+
+var articleType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Article",
+	Fields: graphql.Fields{
+		"id":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"body":        &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		"summary":     &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "本文から抜き出した要約"},
+		"year":        &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"month":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"day":         &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"newspaperID": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		"columnID":    &graphql.Field{Type: graphql.Int, Description: "コラムに属さない記事はnull"},
+		"newspaper": &graphql.Field{
+			Type:    graphql.NewNonNull(newspaperType),
+			Resolve: resolveArticleNewspaper,
+		},
+		"tags": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+			Description: "記事に付いたタグの名前（名前順）",
+			Resolve:     resolveArticleTags,
+		},
+	},
+})
+
+var queryType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Query",
+	Fields: graphql.Fields{
+		"newspaper": &graphql.Field{
+			Type:    newspaperType,
+			Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)}},
+			Resolve: resolveNewspaper,
+		},
+		"newspapers": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(newspaperType))),
+			Description: "新聞を作成した順に取得する",
+			Args:        withPageArgs(graphql.FieldConfigArgument{}),
+			Resolve:     resolveNewspapers,
+		},
+		"article": &graphql.Field{
+			Type:    articleType,
+			Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)}},
+			Resolve: resolveArticle,
+		},
+		"articles": &graphql.Field{
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(articleType))),
+			Description: "条件に一致する記事を取得する（GET /article と同じ条件）",
+			Args: withPageArgs(graphql.FieldConfigArgument{
+				"newspaperID": &graphql.ArgumentConfig{Type: graphql.Int},
+				"columnID":    &graphql.ArgumentConfig{Type: graphql.Int},
+				"tags":        &graphql.ArgumentConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Description: "全てのタグが付いた記事に絞り込む"},
+				"sort":        &graphql.ArgumentConfig{Type: articleSortEnum, DefaultValue: models.OrderNewest},
+			}),
+			Resolve: resolveArticles,
+		},
+	},
+})
+
+var mutationType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Mutation",
+	Fields: graphql.Fields{
+		"createNewspaper": &graphql.Field{
+			Type: graphql.NewNonNull(newspaperType),
+			Args: graphql.FieldConfigArgument{
+				"title": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: resolveCreateNewspaper,
+		},
+		"updateNewspaper": &graphql.Field{
+			Type: graphql.NewNonNull(newspaperType),
+			Args: graphql.FieldConfigArgument{
+				"id":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"title": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: resolveUpdateNewspaper,
+		},
+		"deleteNewspaper": &graphql.Field{
+			Type:    graphql.NewNonNull(graphql.Boolean),
+			Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)}},
+			Resolve: resolveDeleteNewspaper,
+		},
+		"createArticle": &graphql.Field{
+			Type: graphql.NewNonNull(articleType),
+			Args: graphql.FieldConfigArgument{
+				"body":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				"year":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"month":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"day":         &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"newspaperID": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"columnID":    &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: resolveCreateArticle,
+		},
+		"updateArticle": &graphql.Field{
+			Type: graphql.NewNonNull(articleType),
+			Args: graphql.FieldConfigArgument{
+				"id":       &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				"body":     &graphql.ArgumentConfig{Type: graphql.String},
+				"year":     &graphql.ArgumentConfig{Type: graphql.Int},
+				"month":    &graphql.ArgumentConfig{Type: graphql.Int},
+				"day":      &graphql.ArgumentConfig{Type: graphql.Int},
+				"columnID": &graphql.ArgumentConfig{Type: graphql.Int},
+			},
+			Resolve: resolveUpdateArticle,
+		},
+		"deleteArticle": &graphql.Field{
+			Type:    graphql.NewNonNull(graphql.Boolean),
+			Args:    graphql.FieldConfigArgument{"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)}},
+			Resolve: resolveDeleteArticle,
+		},
+	},
+})
+
+// Schema は新聞と記事のGraphQLスキーマ
+var Schema graphql.Schema
+
+func init() {
+	// 新聞と記事は互いを参照するため、新聞の記事は型を作った後に加える
+	newspaperType.AddFieldConfig("articles", &graphql.Field{
+		Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(articleType))),
+		Description: "新聞の記事",
+		Args: withPageArgs(graphql.FieldConfigArgument{
+			"sort": &graphql.ArgumentConfig{Type: articleSortEnum, DefaultValue: models.OrderNewest},
+		}),
+		Resolve: resolveNewspaperArticles,
+	})
+	var err error
+	Schema, err = graphql.NewSchema(graphql.SchemaConfig{Query: queryType, Mutation: mutationType})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -1,8 +1,14 @@
 package models
 
 import (
-	"encoding/json"
+	"context"
 	"go-api-newspaper/api"
+	"go-api-newspaper/configs"
+	"go-api-newspaper/pkg/summary"
+	"go-api-newspaper/pkg/textstats"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Article struct {
@@ -13,25 +19,207 @@ type Article struct {
 	Day         int
 	NewspaperID int
 	Newspaper   *Newspaper
+	ColumnID    *int   `gorm:"index"` // コラムに属さない記事はnil
+	Tags        []*Tag `gorm:"many2many:article_tags;constraint:OnDelete:CASCADE"`
+	Keywords    []*ArticleKeyword
+	ReadingStats
+	Summary    string             // 本文から抜き出した要約（保存時に本文から作る）
+	Annotation *ArticleAnnotation `gorm:"-"` // 本文の漢字の読み（LoadAnnotationで読み込んだ場合だけ設定される）
+	fields     selection          // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列（タグとキーワードは関連から読み込む）
+var articleFields = fieldColumns{
+	"id":          nil,
+	"body":        {"body"},
+	"newspaperID": {"newspaper_id"},
+	"columnID":    {"column_id"},
+	"year":        {"year"},
+	"month":       {"month"},
+	"day":         {"day"},
+	"tags":        nil,
+	"keywords":    nil,
+	"stats":       {"character_count", "sentence_count", "paragraph_count", "kanji_ratio", "kana_ratio", "reading_seconds"},
+	"summary":     {"summary"},
+	"annotation":  {"body"}, // 読みが保存されていなければ本文を解析する
+}
+
+// 選ばれたフィールドに必要な列とタグ・キーワードだけを読み込むスコープ
+func articleScope(selected selection) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Scopes(articleFields.scope("articles", selected))
+		if selected.has("tags") {
+			db = db.Preload("Tags", preloadTags)
+		}
+		if selected.has("keywords") {
+			db = db.Preload("Keywords", preloadKeywords)
+		}
+		return db
+	}
+}
+
+// ReadingStats は本文の文字数や読了時間などの統計（保存時に本文から計算する）
+type ReadingStats struct {
+	CharacterCount int `gorm:"index"`
+	SentenceCount  int
+	ParagraphCount int
+	KanjiRatio     float64
+	KanaRatio      float64
+	ReadingSeconds int
+}
+
+func newReadingStats(body string) ReadingStats {
+	stats := textstats.Compute(body)
+	return ReadingStats{
+		CharacterCount: stats.Characters,
+		SentenceCount:  stats.Sentences,
+		ParagraphCount: stats.Paragraphs,
+		KanjiRatio:     stats.KanjiRatio,
+		KanaRatio:      stats.KanaRatio,
+		ReadingSeconds: stats.ReadingSeconds,
+	}
+}
+
+// RebuildReadingStats は全記事の本文の統計を計算し直し、処理した記事の数を返す
+// 統計を保存するようになる前の記事や、計算方法を変えた後に使う
+func RebuildReadingStats(ctx context.Context, batchSize int) (int, error) {
+	return eachArticle(ctx, batchSize, func(tx *gorm.DB, article *Article) error {
+		stats := newReadingStats(article.Body)
+		return tx.Model(article).UpdateColumns(map[string]interface{}{ // フックを実行せず統計の列だけを更新する
+			"character_count": stats.CharacterCount,
+			"sentence_count":  stats.SentenceCount,
+			"paragraph_count": stats.ParagraphCount,
+			"kanji_ratio":     stats.KanjiRatio,
+			"kana_ratio":      stats.KanaRatio,
+			"reading_seconds": stats.ReadingSeconds,
+		}).Error
+	})
+}
+
+// SummarySentences は要約に含める文の数（SUMMARY_SENTENCES で変更できる）
+var SummarySentences = configs.Config.SummarySentences
+
+// RebuildSummaries は全記事の要約を作り直し、処理した記事の数を返す
+// 要約を保存するようになる前の記事や、要約の文の数を変えた後に使う
+func RebuildSummaries(ctx context.Context, batchSize int) (int, error) {
+	return eachArticle(ctx, batchSize, func(tx *gorm.DB, article *Article) error {
+		return tx.Model(article).UpdateColumn("summary", summary.Summarize(article.Body, SummarySentences)).Error
+	})
+}
+
+// ArticleFilter は記事の一覧・検索で共通に使う絞り込み条件
+// 値が設定されている条件だけを AND で適用する
+type ArticleFilter struct {
+	NewspaperID   *int
+	ColumnID      *int
+	Tags          []string // 全てのタグが付いた記事に絞り込む
+	MinCharacters *int     // 本文の文字数の下限
+	MaxCharacters *int     // 本文の文字数の上限
+}
+
+// ArticleOrder は記事の一覧の並び順
+type ArticleOrder string
+
+const (
+	OrderNewest   ArticleOrder = "newest"   // 発行日の新しい順
+	OrderOldest   ArticleOrder = "oldest"   // 発行日の古い順
+	OrderShortest ArticleOrder = "shortest" // 本文の短い順
+	OrderLongest  ArticleOrder = "longest"  // 本文の長い順
+)
+
+// 並び順をORDER BY句にする（不明な値は新しい順として扱う）
+func (o ArticleOrder) orderBy() string {
+	switch o {
+	case OrderOldest:
+		return "articles.year, articles.month, articles.day, articles.id"
+	case OrderShortest:
+		return "articles.character_count, articles.id"
+	case OrderLongest:
+		return "articles.character_count DESC, articles.id DESC"
+	default:
+		return "articles.year DESC, articles.month DESC, articles.day DESC, articles.id DESC"
+	}
+}
+
+// 絞り込み条件をクエリに適用する（db.Scopes(filter.Scope) のように使う）
+func (f ArticleFilter) Scope(db *gorm.DB) *gorm.DB {
+	if f.NewspaperID != nil {
+		db = db.Where("articles.newspaper_id = ?", *f.NewspaperID)
+	}
+	if f.ColumnID != nil {
+		db = db.Where("articles.column_id = ?", *f.ColumnID)
+	}
+	if f.MinCharacters != nil {
+		db = db.Where("articles.character_count >= ?", *f.MinCharacters)
+	}
+	if f.MaxCharacters != nil {
+		db = db.Where("articles.character_count <= ?", *f.MaxCharacters)
+	}
+	for _, name := range f.Tags {
+		tagged := db.Session(&gorm.Session{NewDB: true}).Model(&ArticleTag{}).
+			Select("article_tags.article_id").
+			Joins("JOIN tags ON tags.id = article_tags.tag_id").
+			Where("tags.name = ?", normalizeTagName(name))
+		db = db.Where("articles.id IN (?)", tagged)
+	}
+	return db
 }
 
 func (a *Article) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&api.ArticleResponse{
-		Id:    a.ID,
-		Body:  a.Body,
-		Year:  a.Year,
-		Month: a.Month,
-		Day:   a.Day,
-		Newspaper: api.Newspaper{
-			Id:         &a.Newspaper.ID,
-			Title:      a.Newspaper.Title,
-			ColumnName: a.Newspaper.ColumnName,
+	return a.fields.marshal(a.response())
+}
+
+func (a *Article) response() *api.ArticleResponse {
+	return &api.ArticleResponse{
+		Id:          a.ID,
+		Body:        a.Body,
+		Year:        a.Year,
+		Month:       a.Month,
+		Day:         a.Day,
+		NewspaperID: a.NewspaperID,
+		ColumnID:    a.ColumnID,
+		Tags:        a.tagNames(),
+		Keywords:    a.keywordNames(),
+		Stats: api.ArticleStats{
+			Characters:         a.CharacterCount,
+			Sentences:          a.SentenceCount,
+			Paragraphs:         a.ParagraphCount,
+			KanjiRatio:         a.KanjiRatio,
+			KanaRatio:          a.KanaRatio,
+			ReadingTimeSeconds: a.ReadingSeconds,
 		},
-	})
+		Summary:    a.Summary,
+		Annotation: a.Annotation.response(),
+	}
+}
+
+// キーワードを読み込んでいない場合はnilを返し、レスポンスからkeywordsを省略する
+func (a *Article) keywordNames() *[]string {
+	if len(a.Keywords) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(a.Keywords))
+	for _, keyword := range a.Keywords {
+		names = append(names, keyword.Keyword)
+	}
+	return &names
 }
 
-func CreateArticle(body string, year int, month int, day int, newspaperID int) (*Article, error) {
-	newspaper, err := GetNewspaper(newspaperID)
+// タグを読み込んでいない場合はnilを返し、レスポンスからtagsを省略する
+func (a *Article) tagNames() *[]string {
+	if len(a.Tags) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(a.Tags))
+	for _, tag := range a.Tags {
+		names = append(names, tag.Name)
+	}
+	return &names
+}
+
+// columnIDがnilの場合はコラムに属さない記事として作成する
+func CreateArticle(ctx context.Context, body string, year int, month int, day int, newspaperID int, columnID *int) (*Article, error) {
+	newspaper, err := GetNewspaper(ctx, newspaperID)
 	if err != nil {
 		return nil, err
 	}
@@ -43,31 +231,164 @@ func CreateArticle(body string, year int, month int, day int, newspaperID int) (
 		Day:         day,
 		NewspaperID: newspaperID,
 		Newspaper:   newspaper,
+		ColumnID:    columnID,
 	}
-	if err := DB.Create(article).Error; err != nil {
+	if err := conn(ctx).Create(article).Error; err != nil {
 		return nil, err
 	}
+	afterCommit(ctx, func() { indexArticle(article) })
 	return article, nil
 }
 
-func GetArticle(id int) (*Article, error) {
-	article := &Article{}
-	if err := DB.Where("id = ?", id).First(article).Error; err != nil {
+func GetArticle(ctx context.Context, id int) (*Article, error) {
+	return GetArticleWithFields(ctx, id, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んで記事を取得する（レスポンスを返すためだけに使い、保存しない）
+func GetArticleWithFields(ctx context.Context, id int, fields Fields) (*Article, error) {
+	selected, err := articleFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	article := &Article{fields: selected}
+	if err := conn(ctx).Scopes(articleScope(selected)).Where("id = ?", id).First(article).Error; err != nil {
 		return nil, err
 	}
 	return article, nil
 }
 
-func (a *Article) Save() error {
-	if err := DB.Save(a).Error; err != nil {
+// 条件に一致する記事を指定した順に、指定したフィールドに必要な列だけを読み込んで取得する
+func FindArticles(ctx context.Context, filter ArticleFilter, order ArticleOrder, limit int, offset int, fields Fields) ([]*Article, error) {
+	selected, err := articleFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	articles := []*Article{}
+	if err := conn(ctx).Scopes(articleScope(selected)).
+		Scopes(filter.Scope).
+		Order(order.orderBy()).
+		Limit(limit).
+		Offset(offset).
+		Find(&articles).Error; err != nil {
+		return nil, err
+	}
+	for _, article := range articles {
+		article.fields = selected
+	}
+	return articles, nil
+}
+
+// 複数の新聞の記事を新聞ごとに指定した順で取得する（新聞ごとに offset 件を飛ばして最大 limit 件）
+// 新聞ごとに1回ずつ検索せず、ウィンドウ関数で新聞ごとの順位を付けて1回の検索で取得する
+func GetNewspapersArticles(ctx context.Context, newspaperIDs []int, order ArticleOrder, limit int, offset int) (map[int][]*Article, error) {
+	ranked := conn(ctx).Model(&Article{}).
+		Select("articles.*, ROW_NUMBER() OVER (PARTITION BY articles.newspaper_id ORDER BY "+order.orderBy()+") AS row_num").
+		Where("articles.newspaper_id IN ?", newspaperIDs)
+	articles := []*Article{}
+	if err := conn(ctx).Table("(?) AS articles", ranked).
+		Where("row_num > ? AND row_num <= ?", offset, offset+limit).
+		Order("newspaper_id, row_num").
+		Find(&articles).Error; err != nil {
+		return nil, err
+	}
+	byNewspaper := make(map[int][]*Article, len(newspaperIDs))
+	for _, article := range articles {
+		byNewspaper[article.NewspaperID] = append(byNewspaper[article.NewspaperID], article)
+	}
+	return byNewspaper, nil
+}
+
+// タグやキーワードは個別に更新するため、保存時に関連するデータは更新しない
+func (a *Article) Save(ctx context.Context) error {
+	if err := conn(ctx).Omit(clause.Associations).Save(a).Error; err != nil {
 		return err
 	}
+	afterCommit(ctx, func() { indexArticle(a) })
 	return nil
 }
 
-func (a *Article) Delete() error {
-	if err := DB.Where("id = ?", a.ID).Delete(a).Error; err != nil {
+// 本文の統計と要約を作り、参照するコラムが記事と同じ新聞に属しているかを確認する（作成・更新時に実行される）
+func (a *Article) BeforeSave(tx *gorm.DB) error {
+	a.ReadingStats = newReadingStats(a.Body)
+	a.Summary = summary.Summarize(a.Body, SummarySentences)
+	if a.ColumnID == nil {
+		return nil
+	}
+	column := &Column{}
+	if err := tx.First(column, *a.ColumnID).Error; err != nil {
 		return err
 	}
+	if column.NewspaperID != a.NewspaperID {
+		return ErrColumnNotInNewspaper
+	}
 	return nil
 }
+
+// 本文が直前のリビジョンと異なる場合に、新しいリビジョンとして記録し、キーワードを抽出し直す（作成・更新時に同じトランザクション内で実行される）
+func (a *Article) AfterSave(tx *gorm.DB) error {
+	// 同じ記事を同時に更新しても同じ番号のリビジョンを作らないよう、最新のリビジョンをロックして読む
+	// （(article_id, revision) の一意制約でも重複を防ぐ）
+	latest := &ArticleRevision{}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("article_id = ?", a.ID).Order("revision DESC").Limit(1).Find(latest).Error; err != nil {
+		return err
+	}
+	if latest.ID != 0 && latest.Body == a.Body {
+		return nil
+	}
+	if err := tx.Create(&ArticleRevision{
+		ArticleID: a.ID,
+		Revision:  latest.Revision + 1,
+		Body:      a.Body,
+	}).Error; err != nil {
+		return err
+	}
+	return a.extractKeywords(tx)
+}
+
+// 記事の作成をイベントとしてアウトボックスに書く
+func (a *Article) AfterCreate(tx *gorm.DB) error {
+	return recordEvent(tx, EventArticleCreated, a)
+}
+
+// 記事の更新をイベントとしてアウトボックスに書く（統計などの列だけを更新する場合はフックが実行されないため書かない）
+func (a *Article) AfterUpdate(tx *gorm.DB) error {
+	return recordEvent(tx, EventArticleUpdated, a)
+}
+
+// 記事の削除時にリビジョン・タグの関連・キーワードもまとめて削除する
+func (a *Article) AfterDelete(tx *gorm.DB) error {
+	for _, model := range []interface{}{&ArticleRevision{}, &ArticleTag{}, &ArticleTerm{}, &ArticleKeyword{}, &ArticleAnnotation{}, &PracticeSession{}, &UserActivity{}} {
+		if err := tx.Where("article_id = ?", a.ID).Delete(model).Error; err != nil {
+			return err
+		}
+	}
+	// 単語帳の単語はユーザーのものなので残し、出典の記事との関連だけを外す
+	return tx.Model(&VocabularyEntry{}).Where("article_id = ?", a.ID).
+		UpdateColumns(map[string]interface{}{"article_id": nil, "char_offset": nil}).Error
+}
+
+// 記事を削除し、削除をイベントとしてアウトボックスに書く
+// 記事が存在しない場合は gorm.ErrRecordNotFound を返し、イベントも書かない
+func (a *Article) Delete(ctx context.Context) error {
+	return Transaction(ctx, func(ctx context.Context) error {
+		// 削除のイベントに新聞のIDを含めるため、IDだけを指定した場合は新聞のIDを読み込む
+		if a.NewspaperID == 0 {
+			if err := conn(ctx).Model(&Article{}).Select("newspaper_id").Where("id = ?", a.ID).Scan(&a.NewspaperID).Error; err != nil {
+				return err
+			}
+		}
+		result := conn(ctx).Where("id = ?", a.ID).Delete(a)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return gorm.ErrRecordNotFound
+		}
+		if err := recordEvent(conn(ctx), EventArticleDeleted, map[string]int{"id": a.ID, "newspaperID": a.NewspaperID}); err != nil {
+			return err
+		}
+		afterCommit(ctx, func() { RelatedIndex.Remove(a.ID) })
+		return nil
+	})
+}
@@ -0,0 +1,107 @@
+package models
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/pkg/furigana"
+)
+
+// AnnotationFormat は読みの返し方
+type AnnotationFormat string
+
+const (
+	AnnotationRuby  AnnotationFormat = "ruby"  // <ruby> 要素を使ったHTML
+	AnnotationSpans AnnotationFormat = "spans" // 読みを付けた断片の配列
+)
+
+// 対応していない返し方を指定した場合のエラー
+var ErrInvalidAnnotationFormat = errors.New("annotation format must be ruby or spans")
+
+// ArticleAnnotation は記事の本文のリビジョンごとに保存した読みの注釈
+// 形態素解析は本文が変わらない限り同じ結果になるため、最新のリビジョンの結果だけを残して使い回す
+type ArticleAnnotation struct {
+	ArticleID int                `gorm:"primaryKey"`
+	Revision  int                `gorm:"primaryKey"`
+	Segments  []furigana.Segment `gorm:"serializer:json"`
+	Format    AnnotationFormat   `gorm:"-"` // レスポンスの形式
+}
+
+func (n *ArticleAnnotation) response() *api.ArticleAnnotation {
+	if n == nil {
+		return nil
+	}
+	annotation := &api.ArticleAnnotation{Format: api.ArticleAnnotationFormat(n.Format)}
+	switch n.Format {
+	case AnnotationRuby:
+		html := furigana.HTML(n.Segments)
+		annotation.Html = &html
+	case AnnotationSpans:
+		spans := make([]api.AnnotationSpan, len(n.Segments))
+		for i, segment := range n.Segments {
+			spans[i] = api.AnnotationSpan{Text: segment.Text, Offset: segment.Offset}
+			if segment.Reading != "" {
+				reading := segment.Reading
+				spans[i].Reading = &reading
+			}
+		}
+		annotation.Spans = &spans
+	}
+	return annotation
+}
+
+// LoadAnnotation は本文の漢字の読みを読み込む
+// 最新のリビジョンの読みが保存されていなければ本文を解析して保存し、古いリビジョンの読みは削除する
+// 解析する本文はデータベースから読み直す（一部のフィールドだけを読み込んだ記事でも、本文を欠いた読みを保存しない）
+func (a *Article) LoadAnnotation(ctx context.Context, format AnnotationFormat) error {
+	if format != AnnotationRuby && format != AnnotationSpans {
+		return ErrInvalidAnnotationFormat
+	}
+
+	var revision int
+	if err := conn(ctx).Model(&ArticleRevision{}).Select("COALESCE(MAX(revision), 0)").
+		Where("article_id = ?", a.ID).Scan(&revision).Error; err != nil {
+		return err
+	}
+
+	annotation := &ArticleAnnotation{}
+	if err := conn(ctx).Where("article_id = ? AND revision = ?", a.ID, revision).Limit(1).Find(annotation).Error; err != nil {
+		return err
+	}
+	if annotation.ArticleID == 0 {
+		body, err := revisionBody(ctx, a.ID, revision)
+		if err != nil {
+			return err
+		}
+		annotation = &ArticleAnnotation{ArticleID: a.ID, Revision: revision, Segments: furigana.Annotate(body)}
+		if err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Where("article_id = ? AND revision <> ?", a.ID, revision).Delete(&ArticleAnnotation{}).Error; err != nil {
+				return err
+			}
+			// 同時に解析した別のリクエストが先に保存していても結果は同じ
+			return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(annotation).Error
+		}); err != nil {
+			return err
+		}
+	}
+	annotation.Format = format
+	a.Annotation = annotation
+	return nil
+}
+
+// リビジョンの本文（リビジョンがない記事では記事の本文）
+func revisionBody(ctx context.Context, articleID int, revision int) (string, error) {
+	var body string
+	query := conn(ctx).Model(&ArticleRevision{}).Where("article_id = ? AND revision = ?", articleID, revision)
+	if revision == 0 {
+		query = conn(ctx).Model(&Article{}).Where("id = ?", articleID)
+	}
+	if err := query.Select("body").Limit(1).Scan(&body).Error; err != nil {
+		return "", err
+	}
+	return body, nil
+}
@@ -0,0 +1,79 @@
+package models_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/furigana"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleAnnotationTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestArticleAnnotationTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleAnnotationTestSuite))
+}
+
+func (suite *ArticleAnnotationTestSuite) TestLoadAnnotation() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	article, _ := models.CreateArticle(ctx, "日本の新聞", 2023, 4, 1, newspaper.ID, nil)
+
+	suite.Assert().Nil(article.LoadAnnotation(ctx, models.AnnotationRuby))
+	suite.Assert().Equal(1, article.Annotation.Revision)
+	articleJSON, err := article.MarshalJSON()
+	suite.Assert().Nil(err)
+	var response api.ArticleResponse
+	suite.Assert().Nil(json.Unmarshal(articleJSON, &response))
+	suite.Assert().Equal(api.ArticleAnnotationFormatRuby, response.Annotation.Format)
+	suite.Assert().True(strings.HasPrefix(*response.Annotation.Html, "<ruby>日本<rp>(</rp><rt>にほん</rt>"))
+	suite.Assert().Nil(response.Annotation.Spans)
+
+	// 保存した読みを使い回す（本文を解析し直さない）
+	suite.Assert().Nil(models.DB.Model(&models.ArticleAnnotation{}).Where("article_id = ?", article.ID).
+		Update("segments", `[{"Text":"保存済み","Reading":"","Offset":0}]`).Error)
+	found, _ := models.GetArticle(ctx, article.ID)
+	suite.Assert().Nil(found.LoadAnnotation(ctx, models.AnnotationSpans))
+	suite.Assert().Equal([]furigana.Segment{{Text: "保存済み"}}, found.Annotation.Segments)
+
+	// 本文が変わると新しいリビジョンの読みを作り、古いリビジョンの読みは削除する
+	found.Body = "世界の新聞"
+	suite.Assert().Nil(found.Save(ctx))
+	suite.Assert().Nil(found.LoadAnnotation(ctx, models.AnnotationSpans))
+	suite.Assert().Equal(2, found.Annotation.Revision)
+	suite.Assert().Equal("せかい", found.Annotation.Segments[0].Reading)
+	var count int64
+	models.DB.Model(&models.ArticleAnnotation{}).Where("article_id = ?", article.ID).Count(&count)
+	suite.Assert().Equal(int64(1), count)
+
+	articleJSON, _ = found.MarshalJSON()
+	suite.Assert().Contains(string(articleJSON), `"spans":[{"offset":0,"reading":"せかい","text":"世界"},{"offset":2,"text":"の"}`)
+
+	suite.Assert().ErrorIs(found.LoadAnnotation(ctx, "kana"), models.ErrInvalidAnnotationFormat)
+}
+
+func (suite *ArticleAnnotationTestSuite) TestLoadAnnotationWithFields() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "読売新聞")
+	article, _ := models.CreateArticle(ctx, "日本の新聞", 2023, 4, 2, newspaper.ID, nil)
+
+	// 本文を読み込んでいない記事でも、本文を読み直して解析する
+	sparse, err := models.GetArticleWithFields(ctx, article.ID, models.Fields{Include: []string{"id"}})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("", sparse.Body)
+	suite.Assert().Nil(sparse.LoadAnnotation(ctx, models.AnnotationSpans))
+	suite.Assert().Equal("にほん", sparse.Annotation.Segments[0].Reading)
+
+	// 保存した読みも本文から作ったもの
+	found, _ := models.GetArticle(ctx, article.ID)
+	suite.Assert().Nil(found.LoadAnnotation(ctx, models.AnnotationSpans))
+	suite.Assert().Equal(sparse.Annotation.Segments, found.Annotation.Segments)
+}
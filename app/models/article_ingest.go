@@ -0,0 +1,56 @@
+package models
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+)
+
+// ContentHash は本文から空白を除いた内容のハッシュを返す
+// 取り込み元のHTMLの改行やインデントの違いで、同じ記事を別の記事とみなさないようにする
+func ContentHash(body string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, body)
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// FindDuplicateArticle は同じ新聞・発行日の記事のうち、本文の内容が同じ記事を探す（ない場合はnil）
+func FindDuplicateArticle(ctx context.Context, newspaperID int, year int, month int, day int, body string) (*Article, error) {
+	articles := []*Article{}
+	if err := conn(ctx).Where("newspaper_id = ? AND year = ? AND month = ? AND day = ?", newspaperID, year, month, day).
+		Order("id").Find(&articles).Error; err != nil {
+		return nil, err
+	}
+	hash := ContentHash(body)
+	for _, article := range articles {
+		if ContentHash(article.Body) == hash {
+			return article, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateArticleUnlessDuplicate は同じ記事がまだなければ記事を作成する（外部から取り込んだ記事の保存用）
+// 同じ記事が既にある場合はその記事とfalseを返す
+// 確認と作成の間に他から同じ記事が作成されることは考慮しないため、同じ取得元の取り込みを並行して実行しないこと
+func CreateArticleUnlessDuplicate(ctx context.Context, body string, year int, month int, day int, newspaperID int, columnID *int) (*Article, bool, error) {
+	duplicate, err := FindDuplicateArticle(ctx, newspaperID, year, month, day, body)
+	if err != nil {
+		return nil, false, err
+	}
+	if duplicate != nil {
+		return duplicate, false, nil
+	}
+	article, err := CreateArticle(ctx, body, year, month, day, newspaperID, columnID)
+	if err != nil {
+		return nil, false, err
+	}
+	return article, true, nil
+}
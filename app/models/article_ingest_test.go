@@ -0,0 +1,45 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleIngestTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestArticleIngestTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleIngestTestSuite))
+}
+
+func (suite *ArticleIngestTestSuite) TestCreateArticleUnlessDuplicate() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	other, _ := models.CreateNewspaper(ctx, "毎日新聞")
+
+	article, created, err := models.CreateArticleUnlessDuplicate(ctx, "桜が咲いた。\n花見に行く。", 2023, 4, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().True(created)
+
+	// 空白の違いだけの本文は同じ記事とみなす
+	duplicate, created, err := models.CreateArticleUnlessDuplicate(ctx, "  桜が咲いた。 花見に行く。\n", 2023, 4, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().False(created)
+	suite.Assert().Equal(article.ID, duplicate.ID)
+
+	// 発行日や新聞が違えば別の記事
+	_, created, _ = models.CreateArticleUnlessDuplicate(ctx, "桜が咲いた。\n花見に行く。", 2023, 4, 2, newspaper.ID, nil)
+	suite.Assert().True(created)
+	_, created, _ = models.CreateArticleUnlessDuplicate(ctx, "桜が咲いた。\n花見に行く。", 2023, 4, 1, other.ID, nil)
+	suite.Assert().True(created)
+
+	found, err := models.FindDuplicateArticle(ctx, newspaper.ID, 2023, 4, 1, "雨が降った。")
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(found)
+}
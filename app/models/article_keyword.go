@@ -0,0 +1,134 @@
+package models
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"go-api-newspaper/pkg/keywords"
+)
+
+// KeywordLimit は記事ごとに保存するキーワードの数
+var KeywordLimit = 10
+
+// ArticleTerm は記事に含まれるキーワード候補の語とその出現回数
+// 語ごとに含む記事の数を数え、TF-IDFのIDFの計算に使う
+type ArticleTerm struct {
+	ArticleID int    `gorm:"primaryKey"`
+	Term      string `gorm:"primaryKey;size:191;index"` // keywords.MaxTermLength 以下
+	Count     int
+}
+
+// ArticleKeyword は記事から抽出したキーワード
+type ArticleKeyword struct {
+	ArticleID int    `gorm:"primaryKey"`
+	Position  int    `gorm:"primaryKey"`     // スコアの高い順に1から始まる順位
+	Keyword   string `gorm:"size:191;index"` // keywords.MaxTermLength 以下
+	Score     float64
+}
+
+// キーワードをスコアの高い順に読み込む（Preload用）
+func preloadKeywords(db *gorm.DB) *gorm.DB {
+	return db.Order("article_keywords.position")
+}
+
+// 本文に含まれる語の出現回数を保存し直し、キーワードを抽出する（本文の作成・更新時に実行される）
+func (a *Article) extractKeywords(tx *gorm.DB) error {
+	tf := keywords.Terms(a.Body)
+	if err := a.saveTerms(tx, tf); err != nil {
+		return err
+	}
+	return a.rankKeywords(tx, tf)
+}
+
+func (a *Article) saveTerms(tx *gorm.DB, tf map[string]int) error {
+	if err := tx.Where("article_id = ?", a.ID).Delete(&ArticleTerm{}).Error; err != nil {
+		return err
+	}
+	if len(tf) == 0 {
+		return nil
+	}
+	terms := make([]*ArticleTerm, 0, len(tf))
+	for term, count := range tf {
+		terms = append(terms, &ArticleTerm{ArticleID: a.ID, Term: term, Count: count})
+	}
+	return tx.Create(&terms).Error
+}
+
+// 保存済みの全記事の語の出現回数をコーパスとして、TF-IDFのスコアが高い語をキーワードとして保存する
+func (a *Article) rankKeywords(tx *gorm.DB, tf map[string]int) error {
+	var documents int64
+	if err := tx.Model(&Article{}).Count(&documents).Error; err != nil {
+		return err
+	}
+
+	df := map[string]int{}
+	if len(tf) > 0 {
+		terms := make([]string, 0, len(tf))
+		for term := range tf {
+			terms = append(terms, term)
+		}
+		var rows []struct {
+			Term  string
+			Count int
+		}
+		if err := tx.Model(&ArticleTerm{}).Select("term, COUNT(*) AS count").
+			Where("term IN ?", terms).Group("term").Scan(&rows).Error; err != nil {
+			return err
+		}
+		for _, row := range rows {
+			df[row.Term] = row.Count
+		}
+	}
+
+	if err := tx.Where("article_id = ?", a.ID).Delete(&ArticleKeyword{}).Error; err != nil {
+		return err
+	}
+	a.Keywords = []*ArticleKeyword{}
+	for i, keyword := range keywords.TopN(tf, df, int(documents), KeywordLimit) {
+		a.Keywords = append(a.Keywords, &ArticleKeyword{
+			ArticleID: a.ID,
+			Position:  i + 1,
+			Keyword:   keyword.Term,
+			Score:     keyword.Score,
+		})
+	}
+	if len(a.Keywords) == 0 {
+		return nil
+	}
+	return tx.Create(&a.Keywords).Error
+}
+
+// RebuildTerms は全記事の本文から語の出現回数を保存し直し、処理した記事の数を返す
+func RebuildTerms(ctx context.Context, batchSize int) (int, error) {
+	return eachArticle(ctx, batchSize, func(tx *gorm.DB, article *Article) error {
+		return article.saveTerms(tx, keywords.Terms(article.Body))
+	})
+}
+
+// RebuildKeywords は全記事のキーワードを抽出し直し、処理した記事の数を返す
+// 先に全記事の語の出現回数を保存し直してから、揃ったコーパスでキーワードを計算する
+func RebuildKeywords(ctx context.Context, batchSize int) (int, error) {
+	if processed, err := RebuildTerms(ctx, batchSize); err != nil {
+		return processed, err
+	}
+	return eachArticle(ctx, batchSize, func(tx *gorm.DB, article *Article) error {
+		return article.rankKeywords(tx, keywords.Terms(article.Body))
+	})
+}
+
+// 全記事をbatchSize件ずつ読み込み、記事ごとのトランザクションでfnを実行する
+func eachArticle(ctx context.Context, batchSize int, fn func(tx *gorm.DB, article *Article) error) (int, error) {
+	processed := 0
+	articles := []*Article{}
+	result := conn(ctx).FindInBatches(&articles, batchSize, func(_ *gorm.DB, _ int) error {
+		for _, article := range articles {
+			if err := conn(ctx).Transaction(func(tx *gorm.DB) error { return fn(tx, article) }); err != nil {
+				return err
+			}
+			processed++
+		}
+		return nil
+	})
+	return processed, result.Error
+}
@@ -0,0 +1,60 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleKeywordTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestArticleKeywordTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleKeywordTestSuite))
+}
+
+func keywordNames(article *models.Article) []string {
+	names := []string{}
+	for _, keyword := range article.Keywords {
+		names = append(names, keyword.Keyword)
+	}
+	return names
+}
+
+func (suite *ArticleKeywordTestSuite) TestKeywords() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	first, err := models.CreateArticle(ctx, "季節の花見。花見の名所。", 2023, 4, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"花見", "名所", "季節"}, keywordNames(first))
+
+	second, _ := models.CreateArticle(ctx, "季節の野球。野球の大会。", 2023, 4, 2, newspaper.ID, nil)
+	// 両方の記事に現れる「季節」は、出現回数が同じ「大会」よりも順位が下がる
+	suite.Assert().Equal([]string{"野球", "大会", "季節"}, keywordNames(second))
+
+	// 本文を更新するとキーワードも抽出し直す
+	second.Body = "選挙の季節。"
+	suite.Assert().Nil(second.Save(ctx))
+	updated, err := models.GetArticle(ctx, second.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]string{"選挙", "季節"}, keywordNames(updated))
+	articleJSON, _ := updated.MarshalJSON()
+	suite.Assert().Contains(string(articleJSON), `"keywords":["選挙","季節"]`)
+
+	// 記事の作成後に増えた記事もコーパスに含めて計算し直す
+	processed, err := models.RebuildKeywords(ctx, 1)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, processed)
+	rebuilt, _ := models.GetArticle(ctx, first.ID)
+	suite.Assert().Equal([]string{"花見", "名所", "季節"}, keywordNames(rebuilt))
+
+	suite.Assert().Nil(rebuilt.Delete(ctx))
+	articles, err := models.FindArticles(ctx, models.ArticleFilter{}, models.OrderNewest, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 1)
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+
+	"go-api-newspaper/pkg/keywords"
+	"go-api-newspaper/pkg/similarity"
+)
+
+// RelatedIndex は関連記事を探すためのインメモリのインデックス
+// 記事の作成・更新・削除のたびに更新し、起動時は LoadRelatedIndex で保存済みの語の出現回数から作る
+var RelatedIndex = similarity.NewIndex()
+
+// タグなどで絞り込む場合に、類似度の高い順に先に選んでおく候補の最大数
+const maxRelatedCandidates = 1000
+
+// RelatedArticle は関連記事とその類似度
+type RelatedArticle struct {
+	Article *Article
+	Score   float64
+}
+
+// 記事は api.ArticleResponse と同じ形式で、指定したフィールドだけを含める
+func (r *RelatedArticle) MarshalJSON() ([]byte, error) {
+	article, err := r.Article.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		Article json.RawMessage `json:"article"`
+		Score   float64         `json:"score"`
+	}{
+		Article: article,
+		Score:   r.Score,
+	})
+}
+
+// 記事の本文の語の出現回数をインデックスに反映する
+func indexArticle(a *Article) {
+	RelatedIndex.Add(a.ID, a.NewspaperID, keywords.Terms(a.Body))
+}
+
+// LoadRelatedIndex は保存済みの語の出現回数からインデックスを作り直して差し替え、含まれる記事の数を返す
+func LoadRelatedIndex(ctx context.Context) (int, error) {
+	rows, err := conn(ctx).Model(&ArticleTerm{}).
+		Select("article_terms.article_id, articles.newspaper_id, article_terms.term, article_terms.count").
+		Joins("JOIN articles ON articles.id = article_terms.article_id").
+		Order("article_terms.article_id").
+		Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	index := similarity.NewIndex()
+	var articleID, newspaperID int
+	terms := map[string]int{}
+	for rows.Next() {
+		var id, group, count int
+		var term string
+		if err := rows.Scan(&id, &group, &term, &count); err != nil {
+			return 0, err
+		}
+		if id != articleID && len(terms) > 0 {
+			index.Add(articleID, newspaperID, terms)
+			terms = map[string]int{}
+		}
+		articleID, newspaperID = id, group
+		terms[term] = count
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	if len(terms) > 0 {
+		index.Add(articleID, newspaperID, terms)
+	}
+
+	RelatedIndex.Replace(index)
+	return RelatedIndex.Len(), nil
+}
+
+// FindRelatedArticles は記事に似た記事を類似度の高い順に最大k件取得する
+// filterの新聞の条件はインデックスで、それ以外の条件はデータベースで絞り込む
+// 関連記事は指定したフィールドに必要な列だけを読み込む
+func FindRelatedArticles(ctx context.Context, article *Article, k int, filter ArticleFilter, fields Fields) ([]*RelatedArticle, error) {
+	selected, err := articleFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	related := []*RelatedArticle{}
+	candidates := RelatedIndex.Similar(article.ID, maxRelatedCandidates, filter.NewspaperID)
+	if len(candidates) == 0 {
+		return related, nil
+	}
+
+	ids := make([]int, 0, len(candidates))
+	for _, candidate := range candidates {
+		ids = append(ids, candidate.ID)
+	}
+	articles := []*Article{}
+	if err := conn(ctx).Scopes(articleScope(selected)).
+		Scopes(filter.Scope).
+		Where("articles.id IN ?", ids).
+		Find(&articles).Error; err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]*Article, len(articles))
+	for _, a := range articles {
+		a.fields = selected
+		byID[a.ID] = a
+	}
+	for _, candidate := range candidates {
+		if a, ok := byID[candidate.ID]; ok && len(related) < k {
+			related = append(related, &RelatedArticle{Article: a, Score: candidate.Score})
+		}
+	}
+	return related, nil
+}
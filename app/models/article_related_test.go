@@ -0,0 +1,63 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleRelatedTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestArticleRelatedTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleRelatedTestSuite))
+}
+
+func relatedIDs(related []*models.RelatedArticle) []int {
+	ids := []int{}
+	for _, r := range related {
+		ids = append(ids, r.Article.ID)
+	}
+	return ids
+}
+
+func (suite *ArticleRelatedTestSuite) TestFindRelatedArticles() {
+	ctx := context.Background()
+	asahi, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	yomiuri, _ := models.CreateNewspaper(ctx, "読売新聞")
+	spring, _ := models.CreateArticle(ctx, "桜の花見。花見の名所。", 2023, 4, 1, asahi.ID, nil)
+	hanami, _ := models.CreateArticle(ctx, "花見の季節。", 2023, 4, 2, asahi.ID, nil)
+	sakura, _ := models.CreateArticle(ctx, "桜の名所で花見。", 2023, 4, 3, yomiuri.ID, nil)
+	models.CreateArticle(ctx, "選挙の季節。", 2023, 7, 1, asahi.ID, nil)
+
+	// 他のテストで作られた記事を含まないよう、データベースからインデックスを作り直す
+	loaded, err := models.LoadRelatedIndex(ctx)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(4, loaded)
+
+	related, err := models.FindRelatedArticles(ctx, spring, 2, models.ArticleFilter{}, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]int{sakura.ID, hanami.ID}, relatedIDs(related))
+	suite.Assert().Greater(related[0].Score, related[1].Score)
+
+	related, err = models.FindRelatedArticles(ctx, spring, 5, models.ArticleFilter{NewspaperID: &asahi.ID}, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]int{hanami.ID}, relatedIDs(related))
+
+	// 更新・削除はインデックスにすぐ反映される
+	suite.Assert().Nil(hanami.AddTag(ctx, "行事"))
+	sakura.Body = "選挙の結果。"
+	suite.Assert().Nil(sakura.Save(ctx))
+	related, err = models.FindRelatedArticles(ctx, spring, 5, models.ArticleFilter{Tags: []string{"行事"}}, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]int{hanami.ID}, relatedIDs(related))
+	suite.Assert().Nil(hanami.Delete(ctx))
+	related, err = models.FindRelatedArticles(ctx, spring, 5, models.ArticleFilter{}, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(related)
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"go-api-newspaper/api"
+)
+
+// ArticleRevision は記事本文の過去のバージョンを保持する
+// Revisionは記事ごとに1から始まり、本文が変更されるたびに増える
+type ArticleRevision struct {
+	ID        int
+	ArticleID int `gorm:"index;uniqueIndex:idx_article_revisions_article_revision,priority:1"`
+	Revision  int `gorm:"uniqueIndex:idx_article_revisions_article_revision,priority:2"`
+	Body      string
+	CreatedAt time.Time
+	fields    selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var revisionFields = fieldColumns{
+	"articleID": {"article_id"},
+	"revision":  {"revision"},
+	"body":      {"body"},
+	"createdAt": {"created_at"},
+}
+
+func (r *ArticleRevision) MarshalJSON() ([]byte, error) {
+	return r.fields.marshal(&api.ArticleRevisionResponse{
+		ArticleID: r.ArticleID,
+		Revision:  r.Revision,
+		Body:      r.Body,
+		CreatedAt: r.CreatedAt,
+	})
+}
+
+// 記事のリビジョンを古い順に、指定したフィールドに必要な列だけを読み込んで取得する
+func GetArticleRevisions(ctx context.Context, articleID int, fields Fields) ([]*ArticleRevision, error) {
+	selected, err := revisionFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	revisions := []*ArticleRevision{}
+	if err := conn(ctx).Scopes(revisionFields.scope("article_revisions", selected)).
+		Where("article_id = ?", articleID).Order("revision").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		revision.fields = selected
+	}
+	return revisions, nil
+}
+
+func GetArticleRevision(ctx context.Context, articleID int, revision int) (*ArticleRevision, error) {
+	return GetArticleRevisionWithFields(ctx, articleID, revision, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んでリビジョンを取得する（レスポンスを返すためだけに使う）
+func GetArticleRevisionWithFields(ctx context.Context, articleID int, revision int, fields Fields) (*ArticleRevision, error) {
+	selected, err := revisionFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	articleRevision := &ArticleRevision{fields: selected}
+	if err := conn(ctx).Scopes(revisionFields.scope("article_revisions", selected)).
+		Where("article_id = ? AND revision = ?", articleID, revision).First(articleRevision).Error; err != nil {
+		return nil, err
+	}
+	return articleRevision, nil
+}
+
+// 記事本文を指定したリビジョンの内容に戻す
+// 過去の履歴は書き換えず、復元した本文を新しいリビジョンとして追加する
+func (a *Article) RestoreRevision(ctx context.Context, revision int) error {
+	articleRevision, err := GetArticleRevision(ctx, a.ID, revision)
+	if err != nil {
+		return err
+	}
+	a.Body = articleRevision.Body
+	return a.Save(ctx)
+}
@@ -0,0 +1,106 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ArticleRevisionTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestArticleRevisionTestSuite(t *testing.T) {
+	suite.Run(t, new(ArticleRevisionTestSuite))
+}
+
+func (suite *ArticleRevisionTestSuite) TestRevisionsOnUpdate() {
+	newspaper, err := models.CreateNewspaper(context.Background(), "Test Newspaper")
+	suite.Assert().Nil(err)
+	article, err := models.CreateArticle(context.Background(), "初版", 2023, 10, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+
+	// 本文以外の更新ではリビジョンは増えない
+	article.Day = 2
+	suite.Assert().Nil(article.Save(context.Background()))
+	article.Body = "第二版"
+	suite.Assert().Nil(article.Save(context.Background()))
+
+	revisions, err := models.GetArticleRevisions(context.Background(), article.ID, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(revisions, 2)
+	suite.Assert().Equal(1, revisions[0].Revision)
+	suite.Assert().Equal("初版", revisions[0].Body)
+	suite.Assert().Equal(2, revisions[1].Revision)
+	suite.Assert().Equal("第二版", revisions[1].Body)
+
+	revision, err := models.GetArticleRevision(context.Background(), article.ID, 1)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("初版", revision.Body)
+}
+
+func (suite *ArticleRevisionTestSuite) TestRestoreRevision() {
+	newspaper, err := models.CreateNewspaper(context.Background(), "Test Newspaper")
+	suite.Assert().Nil(err)
+	article, err := models.CreateArticle(context.Background(), "初版", 2023, 10, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	article.Body = "誤りのある版"
+	suite.Assert().Nil(article.Save(context.Background()))
+
+	suite.Assert().Nil(article.RestoreRevision(context.Background(), 1))
+	restored, err := models.GetArticle(context.Background(), article.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("初版", restored.Body)
+
+	// 復元も新しいリビジョンとして記録され、過去の履歴は残る
+	revisions, err := models.GetArticleRevisions(context.Background(), article.ID, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(revisions, 3)
+	suite.Assert().Equal("誤りのある版", revisions[1].Body)
+	suite.Assert().Equal("初版", revisions[2].Body)
+
+	err = article.RestoreRevision(context.Background(), 99)
+	suite.Assert().NotNil(err)
+}
+
+func (suite *ArticleRevisionTestSuite) TestRevisionsDeletedWithArticle() {
+	newspaper, err := models.CreateNewspaper(context.Background(), "Test Newspaper")
+	suite.Assert().Nil(err)
+	article, err := models.CreateArticle(context.Background(), "削除される記事", 2023, 10, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+
+	suite.Assert().Nil(article.Delete(context.Background()))
+	revisions, err := models.GetArticleRevisions(context.Background(), article.ID, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(revisions, 0)
+}
+
+func (suite *ArticleRevisionTestSuite) TestArticleRevisionMarshal() {
+	revision := models.ArticleRevision{
+		ArticleID: 1,
+		Revision:  2,
+		Body:      "Test",
+	}
+	revisionJSON, err := revision.MarshalJSON()
+	suite.Assert().Nil(err)
+	suite.Assert().JSONEq(`{
+		"articleID":1,
+		"revision":2,
+		"body":"Test",
+		"createdAt":"0001-01-01T00:00:00Z"
+	}`, string(revisionJSON))
+}
+
+func (suite *ArticleRevisionTestSuite) TestUniqueRevision() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "Unique Newspaper")
+	article, err := models.CreateArticle(context.Background(), "一意", 2023, 10, 5, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+
+	// 同じ記事・番号のリビジョンは作れない
+	err = models.DB.Create(&models.ArticleRevision{ArticleID: article.ID, Revision: 1, Body: "重複"}).Error
+	suite.Assert().NotNil(err)
+}
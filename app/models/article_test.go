@@ -1,6 +1,7 @@
 package models_test
 
 import (
+	"context"
 	"errors"
 	"gorm.io/gorm"
 	"regexp"
@@ -25,7 +26,7 @@ func TestArticleTestSuite(t *testing.T) {
 
 func (suite *ArticleTestSuite) SetupSuite() {
 	suite.DBSQLiteSuite.SetupSuite()
-	err := models.DB.AutoMigrate(&models.Article{}, &models.Newspaper{})
+	err := models.DB.AutoMigrate(&models.Article{}, &models.Newspaper{}, &models.Column{})
 	suite.Assert().Nil(err, "マイグレーションに失敗しました")
 	suite.originalDB = models.DB // テスト前のデータベースの状態を保存
 }
@@ -42,10 +43,10 @@ func (suite *ArticleTestSuite) AfterTest(suiteName, testName string) {
 }
 
 func (suite *ArticleTestSuite) TestArticle() {
-	createdNewspaper, err := models.CreateNewspaper("Test Newspaper", "Test Column")
+	createdNewspaper, err := models.CreateNewspaper(context.Background(), "Test Newspaper")
 	suite.Assert().Nil(err)
 
-	createdArticle, err := models.CreateArticle("Test", 2023, 10, 1, createdNewspaper.ID)
+	createdArticle, err := models.CreateArticle(context.Background(), "Test", 2023, 10, 1, createdNewspaper.ID, nil)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("Test", createdArticle.Body)
 	suite.Assert().Equal(2023, createdArticle.Year)
@@ -53,7 +54,7 @@ func (suite *ArticleTestSuite) TestArticle() {
 	suite.Assert().Equal(1, createdArticle.Day)
 	suite.Assert().Equal(1, createdArticle.NewspaperID)
 
-	getArticle, err := models.GetArticle(createdArticle.ID)
+	getArticle, err := models.GetArticle(context.Background(), createdArticle.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("Test", getArticle.Body)
 	suite.Assert().Equal(2023, getArticle.Year)
@@ -62,9 +63,9 @@ func (suite *ArticleTestSuite) TestArticle() {
 	suite.Assert().Equal(1, getArticle.NewspaperID)
 
 	getArticle.Body = "updated"
-	err = getArticle.Save()
+	err = getArticle.Save(context.Background())
 	suite.Assert().Nil(err)
-	updatedArticle, err := models.GetArticle(createdArticle.ID)
+	updatedArticle, err := models.GetArticle(context.Background(), createdArticle.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("updated", updatedArticle.Body)
 	suite.Assert().Equal(2023, updatedArticle.Year)
@@ -72,9 +73,9 @@ func (suite *ArticleTestSuite) TestArticle() {
 	suite.Assert().Equal(1, updatedArticle.Day)
 	suite.Assert().Equal(1, updatedArticle.NewspaperID)
 
-	err = updatedArticle.Delete()
+	err = updatedArticle.Delete(context.Background())
 	suite.Assert().Nil(err)
-	deletedArticle, err := models.GetArticle(updatedArticle.ID)
+	deletedArticle, err := models.GetArticle(context.Background(), updatedArticle.ID)
 	suite.Assert().Nil(deletedArticle)
 	suite.Assert().True(err != nil)
 	suite.Assert().True(strings.Contains("record not found", err.Error()))
@@ -96,7 +97,8 @@ func (suite *ArticleTestSuite) TestArticleMarshal() {
 	}
 	newspaperJSON, err := article.MarshalJSON()
 	suite.Assert().Nil(err)
-	suite.Assert().Equal(`{"body":"Test","day":1,"id":1,"month":10,"newspaperID":1,"year":2023}`, string(newspaperJSON))
+	suite.Assert().Equal(`{"body":"Test","day":1,"id":1,"month":10,"newspaperID":1,`+
+		`"stats":{"characters":0,"kanaRatio":0,"kanjiRatio":0,"paragraphs":0,"readingTimeSeconds":0,"sentences":0},"summary":"","year":2023}`, string(newspaperJSON))
 }
 
 func (suite *ArticleTestSuite) TestArticleCreateFailure() {
@@ -122,12 +124,12 @@ func (suite *ArticleTestSuite) TestArticleCreateFailure() {
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	mockDB.ExpectExec(regexp.QuoteMeta("INSERT INTO `articles`")).
-		WithArgs("Test", 2023, 10, 1, newspaper.ID).
+		WithArgs("Test", 2023, 10, 1, newspaper.ID, nil, 4, 1, 1, 0.0, 0.0, 1, "Test").
 		WillReturnError(errors.New("create error"))
 
 	mockDB.ExpectRollback()
 
-	article, err := models.CreateArticle("Test", 2023, 10, 1, newspaper.ID)
+	article, err := models.CreateArticle(context.Background(), "Test", 2023, 10, 1, newspaper.ID, nil)
 
 	suite.Assert().Nil(article)
 	suite.Assert().NotNil(err)
@@ -139,7 +141,7 @@ func (suite *ArticleTestSuite) TestArticleGetFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `articles` WHERE id = ? ORDER BY `articles`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnError(errors.New("get error"))
 
-	article, err := models.GetArticle(1)
+	article, err := models.GetArticle(context.Background(), 1)
 	suite.Assert().Nil(article)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -149,8 +151,9 @@ func (suite *ArticleTestSuite) TestArticleSaveFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin()
 	mockDB.ExpectExec(regexp.QuoteMeta(
-		"UPDATE `articles` SET `body`=?,`year`=?,`month`=?,`day`=?,`newspaper_id`=? WHERE `id` = ?",
-	)).WithArgs("updated", 2023, 10, 1, 1, 1).
+		"UPDATE `articles` SET `body`=?,`year`=?,`month`=?,`day`=?,`newspaper_id`=?,`column_id`=?,"+
+			"`character_count`=?,`sentence_count`=?,`paragraph_count`=?,`kanji_ratio`=?,`kana_ratio`=?,`reading_seconds`=?,`summary`=? WHERE `id` = ?",
+	)).WithArgs("updated", 2023, 10, 1, 1, nil, 7, 1, 1, 0.0, 0.0, 1, "updated", 1).
 		WillReturnError(errors.New("update error"))
 
 	mockDB.ExpectRollback()
@@ -166,7 +169,7 @@ func (suite *ArticleTestSuite) TestArticleSaveFailure() {
 
 	article.Body = "updated"
 
-	err := article.Save()
+	err := article.Save(context.Background())
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("update error", err.Error())
 }
@@ -190,7 +193,98 @@ func (suite *ArticleTestSuite) TestArticleDeleteFailure() {
 		NewspaperID: 1,
 	}
 
-	err := article.Delete()
+	err := article.Delete(context.Background())
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("delete error", err.Error())
 }
+
+func (suite *ArticleTestSuite) TestArticleReadingStats() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "Stats Newspaper")
+	short, err := models.CreateArticle(ctx, "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(6, short.CharacterCount)
+	suite.Assert().Equal(1, short.SentenceCount)
+	long, _ := models.CreateArticle(ctx, "春はあけぼの。▼夏は夜。▼秋は夕暮れ。", 2023, 4, 2, newspaper.ID, nil)
+	suite.Assert().Equal(3, long.ParagraphCount)
+
+	// 本文を更新すると統計も計算し直す
+	short.Body = "桜が咲いて、散った。"
+	suite.Assert().Nil(short.Save(ctx))
+	updated, _ := models.GetArticle(ctx, short.ID)
+	suite.Assert().Equal(10, updated.CharacterCount)
+
+	minCharacters, maxCharacters := 10, 15
+	articles, err := models.FindArticles(ctx, models.ArticleFilter{
+		NewspaperID:   &newspaper.ID,
+		MinCharacters: &minCharacters,
+		MaxCharacters: &maxCharacters,
+	}, models.OrderNewest, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 1)
+	suite.Assert().Equal(short.ID, articles[0].ID)
+
+	articles, _ = models.FindArticles(ctx, models.ArticleFilter{NewspaperID: &newspaper.ID}, models.OrderLongest, 10, 0, models.Fields{})
+	suite.Assert().Equal(long.ID, articles[0].ID)
+	articles, _ = models.FindArticles(ctx, models.ArticleFilter{NewspaperID: &newspaper.ID}, models.OrderShortest, 10, 0, models.Fields{})
+	suite.Assert().Equal(short.ID, articles[0].ID)
+
+	// 統計を保存する前の記事の統計を計算し直す
+	expected := long.ReadingStats
+	suite.Assert().Nil(models.DB.Model(&models.Article{ID: long.ID}).UpdateColumn("character_count", 0).Error)
+	_, err = models.RebuildReadingStats(ctx, 10)
+	suite.Assert().Nil(err)
+	rebuilt, _ := models.GetArticle(ctx, long.ID)
+	suite.Assert().Equal(expected, rebuilt.ReadingStats)
+}
+
+func (suite *ArticleTestSuite) TestArticleSummary() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "Summary Newspaper")
+	body := "政府は新しい経済対策を決めた。経済対策には子育て支援が含まれる。天気は晴れだった。政府は経済対策の予算を国会に出す。"
+	article, err := models.CreateArticle(ctx, body, 2023, 5, 1, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(models.SummarySentences, 3)
+	suite.Assert().Equal("政府は新しい経済対策を決めた。経済対策には子育て支援が含まれる。政府は経済対策の予算を国会に出す。", article.Summary)
+
+	// 本文を更新すると要約も作り直す
+	article.Body = "春が来た。"
+	suite.Assert().Nil(article.Save(ctx))
+	updated, _ := models.GetArticle(ctx, article.ID)
+	suite.Assert().Equal("春が来た。", updated.Summary)
+
+	// 要約を保存する前の記事の要約を作り直す
+	suite.Assert().Nil(models.DB.Model(&models.Article{ID: article.ID}).UpdateColumn("summary", "").Error)
+	_, err = models.RebuildSummaries(ctx, 10)
+	suite.Assert().Nil(err)
+	rebuilt, _ := models.GetArticle(ctx, article.ID)
+	suite.Assert().Equal("春が来た。", rebuilt.Summary)
+}
+
+func (suite *ArticleTestSuite) TestGetNewspapersArticles() {
+	ctx := context.Background()
+	first, _ := models.CreateNewspaper(ctx, "First Newspaper")
+	second, _ := models.CreateNewspaper(ctx, "Second Newspaper")
+	empty, _ := models.CreateNewspaper(ctx, "Empty Newspaper")
+	var firstArticles []*models.Article
+	for day := 1; day <= 3; day++ {
+		article, _ := models.CreateArticle(ctx, "春が来た。", 2023, 4, day, first.ID, nil)
+		firstArticles = append(firstArticles, article)
+	}
+	secondArticle, _ := models.CreateArticle(ctx, "夏が来た。", 2023, 7, 1, second.ID, nil)
+
+	// 新聞ごとに新しい順で2件目から2件
+	articles, err := models.GetNewspapersArticles(ctx, []int{first.ID, second.ID, empty.ID}, models.OrderNewest, 2, 1)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles[first.ID], 2)
+	suite.Assert().Equal(firstArticles[1].ID, articles[first.ID][0].ID)
+	suite.Assert().Equal(firstArticles[0].ID, articles[first.ID][1].ID)
+	suite.Assert().Empty(articles[second.ID])
+	suite.Assert().Empty(articles[empty.ID])
+
+	articles, err = models.GetNewspapersArticles(ctx, []int{first.ID, second.ID}, models.OrderOldest, 1, 0)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(firstArticles[0].ID, articles[first.ID][0].ID)
+	suite.Assert().Equal(secondArticle.ID, articles[second.ID][0].ID)
+	suite.Assert().Equal("夏が来た。", articles[second.ID][0].Body)
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api"
+)
+
+// 記事が参照するコラムが記事の新聞に属していない場合のエラー
+var ErrColumnNotInNewspaper = errors.New("column does not belong to the newspaper")
+
+// Column は新聞に掲載される連載コラム（例: 天声人語）を表す
+// Slugは新聞の中で一意な識別名で、URLなどで利用する
+type Column struct {
+	ID          int
+	NewspaperID int `gorm:"uniqueIndex:idx_columns_newspaper_slug"`
+	Name        string
+	Slug        string `gorm:"uniqueIndex:idx_columns_newspaper_slug;size:191"`
+	Description string
+	Schedule    string    // 掲載頻度（api.ColumnSchedule の値）
+	fields      selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var columnFields = fieldColumns{
+	"id":          nil,
+	"newspaperID": {"newspaper_id"},
+	"name":        {"name"},
+	"slug":        {"slug"},
+	"description": {"description"},
+	"schedule":    {"schedule"},
+}
+
+func (c *Column) MarshalJSON() ([]byte, error) {
+	return c.fields.marshal(&api.ColumnResponse{
+		Id:          c.ID,
+		NewspaperID: c.NewspaperID,
+		Name:        c.Name,
+		Slug:        c.Slug,
+		Description: c.Description,
+		Schedule:    api.ColumnSchedule(c.Schedule),
+	})
+}
+
+// scheduleが空の場合は毎日掲載のコラムとして作成する
+func CreateColumn(ctx context.Context, newspaperID int, name string, slug string, description string, schedule string) (*Column, error) {
+	if _, err := GetNewspaper(ctx, newspaperID); err != nil {
+		return nil, err
+	}
+	if schedule == "" {
+		schedule = string(api.Daily)
+	}
+
+	column := &Column{
+		NewspaperID: newspaperID,
+		Name:        name,
+		Slug:        slug,
+		Description: description,
+		Schedule:    schedule,
+	}
+	if err := conn(ctx).Create(column).Error; err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+func GetColumn(ctx context.Context, ID int) (*Column, error) {
+	return GetColumnWithFields(ctx, ID, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んでコラムを取得する（レスポンスを返すためだけに使い、保存しない）
+func GetColumnWithFields(ctx context.Context, ID int, fields Fields) (*Column, error) {
+	selected, err := columnFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	column := &Column{fields: selected}
+	if err := conn(ctx).Scopes(columnFields.scope("columns", selected)).First(column, ID).Error; err != nil {
+		return nil, err
+	}
+	return column, nil
+}
+
+// 新聞に属するコラムをID順に、指定したフィールドに必要な列だけを読み込んで取得する
+func GetNewspaperColumns(ctx context.Context, newspaperID int, fields Fields) ([]*Column, error) {
+	selected, err := columnFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	columns := []*Column{}
+	if err := conn(ctx).Scopes(columnFields.scope("columns", selected)).
+		Where("newspaper_id = ?", newspaperID).Order("id").Find(&columns).Error; err != nil {
+		return nil, err
+	}
+	for _, column := range columns {
+		column.fields = selected
+	}
+	return columns, nil
+}
+
+func (c *Column) Save(ctx context.Context) error {
+	if err := conn(ctx).Save(c).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// コラムの削除前に、記事からコラムへの参照を外す（記事自体は削除しない）
+// 記事のフックでリビジョンが作られないよう UpdateColumn を使う
+func (c *Column) BeforeDelete(tx *gorm.DB) error {
+	return tx.Model(&Article{}).Where("column_id = ?", c.ID).UpdateColumn("column_id", nil).Error
+}
+
+func (c *Column) Delete(ctx context.Context) error {
+	if err := conn(ctx).Where("id = ?", c.ID).Delete(c).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api"
+)
+
+// SplitNewspaperColumns は ColumnName を持つ既存の新聞の行をコラムに分割し、作成したコラムの数を返す
+// 同じ Title の行は最も小さいIDの行にまとめ、各行の記事はまとめた先の新聞と作成したコラムを参照するように付け替える
+// まとめる行が既に持つコラムもまとめた先の新聞に移す（同じスラッグのコラムがあればそのコラムにまとめる）
+// 分割済みの行は ColumnName を空にするため、繰り返し実行しても結果は変わらない
+func SplitNewspaperColumns(ctx context.Context) (int, error) {
+	created := 0
+	err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		newspapers := []*Newspaper{}
+		if err := tx.Order("id").Find(&newspapers).Error; err != nil {
+			return err
+		}
+
+		canonical := map[string]*Newspaper{} // Title ごとにまとめ先となる新聞
+		for _, newspaper := range newspapers {
+			target, ok := canonical[newspaper.Title]
+			if !ok {
+				canonical[newspaper.Title] = newspaper
+				target = newspaper
+			}
+
+			if newspaper.ColumnName != "" {
+				column, isNew, err := findOrCreateColumn(tx, target.ID, newspaper.ColumnName)
+				if err != nil {
+					return err
+				}
+				if isNew {
+					created++
+				}
+				if err := tx.Model(&Article{}).Where("newspaper_id = ? AND column_id IS NULL", newspaper.ID).
+					UpdateColumn("column_id", column.ID).Error; err != nil {
+					return err
+				}
+			}
+
+			if newspaper.ID == target.ID {
+				if err := tx.Model(newspaper).Update("column_name", "").Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := moveColumns(tx, newspaper.ID, target.ID); err != nil {
+				return err
+			}
+			if err := tx.Model(&Article{}).Where("newspaper_id = ?", newspaper.ID).
+				UpdateColumn("newspaper_id", target.ID).Error; err != nil {
+				return err
+			}
+			if err := tx.Delete(newspaper).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return created, nil
+}
+
+// 新聞 from のコラムを新聞 to に移す
+// to に同じスラッグのコラムがあればそのコラムにまとめ、記事の参照を付け替えてから元のコラムを削除する
+func moveColumns(tx *gorm.DB, from int, to int) error {
+	columns := []*Column{}
+	if err := tx.Where("newspaper_id = ?", from).Order("id").Find(&columns).Error; err != nil {
+		return err
+	}
+	for _, column := range columns {
+		existing := &Column{}
+		err := tx.Where("newspaper_id = ? AND slug = ?", to, column.Slug).First(existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			if err := tx.Model(column).UpdateColumn("newspaper_id", to).Error; err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&Article{}).Where("column_id = ?", column.ID).UpdateColumn("column_id", existing.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(column).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 新聞に同じ名前のコラムがあればそれを返し、なければ名前から作ったスラッグで作成する
+func findOrCreateColumn(tx *gorm.DB, newspaperID int, name string) (*Column, bool, error) {
+	column := &Column{}
+	err := tx.Where("newspaper_id = ? AND name = ?", newspaperID, name).First(column).Error
+	if err == nil {
+		return column, false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, false, err
+	}
+
+	slug, err := uniqueSlug(tx, newspaperID, slugify(name))
+	if err != nil {
+		return nil, false, err
+	}
+	column = &Column{
+		NewspaperID: newspaperID,
+		Name:        name,
+		Slug:        slug,
+		Schedule:    string(api.Daily),
+	}
+	if err := tx.Create(column).Error; err != nil {
+		return nil, false, err
+	}
+	return column, true, nil
+}
+
+// 新聞の中で使われていないスラッグを返す（重複する場合は末尾に連番を付ける）
+func uniqueSlug(tx *gorm.DB, newspaperID int, base string) (string, error) {
+	slug := base
+	for i := 2; ; i++ {
+		var count int64
+		if err := tx.Model(&Column{}).Where("newspaper_id = ? AND slug = ?", newspaperID, slug).Count(&count).Error; err != nil {
+			return "", err
+		}
+		if count == 0 {
+			return slug, nil
+		}
+		slug = fmt.Sprintf("%s-%d", base, i)
+	}
+}
+
+// コラム名から英小文字・数字・ハイフンだけのスラッグを作る
+// 日本語のみの名前などで何も残らない場合は "column" とする
+func slugify(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		hyphen = true
+	}
+	if b.Len() == 0 {
+		return "column"
+	}
+	return b.String()
+}
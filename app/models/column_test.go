@@ -0,0 +1,178 @@
+package models_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ColumnTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestColumnTestSuite(t *testing.T) {
+	suite.Run(t, new(ColumnTestSuite))
+}
+
+func (suite *ColumnTestSuite) TestColumn() {
+	newspaper, err := models.CreateNewspaper(context.Background(), "朝日新聞")
+	suite.Assert().Nil(err)
+
+	column, err := models.CreateColumn(context.Background(), newspaper.ID, "天声人語", "tensei-jingo", "朝刊1面のコラム", "")
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("daily", column.Schedule)
+
+	column.Schedule = "weekly"
+	suite.Assert().Nil(column.Save(context.Background()))
+	columns, err := models.GetNewspaperColumns(context.Background(), newspaper.ID, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(columns, 1)
+	suite.Assert().Equal("weekly", columns[0].Schedule)
+
+	columnJSON, err := column.MarshalJSON()
+	suite.Assert().Nil(err)
+	suite.Assert().JSONEq(fmt.Sprintf(
+		`{"id":%d,"newspaperID":%d,"name":"天声人語","slug":"tensei-jingo","description":"朝刊1面のコラム","schedule":"weekly"}`,
+		column.ID, newspaper.ID), string(columnJSON))
+
+	// 同じ新聞の中でスラッグは重複できない
+	_, err = models.CreateColumn(context.Background(), newspaper.ID, "別のコラム", "tensei-jingo", "", "")
+	suite.Assert().NotNil(err)
+}
+
+func (suite *ColumnTestSuite) TestArticleColumn() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "読売新聞")
+	other, _ := models.CreateNewspaper(context.Background(), "毎日新聞")
+	column, _ := models.CreateColumn(context.Background(), newspaper.ID, "編集手帳", "henshu-techo", "", "")
+
+	article, err := models.CreateArticle(context.Background(), "本文", 2023, 10, 1, newspaper.ID, &column.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(column.ID, *article.ColumnID)
+
+	// 別の新聞のコラムは参照できない
+	_, err = models.CreateArticle(context.Background(), "本文", 2023, 10, 1, other.ID, &column.ID)
+	suite.Assert().ErrorIs(err, models.ErrColumnNotInNewspaper)
+
+	// コラムを削除しても記事は残り、コラムとの関連だけが外れる
+	suite.Assert().Nil(column.Delete(context.Background()))
+	deletedColumnArticle, err := models.GetArticle(context.Background(), article.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(deletedColumnArticle.ColumnID)
+	revisions, _ := models.GetArticleRevisions(context.Background(), article.ID, models.Fields{})
+	suite.Assert().Len(revisions, 1)
+}
+
+type ColumnMigrationTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestColumnMigrationTestSuite(t *testing.T) {
+	suite.Run(t, new(ColumnMigrationTestSuite))
+}
+
+// 移行前の ColumnName を持つ新聞の行を作る（ColumnName は書き込めないため直接作成する）
+func (suite *ColumnMigrationTestSuite) createLegacyNewspaper(title string, columnName string) *models.Newspaper {
+	newspaper := &models.Newspaper{Title: title, ColumnName: columnName}
+	suite.Require().Nil(models.DB.Create(newspaper).Error)
+	return newspaper
+}
+
+func (suite *ColumnMigrationTestSuite) TestSplitNewspaperColumns() {
+	ctx := context.Background()
+	asahi1 := suite.createLegacyNewspaper("朝日新聞", "天声人語")
+	asahi2 := suite.createLegacyNewspaper("朝日新聞", "Kaze Kaoru")
+	asahi3 := suite.createLegacyNewspaper("朝日新聞", "天声人語")
+	yomiuri := suite.createLegacyNewspaper("読売新聞", "編集手帳")
+	article1, _ := models.CreateArticle(ctx, "記事1", 2023, 10, 1, asahi1.ID, nil)
+	article2, _ := models.CreateArticle(ctx, "記事2", 2023, 10, 2, asahi2.ID, nil)
+	article3, _ := models.CreateArticle(ctx, "記事3", 2023, 10, 3, asahi3.ID, nil)
+	article4, _ := models.CreateArticle(ctx, "記事4", 2023, 10, 4, yomiuri.ID, nil)
+
+	created, err := models.SplitNewspaperColumns(ctx)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(3, created)
+
+	// 同じ Title の行は最も小さいIDの行にまとめられる
+	_, err = models.GetNewspaper(ctx, asahi2.ID)
+	suite.Assert().NotNil(err)
+	merged, err := models.GetNewspaper(ctx, asahi1.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("", merged.ColumnName)
+
+	columns, err := models.GetNewspaperColumns(ctx, asahi1.ID, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(columns, 2)
+	suite.Assert().Equal("天声人語", columns[0].Name)
+	suite.Assert().Equal("column", columns[0].Slug)
+	suite.Assert().Equal("kaze-kaoru", columns[1].Slug)
+
+	for _, expected := range []struct {
+		articleID   int
+		newspaperID int
+		columnName  string
+	}{
+		{article1.ID, asahi1.ID, "天声人語"},
+		{article2.ID, asahi1.ID, "Kaze Kaoru"},
+		{article3.ID, asahi1.ID, "天声人語"},
+		{article4.ID, yomiuri.ID, "編集手帳"},
+	} {
+		article, err := models.GetArticle(ctx, expected.articleID)
+		suite.Assert().Nil(err)
+		suite.Assert().Equal(expected.newspaperID, article.NewspaperID)
+		column, err := models.GetColumn(ctx, *article.ColumnID)
+		suite.Assert().Nil(err)
+		suite.Assert().Equal(expected.columnName, column.Name)
+	}
+
+	// 記事の付け替えではリビジョンは作られない
+	revisions, _ := models.GetArticleRevisions(ctx, article2.ID, models.Fields{})
+	suite.Assert().Len(revisions, 1)
+
+	// 繰り返し実行しても結果は変わらない
+	created, err = models.SplitNewspaperColumns(ctx)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(0, created)
+}
+
+func (suite *ColumnMigrationTestSuite) TestSplitNewspaperColumnsMovesColumns() {
+	ctx := context.Background()
+	chunichi1, _ := models.CreateNewspaper(ctx, "中日新聞")
+	chunichi2, _ := models.CreateNewspaper(ctx, "中日新聞")
+	kept, _ := models.CreateColumn(ctx, chunichi1.ID, "中日春秋", "shunju", "", "")
+	duplicate, _ := models.CreateColumn(ctx, chunichi2.ID, "中日春秋", "shunju", "", "")
+	moved, _ := models.CreateColumn(ctx, chunichi2.ID, "くらしの作文", "sakubun", "", "")
+	article1, _ := models.CreateArticle(ctx, "記事1", 2023, 11, 1, chunichi2.ID, &duplicate.ID)
+	article2, _ := models.CreateArticle(ctx, "記事2", 2023, 11, 2, chunichi2.ID, &moved.ID)
+
+	_, err := models.SplitNewspaperColumns(ctx)
+	suite.Assert().Nil(err)
+
+	// まとめる行のコラムは、同じスラッグのコラムにまとめるか、まとめた先の新聞に移す
+	columns, err := models.GetNewspaperColumns(ctx, chunichi1.ID, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(columns, 2)
+	_, err = models.GetColumn(ctx, duplicate.ID)
+	suite.Assert().NotNil(err)
+
+	for _, expected := range []struct {
+		articleID int
+		columnID  int
+	}{
+		{article1.ID, kept.ID},
+		{article2.ID, moved.ID},
+	} {
+		article, err := models.GetArticle(ctx, expected.articleID)
+		suite.Assert().Nil(err)
+		suite.Assert().Equal(chunichi1.ID, article.NewspaperID)
+		suite.Assert().Equal(expected.columnID, *article.ColumnID)
+
+		// コラムが記事の新聞に属しているため、そのまま保存できる
+		article.Body += "。"
+		suite.Assert().Nil(article.Save(ctx))
+	}
+}
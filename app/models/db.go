@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -23,7 +24,51 @@ var (
 
 // 初期化するモデル（テーブル）をリストで返す関数
 func GetModels() []interface{} {
-	return []interface{}{&Newspaper{}}
+	return []interface{}{&Newspaper{}, &Column{}, &Article{}, &ArticleRevision{}, &Tag{}, &ArticleTag{}, &ArticleTerm{}, &ArticleKeyword{}, &ArticleAnnotation{}, &PracticeSession{}, &UserActivity{}, &VocabularyEntry{}, &OutboxEvent{}, &WebhookSubscription{}, &WebhookDelivery{}}
+}
+
+// リクエストのコンテキストを引き継いだデータベースのインスタンスを返す関数
+// GORMのプラグイン（トレーシングなど）はこのコンテキストからリクエストの情報を取り出す
+// コンテキストが Transaction の中のものであれば、そのトランザクションを返す
+func conn(ctx context.Context) *gorm.DB {
+	if t, ok := ctx.Value(transactionKey{}).(*transaction); ok {
+		return t.tx.WithContext(ctx)
+	}
+	return DB.WithContext(ctx)
+}
+
+type transactionKey struct{}
+
+// コンテキストに保持する実行中のトランザクション
+type transaction struct {
+	tx          *gorm.DB
+	afterCommit []func() // 確定した後に実行する処理（インメモリのインデックスの更新など）
+}
+
+// fn に渡すコンテキストを使うモデルの関数を、全て1つのトランザクションで実行する
+// fn がエラーを返すと全ての変更を取り消す（トランザクションの中で呼ぶとセーブポイントになる）
+func Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	t := &transaction{}
+	if err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		t.tx = tx
+		return fn(context.WithValue(ctx, transactionKey{}, t))
+	}); err != nil {
+		return err
+	}
+	for _, hook := range t.afterCommit {
+		afterCommit(ctx, hook) // 外側のトランザクションがあれば、その確定まで待つ
+	}
+	return nil
+}
+
+// データベースの変更が確定した後に fn を実行する（トランザクションの外ではすぐに実行する）
+// 取り消された変更をインメモリのデータに反映しないために使う
+func afterCommit(ctx context.Context, fn func()) {
+	if t, ok := ctx.Value(transactionKey{}).(*transaction); ok {
+		t.afterCommit = append(t.afterCommit, fn)
+		return
+	}
+	fn()
 }
 
 // データベースのインスタンスを生成するファクトリ関数
@@ -0,0 +1,116 @@
+package models_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/similarity"
+	"go-api-newspaper/pkg/tester"
+)
+
+type TransactionTestSuite struct {
+	tester.DBSQLiteSuite
+	originalIndex *similarity.Index
+}
+
+func TestTransactionTestSuite(t *testing.T) {
+	suite.Run(t, new(TransactionTestSuite))
+}
+
+// 他のスイートの記事とIDが重ならないよう、関連記事のインデックスをテストごとに空にする
+func (suite *TransactionTestSuite) BeforeTest(suiteName, testName string) {
+	suite.originalIndex = models.RelatedIndex
+	models.RelatedIndex = similarity.NewIndex()
+}
+
+func (suite *TransactionTestSuite) AfterTest(suiteName, testName string) {
+	models.RelatedIndex = suite.originalIndex
+}
+
+func (suite *TransactionTestSuite) TestCommit() {
+	ctx := context.Background()
+	var newspaper *models.Newspaper
+	var article *models.Article
+
+	err := models.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if newspaper, err = models.CreateNewspaper(ctx, "Commit"); err != nil {
+			return err
+		}
+		if article, err = models.CreateArticle(ctx, "Transactions commit every change together.", 2024, 1, 1, newspaper.ID, nil); err != nil {
+			return err
+		}
+		// 確定するまでは関連記事のインデックスに追加しない
+		suite.Assert().Equal(0, models.RelatedIndex.Len())
+		return nil
+	})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, models.RelatedIndex.Len())
+
+	_, err = models.GetNewspaper(ctx, newspaper.ID)
+	suite.Assert().Nil(err)
+	_, err = models.GetArticle(ctx, article.ID)
+	suite.Assert().Nil(err)
+}
+
+func (suite *TransactionTestSuite) TestRollback() {
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+	var newspaper *models.Newspaper
+	var article *models.Article
+
+	err := models.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if newspaper, err = models.CreateNewspaper(ctx, "Rollback"); err != nil {
+			return err
+		}
+		if article, err = models.CreateArticle(ctx, "Transactions roll back every change together.", 2024, 1, 2, newspaper.ID, nil); err != nil {
+			return err
+		}
+		return errFailed
+	})
+	suite.Assert().ErrorIs(err, errFailed)
+	suite.Assert().Equal(0, models.RelatedIndex.Len())
+
+	_, err = models.GetNewspaper(ctx, newspaper.ID)
+	suite.Assert().NotNil(err)
+	_, err = models.GetArticle(ctx, article.ID)
+	suite.Assert().NotNil(err)
+	events, _, err := models.GetEventsAfter(ctx, 0, 1000)
+	suite.Assert().Nil(err)
+	for _, event := range events {
+		suite.Assert().NotContains(string(event.Payload), "Rollback")
+	}
+}
+
+func (suite *TransactionTestSuite) TestNestedRollback() {
+	ctx := context.Background()
+	errFailed := errors.New("failed")
+	var outer, inner *models.Newspaper
+
+	err := models.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if outer, err = models.CreateNewspaper(ctx, "Outer"); err != nil {
+			return err
+		}
+		// 内側のトランザクションはセーブポイントになり、取り消しても外側の変更は残る
+		err = models.Transaction(ctx, func(ctx context.Context) error {
+			if inner, err = models.CreateNewspaper(ctx, "Inner"); err != nil {
+				return err
+			}
+			return errFailed
+		})
+		suite.Assert().ErrorIs(err, errFailed)
+		return nil
+	})
+	suite.Assert().Nil(err)
+
+	_, err = models.GetNewspaper(ctx, outer.ID)
+	suite.Assert().Nil(err)
+	_, err = models.GetNewspaper(ctx, inner.ID)
+	suite.Assert().NotNil(err)
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+
+	"go-api-newspaper/configs"
+	"go-api-newspaper/pkg/outbox"
+)
+
+// EventBroker はアウトボックスのイベントをイベントのストリーム（SSE）の接続に配る
+// ディスパッチャーの配信先に加えると、アウトボックスから届けたイベントが接続中のクライアントに届く
+var EventBroker = outbox.NewBroker(64)
+
+// EventReplayLimit は再接続したクライアントに送り直すイベントの上限（EVENT_REPLAY_LIMIT で変更できる）
+var EventReplayLimit = configs.Config.EventReplayLimit
+
+// 届けた順の番号が afterSequence より後のイベントを届けた順に取得する（まだ届けていないイベントは含めない）
+// IDはトランザクションが確定する順に増えるとは限らないため、IDではなく届けた順の番号で続きを読む
+// 最大limit件の新しいイベントだけを返し、それより前のイベントを省いた場合は truncated を true にする
+func GetEventsAfter(ctx context.Context, afterSequence int, limit int) (events []outbox.Event, truncated bool, err error) {
+	records := []*OutboxEvent{}
+	if err := conn(ctx).Where("sequence > ?", afterSequence).Order("sequence DESC").Limit(limit + 1).Find(&records).Error; err != nil {
+		return nil, false, err
+	}
+	if len(records) > limit {
+		records, truncated = records[:limit], true
+	}
+	events = make([]outbox.Event, len(records))
+	for i, record := range records {
+		events[len(records)-1-i] = record.event()
+	}
+	return events, truncated, nil
+}
+
+// EventNewspaperID はイベントの対象の新聞のIDを返す（記事のイベントでは記事が属する新聞）
+func EventNewspaperID(event outbox.Event) (int, bool) {
+	var payload struct {
+		ID          *int `json:"id"`
+		NewspaperID *int `json:"newspaperID"`
+	}
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return 0, false
+	}
+	switch EventType(event.Type) {
+	case EventNewspaperCreated, EventNewspaperUpdated, EventNewspaperDeleted:
+		if payload.ID != nil {
+			return *payload.ID, true
+		}
+	case EventArticleCreated, EventArticleUpdated, EventArticleDeleted:
+		if payload.NewspaperID != nil {
+			return *payload.NewspaperID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,115 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/tester"
+)
+
+type EventStreamTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestEventStreamTestSuite(t *testing.T) {
+	suite.Run(t, new(EventStreamTestSuite))
+}
+
+// アウトボックスのイベントに届けた順の番号を付ける
+func (suite *EventStreamTestSuite) dispatch(ctx context.Context) {
+	_, err := outbox.NewDispatcher(models.OutboxStore{}, 1000).DispatchOnce(ctx)
+	suite.Require().Nil(err)
+}
+
+func (suite *EventStreamTestSuite) TestGetEventsAfter() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "産経新聞")
+	suite.dispatch(ctx)
+	events, _, err := models.GetEventsAfter(ctx, 0, 1000)
+	suite.Assert().Nil(err)
+	lastID := events[len(events)-1].Sequence
+
+	article, _ := models.CreateArticle(ctx, "初雪が降った。", 2023, 12, 10, newspaper.ID, nil)
+	article.Body = "初雪が積もった。"
+	article.Save(ctx)
+	article.Delete(ctx)
+
+	// まだ届けていないイベントは返さない
+	events, _, err = models.GetEventsAfter(ctx, lastID, 10)
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(events)
+	suite.dispatch(ctx)
+
+	events, truncated, err := models.GetEventsAfter(ctx, lastID, 10)
+	suite.Assert().Nil(err)
+	suite.Assert().False(truncated)
+	suite.Assert().Len(events, 3)
+	suite.Assert().Equal("article.created", events[0].Type)
+	suite.Assert().Equal("article.deleted", events[2].Type)
+
+	// 上限を超える場合は新しいイベントだけを古い順に返す
+	events, truncated, err = models.GetEventsAfter(ctx, lastID, 2)
+	suite.Assert().Nil(err)
+	suite.Assert().True(truncated)
+	suite.Assert().Len(events, 2)
+	suite.Assert().Equal("article.updated", events[0].Type)
+	suite.Assert().Equal("article.deleted", events[1].Type)
+}
+
+func (suite *EventStreamTestSuite) TestEventNewspaperID() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "中日新聞")
+	suite.dispatch(ctx)
+	events, _, _ := models.GetEventsAfter(ctx, 0, 1000)
+	lastID := events[len(events)-1].Sequence
+
+	article, _ := models.CreateArticle(ctx, "冬至を迎えた。", 2023, 12, 22, newspaper.ID, nil)
+	(&models.Article{ID: article.ID}).Delete(ctx) // IDだけを指定して削除しても新聞のIDが分かる
+	newspaper.Delete(ctx)
+	suite.dispatch(ctx)
+
+	events, _, _ = models.GetEventsAfter(ctx, lastID, 10)
+	suite.Assert().Len(events, 3)
+	for _, event := range events {
+		newspaperID, ok := models.EventNewspaperID(event)
+		suite.Assert().True(ok, event.Type)
+		suite.Assert().Equal(newspaper.ID, newspaperID, event.Type)
+	}
+}
+
+func (suite *EventStreamTestSuite) TestSequenceInCommitOrder() {
+	ctx := context.Background()
+	suite.dispatch(ctx)
+	events, _, _ := models.GetEventsAfter(ctx, 0, 1000)
+	lastSequence := 0
+	if len(events) > 0 {
+		lastSequence = events[len(events)-1].Sequence
+	}
+
+	models.CreateNewspaper(ctx, "西日本新聞")
+	models.CreateNewspaper(ctx, "河北新報")
+	pending, _ := models.OutboxStore{}.Pending(ctx, 100)
+	suite.Require().Len(pending, 2)
+
+	// 後に書いたイベントが先に確定して届いた場合、先に書いたイベントは後の番号になり、続きから読んでも失われない
+	second, err := models.OutboxStore{}.Sequence(ctx, pending[1].ID)
+	suite.Assert().Nil(err)
+	first, err := models.OutboxStore{}.Sequence(ctx, pending[0].ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(lastSequence+1, second)
+	suite.Assert().Equal(lastSequence+2, first)
+	again, _ := models.OutboxStore{}.Sequence(ctx, pending[0].ID) // 付けた番号は変わらない
+	suite.Assert().Equal(first, again)
+
+	events, _, _ = models.GetEventsAfter(ctx, second, 10)
+	suite.Assert().Len(events, 1)
+	suite.Assert().Equal(pending[0].ID, events[0].ID)
+
+	// 番号を付けたイベントを先に届ける
+	pending, _ = models.OutboxStore{}.Pending(ctx, 100)
+	suite.Assert().Equal([]int{second, first}, []int{pending[0].Sequence, pending[1].Sequence})
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Title は記事の見出し（新聞と発行日）を返す（新聞を読み込んでいない場合は発行日だけ）
+func (a *Article) Title() string {
+	title := fmt.Sprintf("%d年%d月%d日", a.Year, a.Month, a.Day)
+	if a.Newspaper != nil {
+		title = a.Newspaper.Title + " " + title
+	}
+	return title
+}
+
+// PublishedAt は記事の発行日の日本時間の0時を返す
+func (a *Article) PublishedAt() time.Time {
+	return time.Date(a.Year, time.Month(a.Month), a.Day, 0, 0, 0, 0, ActivityLocation)
+}
+
+// GetLatestArticles は発行日の新しい順に記事を新聞とともに取得する（フィード用）
+// newspaperIDがnilの場合は全ての新聞の記事を取得する
+func GetLatestArticles(ctx context.Context, newspaperID *int, limit int) ([]*Article, error) {
+	articles := []*Article{}
+	if err := conn(ctx).Preload("Newspaper").
+		Scopes(ArticleFilter{NewspaperID: newspaperID}.Scope).
+		Order(OrderNewest.orderBy()).
+		Limit(limit).
+		Find(&articles).Error; err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
@@ -0,0 +1,42 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type FeedTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedTestSuite))
+}
+
+func (suite *FeedTestSuite) TestGetLatestArticles() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	other, _ := models.CreateNewspaper(ctx, "毎日新聞")
+	first, _ := models.CreateArticle(ctx, "一つ目。", 2023, 4, 1, newspaper.ID, nil)
+	second, _ := models.CreateArticle(ctx, "二つ目。", 2023, 4, 2, newspaper.ID, nil)
+	third, _ := models.CreateArticle(ctx, "三つ目。", 2023, 4, 3, other.ID, nil)
+
+	articles, err := models.GetLatestArticles(ctx, &newspaper.ID, 10)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 2)
+	suite.Assert().Equal(second.ID, articles[0].ID)
+	suite.Assert().Equal(first.ID, articles[1].ID)
+	suite.Assert().Equal("朝日新聞 2023年4月2日", articles[0].Title())
+	suite.Assert().Equal(jst(2023, 4, 2, 0), articles[0].PublishedAt())
+
+	// 新聞を指定しない場合は全ての新聞の記事を件数の上限まで取得する
+	articles, err = models.GetLatestArticles(ctx, nil, 1)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 1)
+	suite.Assert().Equal(third.ID, articles[0].ID)
+}
@@ -0,0 +1,110 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// レスポンスにないフィールドを指定した場合のエラー
+var ErrUnknownField = errors.New("unknown field")
+
+// Fields はレスポンスに含めるフィールドの指定（fields・exclude クエリパラメータ）
+// どちらも空の場合は全てのフィールドを含める
+type Fields struct {
+	Include []string // 含めるフィールド（空の場合は全てのフィールド）
+	Exclude []string // 除くフィールド
+}
+
+// With は name のフィールドを、他の指定に関わらず含めるようにした指定を返す
+func (f Fields) With(name string) Fields {
+	with := Fields{}
+	if len(f.Include) > 0 {
+		with.Include = append(append([]string{}, f.Include...), name)
+	}
+	for _, excluded := range f.Exclude {
+		if excluded != name {
+			with.Exclude = append(with.Exclude, excluded)
+		}
+	}
+	return with
+}
+
+// fieldColumns はリソースのレスポンスのフィールドごとに、そのフィールドを作るのに必要な列
+// 列が空のフィールドは関連（タグなど）から作る
+type fieldColumns map[string][]string
+
+// selection は選ばれたフィールド（nilの場合は全てのフィールド）
+type selection map[string]bool
+
+// 指定されたフィールドがリソースのレスポンスにあることを確かめ、選ばれたフィールドを返す
+func (c fieldColumns) selection(fields Fields) (selection, error) {
+	if len(fields.Include) == 0 && len(fields.Exclude) == 0 {
+		return nil, nil
+	}
+	for _, name := range append(append([]string{}, fields.Include...), fields.Exclude...) {
+		if _, ok := c[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, name)
+		}
+	}
+	selected := selection{}
+	if len(fields.Include) == 0 {
+		for name := range c {
+			selected[name] = true
+		}
+	}
+	for _, name := range fields.Include {
+		selected[name] = true
+	}
+	for _, name := range fields.Exclude {
+		delete(selected, name)
+	}
+	return selected, nil
+}
+
+// 選ばれたフィールドに必要な列だけを読み込むスコープを返す
+// 主キーは関連の読み込みや更新に使うため常に読み込む
+func (c fieldColumns) scope(table string, selected selection) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if selected == nil {
+			return db
+		}
+		seen := map[string]bool{"id": true}
+		columns := []string{table + ".id"}
+		for name := range selected {
+			for _, column := range c[name] {
+				if !seen[column] {
+					seen[column] = true
+					columns = append(columns, table+"."+column)
+				}
+			}
+		}
+		sort.Strings(columns[1:])
+		return db.Select(columns)
+	}
+}
+
+func (s selection) has(name string) bool {
+	return s == nil || s[name]
+}
+
+// レスポンスをJSONに変換し、選ばれていないフィールドを除く
+func (s selection) marshal(response interface{}) ([]byte, error) {
+	data, err := json.Marshal(response)
+	if err != nil || s == nil {
+		return data, err
+	}
+	object := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+	for name := range object {
+		if !s[name] {
+			delete(object, name)
+		}
+	}
+	return json.Marshal(object)
+}
@@ -0,0 +1,71 @@
+package models_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type FieldsTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestFieldsTestSuite(t *testing.T) {
+	suite.Run(t, new(FieldsTestSuite))
+}
+
+func (suite *FieldsTestSuite) TestInclude() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "フィールド新聞")
+	article, _ := models.CreateArticle(context.Background(), "本文。", 2023, 5, 1, newspaper.ID, nil)
+	article.AddTag(context.Background(), "フィールド")
+
+	got, err := models.GetArticleWithFields(context.Background(), article.ID, models.Fields{Include: []string{"id", "year", "tags"}})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("", got.Body) // 選ばれていない列は読み込まない
+	suite.Assert().Len(got.Tags, 1)
+
+	data, _ := json.Marshal(got)
+	var response map[string]interface{}
+	json.Unmarshal(data, &response)
+	suite.Assert().ElementsMatch([]string{"id", "year", "tags"}, keys(response))
+}
+
+func (suite *FieldsTestSuite) TestExclude() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "除外新聞")
+	models.CreateArticle(context.Background(), "本文。", 2023, 5, 2, newspaper.ID, nil)
+
+	newspaperID := newspaper.ID
+	articles, err := models.FindArticles(context.Background(), models.ArticleFilter{NewspaperID: &newspaperID}, models.OrderNewest, 10, 0, models.Fields{Exclude: []string{"body", "annotation"}})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 1)
+
+	data, _ := json.Marshal(articles[0])
+	var response map[string]interface{}
+	json.Unmarshal(data, &response)
+	suite.Assert().NotContains(response, "body")
+	suite.Assert().Contains(response, "year")
+	suite.Assert().Contains(response, "stats")
+}
+
+func (suite *FieldsTestSuite) TestUnknownField() {
+	newspaper, _ := models.CreateNewspaper(context.Background(), "不明新聞")
+	_, err := models.GetNewspaperWithFields(context.Background(), newspaper.ID, models.Fields{Include: []string{"title", "unknown"}})
+	suite.Assert().True(errors.Is(err, models.ErrUnknownField))
+
+	_, err = models.GetWebhookSubscriptions(context.Background(), models.Fields{Exclude: []string{"body"}})
+	suite.Assert().True(errors.Is(err, models.ErrUnknownField))
+}
+
+func keys(object map[string]interface{}) []string {
+	names := []string{}
+	for name := range object {
+		names = append(names, name)
+	}
+	return names
+}
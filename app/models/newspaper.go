@@ -1,7 +1,9 @@
 package models
 
 import (
-	"encoding/json"
+	"context"
+
+	"gorm.io/gorm"
 
 	"go-api-newspaper/api"
 )
@@ -9,47 +11,102 @@ import (
 type Newspaper struct {
 	ID          int
 	Title       string
-	ColumnName  string
+	ColumnName  string    // 非推奨: 移行前の行が持つコラム名（SplitNewspaperColumns でコラムに分割する）。読み込むだけで書き込まない
+	fields      selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var newspaperFields = fieldColumns{
+	"id":         nil,
+	"title":      {"title"},
+	"columnName": {"column_name"},
 }
 
 // 構造体をjsonに変換する
 func (a *Newspaper) MarshalJSON() ([]byte, error) {
-	return json.Marshal(&api.NewspaperResponse{ // api.NewspaperResponse という別の構造体にデータを詰め替えている
+	return a.fields.marshal(&api.NewspaperResponse{ // api.NewspaperResponse という別の構造体にデータを詰め替えている
 		Id:          a.ID,
 		Title:       a.Title,
 		ColumnName:  a.ColumnName,
 	})
 }
 
-func CreateNewspaper(title string, columnName string) (*Newspaper, error) {
+// コラムは Column リソースで管理するため、コラム名は指定できない
+func CreateNewspaper(ctx context.Context, title string) (*Newspaper, error) {
 	newspaper := &Newspaper{
 		Title:       title,
-		ColumnName:  columnName,
 	}
-	if err := DB.Create(newspaper).Error; err != nil {
+	// 記事の作成時にも新聞が関連として保存されるため、イベントはフックではなくここで書く
+	if err := conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(newspaper).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, EventNewspaperCreated, newspaper)
+	}); err != nil {
 		return nil, err
 	}
 	return newspaper, nil
 }
 
-func GetNewspaper(ID int) (*Newspaper, error) {
-	var newspaper = Newspaper{}
-	if err := DB.First(&newspaper, ID).Error; err != nil {
+func GetNewspaper(ctx context.Context, ID int) (*Newspaper, error) {
+	return GetNewspaperWithFields(ctx, ID, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んで新聞を取得する（レスポンスを返すためだけに使い、保存しない）
+func GetNewspaperWithFields(ctx context.Context, ID int, fields Fields) (*Newspaper, error) {
+	selected, err := newspaperFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	var newspaper = Newspaper{fields: selected}
+	if err := conn(ctx).Scopes(newspaperFields.scope("newspapers", selected)).First(&newspaper, ID).Error; err != nil {
 		return nil, err
 	}
 	return &newspaper, nil
 }
 
-func (a *Newspaper) Save() error {
-	if err := DB.Save(&a).Error; err != nil {
-		return err
+// 新聞を作成した順に取得する
+func GetNewspapers(ctx context.Context, limit int, offset int) ([]*Newspaper, error) {
+	newspapers := []*Newspaper{}
+	if err := conn(ctx).Order("id").Limit(limit).Offset(offset).Find(&newspapers).Error; err != nil {
+		return nil, err
 	}
-	return nil
+	return newspapers, nil
 }
 
-func (a *Newspaper) Delete() error {
-	if err := DB.Where("id = ?", &a.ID).Delete(&a).Error; err != nil {
-		return err
+// 複数の新聞をIDでまとめて取得する（見つからない新聞は含まない）
+func GetNewspapersByIDs(ctx context.Context, IDs []int) (map[int]*Newspaper, error) {
+	newspapers := []*Newspaper{}
+	if err := conn(ctx).Where("id IN ?", IDs).Find(&newspapers).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Newspaper, len(newspapers))
+	for _, newspaper := range newspapers {
+		byID[newspaper.ID] = newspaper
 	}
-	return nil
+	return byID, nil
+}
+
+func (a *Newspaper) Save(ctx context.Context) error {
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&a).Error; err != nil {
+			return err
+		}
+		return recordEvent(tx, EventNewspaperUpdated, a)
+	})
+}
+
+// 新聞を削除し、削除をイベントとしてアウトボックスに書く
+// 新聞が存在しない場合は gorm.ErrRecordNotFound を返し、イベントも書かない
+func (a *Newspaper) Delete(ctx context.Context) error {
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id = ?", &a.ID).Delete(&a)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != 1 {
+			return gorm.ErrRecordNotFound
+		}
+		return recordEvent(tx, EventNewspaperDeleted, map[string]int{"id": a.ID})
+	})
 }
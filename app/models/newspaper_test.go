@@ -1,6 +1,7 @@
 package models_test
 
 import (
+    "context"
     "errors"
     "fmt"
     "regexp"
@@ -41,27 +42,27 @@ func (suite *NewspaperTestSuite) AfterTest(suiteName, testName string) {
 }
 
 func (suite *NewspaperTestSuite) TestNewspaper() {
-	createdNewspaper, err := models.CreateNewspaper("Test", "sports")
+	createdNewspaper, err := models.CreateNewspaper(context.Background(), "Test")
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("Test", createdNewspaper.Title)
-	suite.Assert().Equal("sports", createdNewspaper.ColumnName)
+	suite.Assert().Equal("", createdNewspaper.ColumnName)
 
-	getNewspaper, err := models.GetNewspaper(createdNewspaper.ID)
+	getNewspaper, err := models.GetNewspaper(context.Background(), createdNewspaper.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("Test", getNewspaper.Title)
-	suite.Assert().Equal("sports", getNewspaper.ColumnName)
+	suite.Assert().Equal("", getNewspaper.ColumnName)
 
 	getNewspaper.Title = "updated"
-	err = getNewspaper.Save()
+	err = getNewspaper.Save(context.Background())
 	suite.Assert().Nil(err)
-	updatedNewspaper, err := models.GetNewspaper(createdNewspaper.ID)
+	updatedNewspaper, err := models.GetNewspaper(context.Background(), createdNewspaper.ID)
 	suite.Assert().Nil(err)
 	suite.Assert().Equal("updated", updatedNewspaper.Title)
-	suite.Assert().Equal("sports", updatedNewspaper.ColumnName)
+	suite.Assert().Equal("", updatedNewspaper.ColumnName)
 
-	err = updatedNewspaper.Delete()
+	err = updatedNewspaper.Delete(context.Background())
 	suite.Assert().Nil(err)
-	deletedNewspaper, err := models.GetNewspaper(updatedNewspaper.ID)
+	deletedNewspaper, err := models.GetNewspaper(context.Background(), updatedNewspaper.ID)
 	suite.Assert().Nil(deletedNewspaper)
 	suite.Assert().True(strings.Contains("record not found", err.Error()))
 }
@@ -83,12 +84,12 @@ func (suite *NewspaperTestSuite) TestNewspaperMarshal() {
 func (suite *NewspaperTestSuite) TestNewspaperCreateFailure() {
 	mockDB := suite.MockDB()
 	mockDB.ExpectBegin() // トランザクションの開始を期待
-	mockDB.ExpectExec("INSERT INTO `newspapers`").WithArgs("Test", "sports").WillReturnError(errors.New("create error"))
+	mockDB.ExpectExec("INSERT INTO `newspapers`").WithArgs("Test", "").WillReturnError(errors.New("create error"))
 	// トランザクションのロールバックやコミット操作を期待
 	mockDB.ExpectRollback()
 	mockDB.ExpectCommit()
 
-	newspaper, err := models.CreateNewspaper("Test", "sports")
+	newspaper, err := models.CreateNewspaper(context.Background(), "Test")
 		suite.Assert().Nil(newspaper)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("create error", err.Error())
@@ -99,7 +100,7 @@ func (suite *NewspaperTestSuite) TestNewspaperGetFailure() {
 	// SQLクエリの期待値を設定。このクエリが実行されると、エラー"get error"が返される
 	mockDB.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `newspapers` WHERE `newspapers`.`id` = ? ORDER BY `newspapers`.`id` LIMIT ?")).WithArgs(1, 1).WillReturnError(errors.New("get error"))
 
-	newspaper, err := models.GetNewspaper(1)
+	newspaper, err := models.GetNewspaper(context.Background(), 1)
 	suite.Assert().Nil(newspaper)
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("get error", err.Error())
@@ -118,7 +119,7 @@ func (suite *NewspaperTestSuite) TestNewspaperSaveFailure() {
 		ColumnName: "sports",
 	}
 	newspaper.Title = "updated"
-	err := newspaper.Save()
+	err := newspaper.Save(context.Background())
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("update error", err.Error())
 }
@@ -136,7 +137,7 @@ func (suite *NewspaperTestSuite) TestNewspaperDeleteFailure() {
 			ColumnName:  "sports",
 	}
 
-	err := newspaper.Delete()
+	err := newspaper.Delete(context.Background())
 	suite.Assert().NotNil(err)
 	suite.Assert().Equal("delete error", err.Error())
 }
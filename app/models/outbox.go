@@ -0,0 +1,104 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-api-newspaper/pkg/outbox"
+)
+
+// EventType はドメインイベントの種類
+type EventType string
+
+const (
+	EventArticleCreated   EventType = "article.created"
+	EventArticleUpdated   EventType = "article.updated"
+	EventArticleDeleted   EventType = "article.deleted"
+	EventNewspaperCreated EventType = "newspaper.created"
+	EventNewspaperUpdated EventType = "newspaper.updated"
+	EventNewspaperDeleted EventType = "newspaper.deleted"
+)
+
+var eventTypes = []EventType{
+	EventArticleCreated, EventArticleUpdated, EventArticleDeleted,
+	EventNewspaperCreated, EventNewspaperUpdated, EventNewspaperDeleted,
+}
+
+// OutboxEvent はモデルの変更と同じトランザクションで書くドメインイベント（トランザクショナルアウトボックス）
+// 変更が取り消されるとイベントも書かれないため、確定した変更のイベントだけが配信先に届く
+type OutboxEvent struct {
+	ID        int
+	Sequence  *int      `gorm:"uniqueIndex"` // 届けた順に増える番号（届ける前に付ける。付けていない場合はnil）
+	Type      EventType `gorm:"size:64"`
+	Payload   string    // 作成・更新ではレスポンスと同じ形式のリソース、削除では {"id": ...} のJSON
+	CreatedAt time.Time
+	SentAt    *time.Time `gorm:"index"` // 全ての配信先に届けた時刻（届けていない場合はnil）
+}
+
+func (e *OutboxEvent) event() outbox.Event {
+	event := outbox.Event{ID: e.ID, Type: string(e.Type), Payload: json.RawMessage(e.Payload), OccurredAt: e.CreatedAt}
+	if e.Sequence != nil {
+		event.Sequence = *e.Sequence
+	}
+	return event
+}
+
+// イベントをアウトボックスに書く（モデルの変更と同じトランザクションで実行する）
+func recordEvent(tx *gorm.DB, eventType EventType, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&OutboxEvent{Type: eventType, Payload: string(payload)}).Error
+}
+
+// OutboxStore はデータベースのアウトボックス（outbox.Store の実装）
+type OutboxStore struct{}
+
+func (OutboxStore) Pending(ctx context.Context, limit int) ([]outbox.Event, error) {
+	records := []*OutboxEvent{}
+	// 番号を付けた後に届けられなかったイベントを先に届ける
+	if err := conn(ctx).Where("sent_at IS NULL").Order("sequence IS NULL, sequence, id").Limit(limit).Find(&records).Error; err != nil {
+		return nil, err
+	}
+	events := make([]outbox.Event, len(records))
+	for i, record := range records {
+		events[i] = record.event()
+	}
+	return events, nil
+}
+
+// 確定したイベントだけを読めるため、付ける番号は確定した順（届ける順）に増える
+// 複数のプロセスが同時に付けた場合は一意制約で一方が失敗し、次に届けるときに付け直す
+func (OutboxStore) Sequence(ctx context.Context, ID int) (int, error) {
+	var sequence int
+	err := Transaction(ctx, func(ctx context.Context) error {
+		record := &OutboxEvent{}
+		if err := conn(ctx).First(record, ID).Error; err != nil {
+			return err
+		}
+		if record.Sequence != nil {
+			sequence = *record.Sequence
+			return nil
+		}
+		var last *int
+		if err := conn(ctx).Model(&OutboxEvent{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("MAX(sequence)").Scan(&last).Error; err != nil {
+			return err
+		}
+		sequence = 1
+		if last != nil {
+			sequence = *last + 1
+		}
+		return conn(ctx).Model(record).UpdateColumn("sequence", sequence).Error
+	})
+	return sequence, err
+}
+
+func (OutboxStore) MarkSent(ctx context.Context, ID int, at time.Time) error {
+	return conn(ctx).Model(&OutboxEvent{ID: ID}).UpdateColumn("sent_at", at).Error
+}
@@ -0,0 +1,151 @@
+package models_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/tester"
+)
+
+type OutboxTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestOutboxTestSuite(t *testing.T) {
+	suite.Run(t, new(OutboxTestSuite))
+}
+
+// 何もしない配信先
+type discardSink struct{}
+
+func (discardSink) Name() string                                          { return "discard" }
+func (discardSink) Publish(ctx context.Context, event outbox.Event) error { return nil }
+
+// それまでのイベントを送信済みにし、テストで書いたイベントだけが未送信として残るようにする
+func (suite *OutboxTestSuite) drain(ctx context.Context) {
+	for {
+		dispatched, err := outbox.NewDispatcher(models.OutboxStore{}, 100, discardSink{}).DispatchOnce(ctx)
+		suite.Require().Nil(err)
+		if dispatched == 0 {
+			return
+		}
+	}
+}
+
+func (suite *OutboxTestSuite) pendingTypes(ctx context.Context) []string {
+	events, err := models.OutboxStore{}.Pending(ctx, 100)
+	suite.Require().Nil(err)
+	types := []string{}
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func (suite *OutboxTestSuite) TestRecordEvents() {
+	ctx := context.Background()
+	suite.drain(ctx)
+
+	newspaper, err := models.CreateNewspaper(ctx, "毎日新聞")
+	suite.Assert().Nil(err)
+	newspaper.Title = "毎日新聞社"
+	suite.Assert().Nil(newspaper.Save(ctx))
+	article, err := models.CreateArticle(ctx, "紅葉が見頃だ。", 2023, 11, 20, newspaper.ID, nil)
+	suite.Assert().Nil(err)
+	article.Body = "紅葉が散った。"
+	suite.Assert().Nil(article.Save(ctx))
+	suite.Assert().Nil(article.Delete(ctx))
+	suite.Assert().Nil(newspaper.Delete(ctx))
+
+	// 記事の作成で新聞が関連として保存されても newspaper.updated は書かない
+	suite.Assert().Equal([]string{
+		"newspaper.created", "newspaper.updated",
+		"article.created", "article.updated", "article.deleted",
+		"newspaper.deleted",
+	}, suite.pendingTypes(ctx))
+
+	events, _ := models.OutboxStore{}.Pending(ctx, 100)
+	var created struct {
+		ID   int    `json:"id"`
+		Body string `json:"body"`
+	}
+	suite.Assert().Nil(json.Unmarshal(events[2].Payload, &created))
+	suite.Assert().Equal(article.ID, created.ID)
+	suite.Assert().Equal("紅葉が見頃だ。", created.Body)
+	suite.Assert().JSONEq(fmt.Sprintf(`{"id":%d,"newspaperID":%d}`, article.ID, newspaper.ID), string(events[4].Payload))
+}
+
+func (suite *OutboxTestSuite) TestRolledBackChangeRecordsNoEvent() {
+	ctx := context.Background()
+	suite.drain(ctx)
+
+	newspaper, _ := models.CreateNewspaper(ctx, "日本経済新聞")
+	other, _ := models.CreateNewspaper(ctx, "東京新聞")
+	column, err := models.CreateColumn(ctx, other.ID, "筆洗", "hissen-outbox", "", "")
+	suite.Assert().Nil(err)
+	suite.drain(ctx)
+
+	// 別の新聞のコラムを指定した記事は作成されず、イベントも書かれない
+	_, err = models.CreateArticle(ctx, "雪が降った。", 2023, 12, 1, newspaper.ID, &column.ID)
+	suite.Assert().ErrorIs(err, models.ErrColumnNotInNewspaper)
+	suite.Assert().Empty(suite.pendingTypes(ctx))
+}
+
+func (suite *OutboxTestSuite) TestDeleteMissingRecordsNoEvent() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "産経新聞")
+	article, _ := models.CreateArticle(ctx, "霜が降りた。", 2023, 12, 2, newspaper.ID, nil)
+	suite.Assert().Nil(article.Delete(ctx))
+	suite.Assert().Nil(newspaper.Delete(ctx))
+	suite.drain(ctx)
+
+	// 削除済みのIDを削除しても見つからないエラーになり、イベントは書かれない
+	missingArticle := models.Article{ID: article.ID}
+	suite.Assert().ErrorIs(missingArticle.Delete(ctx), gorm.ErrRecordNotFound)
+	missingNewspaper := models.Newspaper{ID: newspaper.ID}
+	suite.Assert().ErrorIs(missingNewspaper.Delete(ctx), gorm.ErrRecordNotFound)
+	suite.Assert().Empty(suite.pendingTypes(ctx))
+}
+
+func (suite *OutboxTestSuite) TestMarkSent() {
+	ctx := context.Background()
+	suite.drain(ctx)
+
+	models.CreateNewspaper(ctx, "読売新聞")
+	events, err := models.OutboxStore{}.Pending(ctx, 100)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(events, 1)
+	suite.Assert().False(events[0].OccurredAt.IsZero())
+
+	suite.Assert().Nil(models.OutboxStore{}.MarkSent(ctx, events[0].ID, time.Now()))
+	suite.Assert().Empty(suite.pendingTypes(ctx))
+}
+
+func (suite *OutboxTestSuite) TestWebhookSinkIsIdempotent() {
+	ctx := context.Background()
+	suite.drain(ctx)
+
+	subscription, err := models.CreateWebhookSubscription(ctx, "https://example.com/outbox", []models.EventType{models.EventNewspaperCreated}, "0123456789abcdef")
+	suite.Assert().Nil(err)
+	models.CreateNewspaper(ctx, "北海道新聞")
+	events, _ := models.OutboxStore{}.Pending(ctx, 100)
+	suite.Assert().Len(events, 1)
+
+	// 届けた後に送信済みにできず、同じイベントがもう一度届いても配信は1つだけ
+	for i := 0; i < 2; i++ {
+		suite.Assert().Nil(models.WebhookSink{}.Publish(ctx, events[0]))
+	}
+	deliveries, err := models.GetWebhookDeliveries(ctx, subscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(deliveries, 1)
+	suite.Assert().Equal(events[0].ID, deliveries[0].EventID)
+	suite.Assert().Nil(subscription.Delete(ctx))
+}
@@ -0,0 +1,129 @@
+package models
+
+import (
+	"context"
+	"time"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/pkg/transcription"
+)
+
+// PracticeSession はユーザーが記事の本文を書き写した練習の結果
+// 採点時の本文に対する誤りの位置を保存するため、後から記事の本文が変わっても採点し直さない
+type PracticeSession struct {
+	ID              int
+	UserID          string `gorm:"size:128;index:idx_practice_sessions_user_created,priority:1"`
+	ArticleID       int    `gorm:"index"`
+	Text            string
+	Correct         int
+	Missing         int
+	Extra           int
+	Substituted     int
+	Accuracy        float64
+	Errors          []transcription.Error `gorm:"serializer:json"`
+	DurationSeconds *int
+	CreatedAt       time.Time `gorm:"index:idx_practice_sessions_user_created,priority:2"`
+	fields          selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var practiceSessionFields = fieldColumns{
+	"id":              nil,
+	"articleID":       {"article_id"},
+	"text":            {"text"},
+	"accuracy":        {"accuracy"},
+	"distance":        {"missing", "extra", "substituted"},
+	"correct":         {"correct"},
+	"missing":         {"missing"},
+	"extra":           {"extra"},
+	"substituted":     {"substituted"},
+	"errors":          {"errors"},
+	"durationSeconds": {"duration_seconds"},
+	"createdAt":       {"created_at"},
+}
+
+func (p *PracticeSession) MarshalJSON() ([]byte, error) {
+	errors := make([]api.PracticeError, len(p.Errors))
+	for i, e := range p.Errors {
+		errors[i] = api.PracticeError{
+			Category: api.PracticeErrorCategory(e.Category),
+			Position: e.Position,
+			Expected: e.Expected,
+			Actual:   e.Actual,
+		}
+	}
+	return p.fields.marshal(&api.PracticeSessionResponse{
+		Id:              p.ID,
+		ArticleID:       p.ArticleID,
+		Text:            p.Text,
+		Accuracy:        p.Accuracy,
+		Distance:        p.Missing + p.Extra + p.Substituted,
+		Correct:         p.Correct,
+		Missing:         p.Missing,
+		Extra:           p.Extra,
+		Substituted:     p.Substituted,
+		Errors:          errors,
+		DurationSeconds: p.DurationSeconds,
+		CreatedAt:       p.CreatedAt,
+	})
+}
+
+// 書き写した文章を記事の本文と比較して採点し、ユーザーの練習の履歴として保存する
+func CreatePracticeSession(ctx context.Context, userID string, article *Article, text string, durationSeconds *int) (*PracticeSession, error) {
+	result := transcription.Score(article.Body, text)
+	session := &PracticeSession{
+		UserID:          userID,
+		ArticleID:       article.ID,
+		Text:            text,
+		Correct:         result.Correct,
+		Missing:         result.Missing,
+		Extra:           result.Extra,
+		Substituted:     result.Substituted,
+		Accuracy:        result.Accuracy(),
+		Errors:          result.Errors,
+		DurationSeconds: durationSeconds,
+	}
+	if err := conn(ctx).Create(session).Error; err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// ユーザーの練習を取得する（他のユーザーの練習は見つからないものとして扱う）
+func GetPracticeSession(ctx context.Context, userID string, ID int) (*PracticeSession, error) {
+	return GetPracticeSessionWithFields(ctx, userID, ID, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んでユーザーの練習を取得する（レスポンスを返すためだけに使う）
+func GetPracticeSessionWithFields(ctx context.Context, userID string, ID int, fields Fields) (*PracticeSession, error) {
+	selected, err := practiceSessionFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	session := &PracticeSession{fields: selected}
+	if err := conn(ctx).Scopes(practiceSessionFields.scope("practice_sessions", selected)).
+		Where("id = ? AND user_id = ?", ID, userID).First(session).Error; err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// ユーザーの練習の履歴を新しい順に、指定したフィールドに必要な列だけを読み込んで取得する（articleIDを指定した場合はその記事の練習だけ）
+func GetPracticeSessions(ctx context.Context, userID string, articleID *int, limit int, offset int, fields Fields) ([]*PracticeSession, error) {
+	selected, err := practiceSessionFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	sessions := []*PracticeSession{}
+	db := conn(ctx).Scopes(practiceSessionFields.scope("practice_sessions", selected)).Where("user_id = ?", userID)
+	if articleID != nil {
+		db = db.Where("article_id = ?", *articleID)
+	}
+	if err := db.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		session.fields = selected
+	}
+	return sessions, nil
+}
@@ -0,0 +1,90 @@
+package models_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+	"go-api-newspaper/pkg/transcription"
+)
+
+type PracticeSessionTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestPracticeSessionTestSuite(t *testing.T) {
+	suite.Run(t, new(PracticeSessionTestSuite))
+}
+
+func (suite *PracticeSessionTestSuite) TestCreatePracticeSession() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	article, _ := models.CreateArticle(ctx, "春はあけぼの。", 2023, 4, 1, newspaper.ID, nil)
+
+	duration := 30
+	session, err := models.CreatePracticeSession(ctx, "alice", article, "夏はよる。", &duration)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, session.Correct)
+	suite.Assert().Equal(3, session.Substituted)
+	suite.Assert().Equal(2, session.Missing)
+	suite.Assert().InDelta(2.0/7.0, session.Accuracy, 1e-9)
+
+	// 誤りの箇所も保存される
+	found, err := models.GetPracticeSession(ctx, "alice", session.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal([]transcription.Error{
+		{Category: transcription.Substituted, Position: 0, Expected: "春", Actual: "夏"},
+		{Category: transcription.Substituted, Position: 2, Expected: "あけ", Actual: "よる"},
+		{Category: transcription.Missing, Position: 4, Expected: "ぼの"},
+	}, found.Errors)
+
+	var response api.PracticeSessionResponse
+	sessionJSON, err := found.MarshalJSON()
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(json.Unmarshal(sessionJSON, &response))
+	suite.Assert().Equal(5, response.Distance)
+	suite.Assert().Equal(api.Missing, response.Errors[2].Category)
+	suite.Assert().Equal(30, *response.DurationSeconds)
+
+	// 他のユーザーの練習は取得できない
+	_, err = models.GetPracticeSession(ctx, "bob", session.ID)
+	suite.Assert().NotNil(err)
+}
+
+func (suite *PracticeSessionTestSuite) TestGetPracticeSessions() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "読売新聞")
+	spring, _ := models.CreateArticle(ctx, "春", 2023, 4, 1, newspaper.ID, nil)
+	summer, _ := models.CreateArticle(ctx, "夏", 2023, 7, 1, newspaper.ID, nil)
+	first, _ := models.CreatePracticeSession(ctx, "carol", spring, "秋", nil)
+	second, _ := models.CreatePracticeSession(ctx, "carol", spring, "春", nil)
+	third, _ := models.CreatePracticeSession(ctx, "carol", summer, "夏", nil)
+	models.CreatePracticeSession(ctx, "dave", spring, "春", nil)
+
+	sessions, err := models.GetPracticeSessions(ctx, "carol", nil, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(sessions, 3)
+	suite.Assert().Equal(third.ID, sessions[0].ID)
+
+	sessions, err = models.GetPracticeSessions(ctx, "carol", &spring.ID, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(sessions, 2)
+	suite.Assert().Equal(second.ID, sessions[0].ID)
+	suite.Assert().Equal(first.ID, sessions[1].ID)
+
+	sessions, err = models.GetPracticeSessions(ctx, "carol", nil, 1, 2, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(sessions, 1)
+	suite.Assert().Equal(first.ID, sessions[0].ID)
+
+	// 記事を削除すると練習の履歴も削除される
+	suite.Assert().Nil(spring.Delete(ctx))
+	sessions, err = models.GetPracticeSessions(ctx, "dave", nil, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Empty(sessions)
+}
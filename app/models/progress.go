@@ -0,0 +1,246 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-api-newspaper/api"
+)
+
+// ActivityKind はユーザーが記事に対して行った学習の種類
+type ActivityKind string
+
+const (
+	ActivityRead        ActivityKind = "read"        // 記事を読んだ
+	ActivityTranscribed ActivityKind = "transcribed" // 記事を書き写した
+)
+
+// ActivityLocation は学習した日を決めるタイムゾーン（日本の新聞のコラムを対象とするため日本時間）
+var ActivityLocation = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+const activityDateLayout = "2006-01-02"
+
+// UserActivity はユーザーが記事を読んだ・書き写した記録
+// 連続学習日数を数えるため、同じ記事の同じ種類の学習も日が違えば別に記録する
+type UserActivity struct {
+	ID           int
+	UserID       string       `gorm:"size:128;uniqueIndex:idx_user_activities_unique,priority:1"`
+	ArticleID    int          `gorm:"uniqueIndex:idx_user_activities_unique,priority:2;index"`
+	Kind         ActivityKind `gorm:"size:16;uniqueIndex:idx_user_activities_unique,priority:3"`
+	ActivityDate string       `gorm:"size:10;uniqueIndex:idx_user_activities_unique,priority:4"` // ActivityLocationでの日付（YYYY-MM-DD）
+	CreatedAt    time.Time
+}
+
+// ActivityLocationでの日付（YYYY-MM-DD）
+func activityDate(t time.Time) string {
+	return t.In(ActivityLocation).Format(activityDateLayout)
+}
+
+// 学習を記録する（同じ日に同じ記事を同じ種類で学習した場合は何もしない）
+func recordActivity(tx *gorm.DB, userID string, articleID int, kind ActivityKind, at time.Time) error {
+	activity := &UserActivity{
+		UserID:       userID,
+		ArticleID:    articleID,
+		Kind:         kind,
+		ActivityDate: activityDate(at),
+	}
+	return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(activity).Error
+}
+
+// ユーザーが記事を読んだことを記録する
+func (a *Article) MarkRead(ctx context.Context, userID string, at time.Time) error {
+	return recordActivity(conn(ctx), userID, a.ID, ActivityRead, at)
+}
+
+// 書き写しの練習をしたことを記録する（練習の保存時に実行される）
+func (p *PracticeSession) AfterCreate(tx *gorm.DB) error {
+	return recordActivity(tx, p.UserID, p.ArticleID, ActivityTranscribed, p.CreatedAt)
+}
+
+// 記事の数と、そのうちユーザーが読んだ・書き写した・いずれかを行った記事の数を集計する
+const articleProgressSelect = "COUNT(DISTINCT articles.id) AS articles, " +
+	"COUNT(DISTINCT CASE WHEN user_activities.kind = 'read' THEN user_activities.article_id END) AS read_articles, " +
+	"COUNT(DISTINCT CASE WHEN user_activities.kind = 'transcribed' THEN user_activities.article_id END) AS transcribed_articles, " +
+	"COUNT(DISTINCT user_activities.article_id) AS completed_articles"
+
+// ArticleProgress は記事の数とユーザーが学習した記事の数
+type ArticleProgress struct {
+	Articles            int
+	ReadArticles        int
+	TranscribedArticles int
+	CompletedArticles   int // 読んだか書き写した記事の数
+}
+
+// NewspaperProgress は新聞ごとの学習の進み具合
+type NewspaperProgress struct {
+	NewspaperID int
+	Title       string
+	ArticleProgress
+}
+
+// Progress はユーザーの学習の進み具合
+type Progress struct {
+	ArticleProgress
+	ActiveDays     int     // 学習した日数
+	CurrentStreak  int     // 今日（今日まだ学習していなければ昨日）まで続いている連続学習日数
+	LongestStreak  int     // これまでで最も長い連続学習日数
+	LastActiveDate *string // 最後に学習した日（YYYY-MM-DD）
+	Newspapers     []*NewspaperProgress
+}
+
+func (p *Progress) MarshalJSON() ([]byte, error) {
+	newspapers := make([]api.NewspaperProgressResponse, len(p.Newspapers))
+	for i, n := range p.Newspapers {
+		newspapers[i] = api.NewspaperProgressResponse{
+			NewspaperID: n.NewspaperID,
+			Title:       n.Title,
+			Articles:    n.Articles,
+			Read:        n.ReadArticles,
+			Transcribed: n.TranscribedArticles,
+			Completed:   n.CompletedArticles,
+		}
+	}
+	return json.Marshal(&api.ProgressResponse{
+		Articles:       p.Articles,
+		Read:           p.ReadArticles,
+		Transcribed:    p.TranscribedArticles,
+		Completed:      p.CompletedArticles,
+		ActiveDays:     p.ActiveDays,
+		CurrentStreak:  p.CurrentStreak,
+		LongestStreak:  p.LongestStreak,
+		LastActiveDate: p.LastActiveDate,
+		Newspapers:     newspapers,
+	})
+}
+
+// ユーザーの学習の進み具合を集計する（todayは連続学習日数を数える基準の日時）
+func GetProgress(ctx context.Context, userID string, today time.Time) (*Progress, error) {
+	progress := &Progress{Newspapers: []*NewspaperProgress{}}
+	if err := conn(ctx).Model(&Newspaper{}).
+		Select("newspapers.id AS newspaper_id, newspapers.title AS title, "+articleProgressSelect).
+		Joins("LEFT JOIN articles ON articles.newspaper_id = newspapers.id").
+		Joins("LEFT JOIN user_activities ON user_activities.article_id = articles.id AND user_activities.user_id = ?", userID).
+		Group("newspapers.id, newspapers.title").
+		Order("newspapers.id").
+		Scan(&progress.Newspapers).Error; err != nil {
+		return nil, err
+	}
+	// 記事はいずれか1つの新聞に属するため、新聞ごとの数を足せば全体の数になる
+	for _, newspaper := range progress.Newspapers {
+		progress.Articles += newspaper.Articles
+		progress.ReadArticles += newspaper.ReadArticles
+		progress.TranscribedArticles += newspaper.TranscribedArticles
+		progress.CompletedArticles += newspaper.CompletedArticles
+	}
+
+	var dates []string
+	if err := conn(ctx).Model(&UserActivity{}).Distinct("activity_date").
+		Where("user_id = ?", userID).Order("activity_date").Pluck("activity_date", &dates).Error; err != nil {
+		return nil, err
+	}
+	progress.ActiveDays = len(dates)
+	progress.CurrentStreak, progress.LongestStreak = streaks(dates, activityDate(today))
+	if len(dates) > 0 {
+		progress.LastActiveDate = &dates[len(dates)-1]
+	}
+	return progress, nil
+}
+
+// 昇順に並んだ学習した日から、現在の連続学習日数と最長の連続学習日数を求める
+func streaks(dates []string, today string) (int, int) {
+	current, longest := 0, 0
+	var previous time.Time
+	for _, date := range dates {
+		day, err := time.Parse(activityDateLayout, date)
+		if err != nil {
+			continue
+		}
+		if !previous.IsZero() && day.Sub(previous) == 24*time.Hour {
+			current++
+		} else {
+			current = 1
+		}
+		longest = max(longest, current)
+		previous = day
+	}
+
+	// 最後に学習した日が今日でも昨日でもなければ、連続は途切れている
+	todayDay, err := time.Parse(activityDateLayout, today)
+	if err != nil || previous.IsZero() || todayDay.Sub(previous) > 24*time.Hour {
+		current = 0
+	}
+	return current, longest
+}
+
+// CalendarDay は1日分の記事の数とユーザーが学習した記事の数
+type CalendarDay struct {
+	Day int
+	ArticleProgress
+}
+
+// Completed はその日の記事を全て読んだか書き写したかを返す（記事がない日はfalse）
+func (d *CalendarDay) Completed() bool {
+	return d.Articles > 0 && d.CompletedArticles == d.Articles
+}
+
+// Calendar は新聞の1か月分の記事の発行日ごとの学習の進み具合
+type Calendar struct {
+	NewspaperID int
+	Year        int
+	Month       int
+	Days        []*CalendarDay // 1日から月末まで
+}
+
+func (c *Calendar) MarshalJSON() ([]byte, error) {
+	days := make([]api.CalendarDayResponse, len(c.Days))
+	completedDays := 0
+	for i, d := range c.Days {
+		days[i] = api.CalendarDayResponse{
+			Day:         d.Day,
+			Articles:    d.Articles,
+			Read:        d.ReadArticles,
+			Transcribed: d.TranscribedArticles,
+			Completed:   d.Completed(),
+		}
+		if d.Completed() {
+			completedDays++
+		}
+	}
+	return json.Marshal(&api.CalendarResponse{
+		NewspaperID:   c.NewspaperID,
+		Year:          c.Year,
+		Month:         c.Month,
+		CompletedDays: completedDays,
+		Days:          days,
+	})
+}
+
+// 新聞の指定した月の記事を発行日（記事の年月日）ごとに集計し、ユーザーが学習した記事の数を求める
+func GetMonthlyCalendar(ctx context.Context, userID string, newspaperID int, year int, month int) (*Calendar, error) {
+	var rows []*CalendarDay
+	if err := conn(ctx).Model(&Article{}).
+		Select("articles.day AS day, "+articleProgressSelect).
+		Joins("LEFT JOIN user_activities ON user_activities.article_id = articles.id AND user_activities.user_id = ?", userID).
+		Where("articles.newspaper_id = ? AND articles.year = ? AND articles.month = ?", newspaperID, year, month).
+		Group("articles.day").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	// 記事のない日も含めて月末までの日を並べる
+	daysInMonth := time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	calendar := &Calendar{NewspaperID: newspaperID, Year: year, Month: month, Days: make([]*CalendarDay, daysInMonth)}
+	for i := range calendar.Days {
+		calendar.Days[i] = &CalendarDay{Day: i + 1}
+	}
+	for _, row := range rows {
+		if row.Day >= 1 && row.Day <= daysInMonth {
+			calendar.Days[row.Day-1] = row
+		}
+	}
+	return calendar, nil
+}
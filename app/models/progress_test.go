@@ -0,0 +1,123 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type ProgressTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestProgressTestSuite(t *testing.T) {
+	suite.Run(t, new(ProgressTestSuite))
+}
+
+// 日本時間の指定した日の時刻
+func jst(year int, month time.Month, day int, hour int) time.Time {
+	return time.Date(year, month, day, hour, 0, 0, 0, models.ActivityLocation)
+}
+
+// 新聞の進み具合を取り出す
+func findNewspaperProgress(progress *models.Progress, newspaperID int) *models.NewspaperProgress {
+	for _, newspaper := range progress.Newspapers {
+		if newspaper.NewspaperID == newspaperID {
+			return newspaper
+		}
+	}
+	return nil
+}
+
+func (suite *ProgressTestSuite) TestStreaks() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	article, _ := models.CreateArticle(ctx, "春はあけぼの。", 2023, 4, 1, newspaper.ID, nil)
+
+	// 4/1〜4/3 の3日連続、1日空けて 4/5〜4/6 の2日連続
+	for _, at := range []time.Time{
+		jst(2023, 4, 1, 8), jst(2023, 4, 1, 21), jst(2023, 4, 2, 8), jst(2023, 4, 3, 23),
+		jst(2023, 4, 5, 0), jst(2023, 4, 6, 7),
+	} {
+		suite.Assert().Nil(article.MarkRead(ctx, "alice", at))
+	}
+	// 日本時間で日付が変わった直後（UTCではまだ前日）の記録は日本時間の日付で数える
+	suite.Assert().Nil(article.MarkRead(ctx, "alice", time.Date(2023, 4, 6, 15, 30, 0, 0, time.UTC)))
+
+	progress, err := models.GetProgress(ctx, "alice", jst(2023, 4, 7, 12))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(6, progress.ActiveDays)
+	suite.Assert().Equal(3, progress.CurrentStreak) // 4/5〜4/7
+	suite.Assert().Equal(3, progress.LongestStreak)
+	suite.Assert().Equal("2023-04-07", *progress.LastActiveDate)
+
+	// 今日まだ学習していなくても、昨日まで続いていれば連続は途切れない
+	progress, _ = models.GetProgress(ctx, "alice", jst(2023, 4, 8, 12))
+	suite.Assert().Equal(3, progress.CurrentStreak)
+	progress, _ = models.GetProgress(ctx, "alice", jst(2023, 4, 9, 12))
+	suite.Assert().Equal(0, progress.CurrentStreak)
+	suite.Assert().Equal(3, progress.LongestStreak)
+
+	// 学習していないユーザー
+	progress, err = models.GetProgress(ctx, "nobody", jst(2023, 4, 9, 12))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(0, progress.ActiveDays)
+	suite.Assert().Equal(0, progress.CurrentStreak)
+	suite.Assert().Nil(progress.LastActiveDate)
+}
+
+func (suite *ProgressTestSuite) TestProgressAndCalendar() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "読売新聞")
+	first, _ := models.CreateArticle(ctx, "一日の記事", 2023, 5, 1, newspaper.ID, nil)
+	second, _ := models.CreateArticle(ctx, "二日の記事", 2023, 5, 2, newspaper.ID, nil)
+	models.CreateArticle(ctx, "二日の別の記事", 2023, 5, 2, newspaper.ID, nil)
+	models.CreateArticle(ctx, "六月の記事", 2023, 6, 1, newspaper.ID, nil)
+
+	suite.Assert().Nil(first.MarkRead(ctx, "bob", jst(2023, 5, 1, 8)))
+	suite.Assert().Nil(second.MarkRead(ctx, "bob", jst(2023, 5, 2, 8)))
+	_, err := models.CreatePracticeSession(ctx, "bob", first, "一日の記事", nil) // 書き写しも学習として記録される
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(first.MarkRead(ctx, "carol", jst(2023, 5, 1, 8)))
+
+	progress, err := models.GetProgress(ctx, "bob", time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(2, progress.ReadArticles)
+	suite.Assert().Equal(1, progress.TranscribedArticles)
+	suite.Assert().Equal(2, progress.CompletedArticles)
+	suite.Assert().Equal(1, progress.CurrentStreak) // 書き写しは今日の学習
+	newspaperProgress := findNewspaperProgress(progress, newspaper.ID)
+	suite.Assert().NotNil(newspaperProgress)
+	suite.Assert().Equal(4, newspaperProgress.Articles)
+	suite.Assert().Equal(2, newspaperProgress.CompletedArticles)
+
+	calendar, err := models.GetMonthlyCalendar(ctx, "bob", newspaper.ID, 2023, 5)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(calendar.Days, 31)
+	suite.Assert().Equal(1, calendar.Days[0].Articles)
+	suite.Assert().Equal(1, calendar.Days[0].ReadArticles)
+	suite.Assert().Equal(1, calendar.Days[0].TranscribedArticles)
+	suite.Assert().True(calendar.Days[0].Completed())
+	suite.Assert().Equal(2, calendar.Days[1].Articles)
+	suite.Assert().Equal(1, calendar.Days[1].CompletedArticles)
+	suite.Assert().False(calendar.Days[1].Completed()) // 2日の記事は1つしか読んでいない
+	suite.Assert().False(calendar.Days[2].Completed()) // 記事のない日
+
+	calendarJSON, err := calendar.MarshalJSON()
+	suite.Assert().Nil(err)
+	suite.Assert().Contains(string(calendarJSON), `"completedDays":1`)
+
+	// 他のユーザーの学習は含まない
+	calendar, _ = models.GetMonthlyCalendar(ctx, "carol", newspaper.ID, 2023, 5)
+	suite.Assert().Equal(0, calendar.Days[0].TranscribedArticles)
+	suite.Assert().True(calendar.Days[0].Completed())
+
+	// 閏年の2月
+	calendar, _ = models.GetMonthlyCalendar(ctx, "bob", newspaper.ID, 2024, 2)
+	suite.Assert().Len(calendar.Days, 29)
+}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"go-api-newspaper/api"
+)
+
+// 空白だけのタグ名を指定した場合のエラー
+var ErrInvalidTagName = errors.New("tag name must not be empty")
+
+// Tag は記事を話題（政治、季節、スポーツなど）で分類するためのタグ
+// 記事とは article_tags テーブルを介して多対多で関連付ける
+type Tag struct {
+	ID   int
+	Name string `gorm:"uniqueIndex;size:191"`
+}
+
+// ArticleTag は記事とタグを関連付ける中間テーブルの行
+type ArticleTag struct {
+	ArticleID int `gorm:"primaryKey"`
+	TagID     int `gorm:"primaryKey;index"`
+}
+
+// TagCount はタグとそのタグが付いた記事の数
+type TagCount struct {
+	Name  string
+	Count int
+}
+
+func (t *TagCount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&api.TagCountResponse{
+		Name:  t.Name,
+		Count: t.Count,
+	})
+}
+
+// 前後の空白を除き、英字は小文字にそろえる（"Sports" と "sports " を同じタグとして扱う）
+func normalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// 同じ名前のタグがあればそれを返し、なければ作成する
+func GetOrCreateTag(ctx context.Context, name string) (*Tag, error) {
+	name = normalizeTagName(name)
+	if name == "" {
+		return nil, ErrInvalidTagName
+	}
+	tag := &Tag{}
+	if err := conn(ctx).Where(Tag{Name: name}).FirstOrCreate(tag).Error; err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+// 記事にタグを付ける（既に付いている場合は何もしない）
+func (a *Article) AddTag(ctx context.Context, name string) error {
+	tag, err := GetOrCreateTag(ctx, name)
+	if err != nil {
+		return err
+	}
+	articleTag := &ArticleTag{ArticleID: a.ID, TagID: tag.ID}
+	if err := conn(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(articleTag).Error; err != nil {
+		return err
+	}
+	return a.loadTags(ctx)
+}
+
+// 記事からタグを外す（付いていない場合は何もしない）
+func (a *Article) RemoveTag(ctx context.Context, name string) error {
+	name = normalizeTagName(name)
+	if name == "" {
+		return ErrInvalidTagName
+	}
+	tagIDs := conn(ctx).Model(&Tag{}).Select("id").Where("name = ?", name)
+	if err := conn(ctx).Where("article_id = ? AND tag_id IN (?)", a.ID, tagIDs).Delete(&ArticleTag{}).Error; err != nil {
+		return err
+	}
+	return a.loadTags(ctx)
+}
+
+func (a *Article) loadTags(ctx context.Context) error {
+	a.Tags = []*Tag{}
+	return conn(ctx).Model(&Tag{}).
+		Joins("JOIN article_tags ON article_tags.tag_id = tags.id").
+		Where("article_tags.article_id = ?", a.ID).
+		Order("tags.name").
+		Find(&a.Tags).Error
+}
+
+// 複数の記事に付いたタグを記事のIDごとに名前順で取得する（タグのない記事は含まない）
+func GetArticlesTags(ctx context.Context, articleIDs []int) (map[int][]*Tag, error) {
+	rows := []struct {
+		ArticleID int
+		Tag
+	}{}
+	if err := conn(ctx).Model(&Tag{}).
+		Select("article_tags.article_id, tags.id, tags.name").
+		Joins("JOIN article_tags ON article_tags.tag_id = tags.id").
+		Where("article_tags.article_id IN ?", articleIDs).
+		Order("tags.name").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	tags := make(map[int][]*Tag, len(articleIDs))
+	for i := range rows {
+		tags[rows[i].ArticleID] = append(tags[rows[i].ArticleID], &rows[i].Tag)
+	}
+	return tags, nil
+}
+
+// 新聞の記事に付いているタグを、記事数の多い順（同数なら名前順）に取得する
+func GetNewspaperTagCounts(ctx context.Context, newspaperID int) ([]*TagCount, error) {
+	counts := []*TagCount{}
+	if err := conn(ctx).Model(&Tag{}).
+		Select("tags.name AS name, COUNT(*) AS count").
+		Joins("JOIN article_tags ON article_tags.tag_id = tags.id").
+		Joins("JOIN articles ON articles.id = article_tags.article_id").
+		Where("articles.newspaper_id = ?", newspaperID).
+		Group("tags.id, tags.name").
+		Order("count DESC, name").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// 記事に付いたタグを名前順に読み込む（Preload用）
+func preloadTags(db *gorm.DB) *gorm.DB {
+	return db.Order("tags.name")
+}
@@ -0,0 +1,106 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type TagTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
+
+func (suite *TagTestSuite) TestAddAndRemoveTag() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	article, _ := models.CreateArticle(ctx, "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+
+	suite.Assert().Nil(article.AddTag(ctx, "花見"))
+	suite.Assert().Nil(article.AddTag(ctx, " Sports "))
+	suite.Assert().Nil(article.AddTag(ctx, "花見")) // 同じタグを付けても重複しない
+	suite.Assert().ErrorIs(article.AddTag(ctx, "  "), models.ErrInvalidTagName)
+
+	tagged, err := models.GetArticle(ctx, article.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(tagged.Tags, 2)
+	suite.Assert().Equal("sports", tagged.Tags[0].Name)
+	suite.Assert().Equal("花見", tagged.Tags[1].Name)
+
+	// 保存してもタグの関連は変わらない
+	tagged.Body = "桜が散った。"
+	suite.Assert().Nil(tagged.Save(ctx))
+
+	suite.Assert().Nil(tagged.RemoveTag(ctx, "SPORTS"))
+	suite.Assert().Len(tagged.Tags, 1)
+	articleJSON, err := tagged.MarshalJSON()
+	suite.Assert().Nil(err)
+	suite.Assert().Contains(string(articleJSON), `"tags":["花見"]`)
+}
+
+func (suite *TagTestSuite) TestFindArticlesAndTagCounts() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "読売新聞")
+	other, _ := models.CreateNewspaper(ctx, "毎日新聞")
+	spring, _ := models.CreateArticle(ctx, "春", 2023, 4, 1, newspaper.ID, nil)
+	summer, _ := models.CreateArticle(ctx, "夏", 2023, 7, 1, newspaper.ID, nil)
+	election, _ := models.CreateArticle(ctx, "選挙", 2023, 7, 2, other.ID, nil)
+	suite.Assert().Nil(spring.AddTag(ctx, "季節"))
+	suite.Assert().Nil(summer.AddTag(ctx, "季節"))
+	suite.Assert().Nil(summer.AddTag(ctx, "スポーツ"))
+	suite.Assert().Nil(election.AddTag(ctx, "政治"))
+	suite.Assert().Nil(election.AddTag(ctx, "季節"))
+
+	// 新しい順に並び、タグは全て付いている記事に絞り込まれる
+	articles, err := models.FindArticles(ctx, models.ArticleFilter{Tags: []string{"季節"}}, models.OrderNewest, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 3)
+	suite.Assert().Equal(election.ID, articles[0].ID)
+	articles, err = models.FindArticles(ctx, models.ArticleFilter{NewspaperID: &newspaper.ID, Tags: []string{"季節", "スポーツ"}}, models.OrderNewest, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 1)
+	suite.Assert().Equal(summer.ID, articles[0].ID)
+	suite.Assert().Len(articles[0].Tags, 2)
+	articles, err = models.FindArticles(ctx, models.ArticleFilter{NewspaperID: &newspaper.ID}, models.OrderNewest, 1, 1, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(articles, 1)
+	suite.Assert().Equal(spring.ID, articles[0].ID)
+
+	counts, err := models.GetNewspaperTagCounts(ctx, newspaper.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(counts, 2)
+	suite.Assert().Equal(models.TagCount{Name: "季節", Count: 2}, *counts[0])
+	suite.Assert().Equal(models.TagCount{Name: "スポーツ", Count: 1}, *counts[1])
+
+	// 記事を削除するとタグの関連も削除される
+	suite.Assert().Nil(summer.Delete(ctx))
+	counts, err = models.GetNewspaperTagCounts(ctx, newspaper.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Len(counts, 1)
+}
+
+func (suite *TagTestSuite) TestGetArticlesTags() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "東京新聞")
+	first, _ := models.CreateArticle(ctx, "梅が咲いた。", 2023, 2, 1, newspaper.ID, nil)
+	second, _ := models.CreateArticle(ctx, "節分の豆まき。", 2023, 2, 3, newspaper.ID, nil)
+	untagged, _ := models.CreateArticle(ctx, "寒い日が続く。", 2023, 2, 5, newspaper.ID, nil)
+	first.AddTag(ctx, "花")
+	first.AddTag(ctx, "季節")
+	second.AddTag(ctx, "行事")
+
+	tags, err := models.GetArticlesTags(ctx, []int{first.ID, second.ID, untagged.ID})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(tags, 2)
+	suite.Assert().Equal("季節", tags[first.ID][0].Name)
+	suite.Assert().Equal("花", tags[first.ID][1].Name)
+	suite.Assert().Equal("行事", tags[second.ID][0].Name)
+	suite.Assert().Nil(tags[untagged.ID])
+}
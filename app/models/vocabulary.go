@@ -0,0 +1,248 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/pkg/sm2"
+	"go-api-newspaper/pkg/tokenizer"
+)
+
+var (
+	// 空白だけの単語を登録しようとした場合のエラー
+	ErrInvalidVocabularyWord = errors.New("word must not be empty")
+	// 指定した記事の本文の位置に単語がない場合のエラー
+	ErrWordNotInArticle = errors.New("word does not appear in the article at the offset")
+)
+
+// VocabularyEntry はユーザーが記事を読んでいて調べた単語
+// 復習の予定はSM-2で決め、DueDateの日（ActivityLocationでの日付）になったら復習する
+type VocabularyEntry struct {
+	ID             int
+	UserID         string `gorm:"size:128;index:idx_vocabulary_entries_user_due,priority:1"`
+	Word           string `gorm:"size:191"`
+	Reading        string // ひらがなの読み
+	Meaning        string
+	ArticleID      *int     `gorm:"index"`              // 単語を調べた記事（記事が削除されると空になる）
+	Offset         *int     `gorm:"column:char_offset"` // 記事の本文における単語の文字（rune）単位の位置
+	Article        *Article `gorm:"-"`                  // 出典の表示用（GetAllVocabularyEntriesで読み込む）
+	EaseFactor     float64
+	IntervalDays   int
+	Repetitions    int
+	DueDate        string `gorm:"size:10;index:idx_vocabulary_entries_user_due,priority:2"`
+	LastReviewedAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	fields         selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var vocabularyFields = fieldColumns{
+	"id":             nil,
+	"word":           {"word"},
+	"reading":        {"reading"},
+	"meaning":        {"meaning"},
+	"articleID":      {"article_id"},
+	"offset":         {"char_offset"},
+	"easeFactor":     {"ease_factor"},
+	"interval":       {"interval_days"},
+	"repetitions":    {"repetitions"},
+	"dueDate":        {"due_date"},
+	"lastReviewedAt": {"last_reviewed_at"},
+	"createdAt":      {"created_at"},
+}
+
+func (v *VocabularyEntry) MarshalJSON() ([]byte, error) {
+	return v.fields.marshal(&api.VocabularyEntryResponse{
+		Id:             v.ID,
+		Word:           v.Word,
+		Reading:        v.Reading,
+		Meaning:        v.Meaning,
+		ArticleID:      v.ArticleID,
+		Offset:         v.Offset,
+		EaseFactor:     v.EaseFactor,
+		Interval:       v.IntervalDays,
+		Repetitions:    v.Repetitions,
+		DueDate:        v.DueDate,
+		LastReviewedAt: v.LastReviewedAt,
+		CreatedAt:      v.CreatedAt,
+	})
+}
+
+// Source は出典（新聞と記事の発行日）を返す（記事を読み込んでいない場合は空）
+func (v *VocabularyEntry) Source() string {
+	if v.Article == nil {
+		return ""
+	}
+	return v.Article.Title()
+}
+
+// 単語を単語帳に登録する（登録した日から復習を始める）
+func CreateVocabularyEntry(ctx context.Context, userID string, word string, reading string, meaning string, articleID *int, offset *int, at time.Time) (*VocabularyEntry, error) {
+	card := sm2.NewCard()
+	entry := &VocabularyEntry{
+		UserID:      userID,
+		Word:        word,
+		Reading:     reading,
+		Meaning:     meaning,
+		ArticleID:   articleID,
+		Offset:      offset,
+		EaseFactor:  card.EaseFactor,
+		Repetitions: card.Repetitions,
+		DueDate:     activityDate(at),
+	}
+	if err := conn(ctx).Create(entry).Error; err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// 単語を整え、読みを省略した場合は辞書の読みで補う（作成・更新時に実行される）
+func (v *VocabularyEntry) BeforeSave(tx *gorm.DB) error {
+	v.Word = strings.TrimSpace(v.Word)
+	if v.Word == "" {
+		return ErrInvalidVocabularyWord
+	}
+	if v.Reading == "" {
+		if entry, ok := tokenizer.DefaultDictionary().Lookup(v.Word); ok {
+			v.Reading = tokenizer.ToHiragana(entry.Reading)
+		}
+	}
+	return nil
+}
+
+// 記事の本文の指定した位置に単語があるかを確認する（作成時に実行される）
+// 更新時は確認しないため、活用形で登録した単語を後から辞書形に直すことができる
+func (v *VocabularyEntry) BeforeCreate(tx *gorm.DB) error {
+	if v.ArticleID == nil {
+		if v.Offset != nil {
+			return ErrWordNotInArticle
+		}
+		return nil
+	}
+	article := &Article{}
+	if err := tx.Select("id", "body").First(article, *v.ArticleID).Error; err != nil {
+		return err
+	}
+	if v.Offset == nil {
+		return nil
+	}
+	body, word := []rune(article.Body), []rune(v.Word)
+	if *v.Offset+len(word) > len(body) || string(body[*v.Offset:*v.Offset+len(word)]) != v.Word {
+		return ErrWordNotInArticle
+	}
+	return nil
+}
+
+// ユーザーの単語を取得する（他のユーザーの単語は見つからないものとして扱う）
+func GetVocabularyEntry(ctx context.Context, userID string, ID int) (*VocabularyEntry, error) {
+	return GetVocabularyEntryWithFields(ctx, userID, ID, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んでユーザーの単語を取得する（レスポンスを返すためだけに使い、保存しない）
+func GetVocabularyEntryWithFields(ctx context.Context, userID string, ID int, fields Fields) (*VocabularyEntry, error) {
+	selected, err := vocabularyFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	entry := &VocabularyEntry{fields: selected}
+	if err := conn(ctx).Scopes(vocabularyFields.scope("vocabulary_entries", selected)).
+		Where("id = ? AND user_id = ?", ID, userID).First(entry).Error; err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// ユーザーの単語を登録の新しい順に、指定したフィールドに必要な列だけを読み込んで取得する（articleIDを指定した場合はその記事から登録した単語だけ）
+func GetVocabularyEntries(ctx context.Context, userID string, articleID *int, limit int, offset int, fields Fields) ([]*VocabularyEntry, error) {
+	selected, err := vocabularyFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*VocabularyEntry{}
+	db := conn(ctx).Scopes(vocabularyFields.scope("vocabulary_entries", selected)).Where("user_id = ?", userID)
+	if articleID != nil {
+		db = db.Where("article_id = ?", *articleID)
+	}
+	if err := db.Order("id DESC").Limit(limit).Offset(offset).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		entry.fields = selected
+	}
+	return entries, nil
+}
+
+// todayまでに復習する予定の単語を予定日の古い順に、指定したフィールドに必要な列だけを読み込んで取得する
+func GetDueVocabularyEntries(ctx context.Context, userID string, today time.Time, limit int, fields Fields) ([]*VocabularyEntry, error) {
+	selected, err := vocabularyFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*VocabularyEntry{}
+	if err := conn(ctx).Scopes(vocabularyFields.scope("vocabulary_entries", selected)).
+		Where("user_id = ? AND due_date <= ?", userID, activityDate(today)).
+		Order("due_date, id").Limit(limit).Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		entry.fields = selected
+	}
+	return entries, nil
+}
+
+// ユーザーの全ての単語を登録順に、出典の記事と新聞とともに取得する（書き出し用）
+func GetAllVocabularyEntries(ctx context.Context, userID string) ([]*VocabularyEntry, error) {
+	entries := []*VocabularyEntry{}
+	if err := conn(ctx).Where("user_id = ?", userID).Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	var articleIDs []int
+	for _, entry := range entries {
+		if entry.ArticleID != nil {
+			articleIDs = append(articleIDs, *entry.ArticleID)
+		}
+	}
+	if len(articleIDs) == 0 {
+		return entries, nil
+	}
+	articles := []*Article{}
+	if err := conn(ctx).Preload("Newspaper").Find(&articles, articleIDs).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+	}
+	for _, entry := range entries {
+		if entry.ArticleID != nil {
+			entry.Article = byID[*entry.ArticleID]
+		}
+	}
+	return entries, nil
+}
+
+// 復習の出来（0〜5）をSM-2に渡し、次に復習する日を決める
+func (v *VocabularyEntry) Review(ctx context.Context, quality int, at time.Time) error {
+	card := sm2.Card{EaseFactor: v.EaseFactor, Interval: v.IntervalDays, Repetitions: v.Repetitions}.Review(quality)
+	v.EaseFactor = card.EaseFactor
+	v.IntervalDays = card.Interval
+	v.Repetitions = card.Repetitions
+	v.DueDate = activityDate(at.In(ActivityLocation).AddDate(0, 0, card.Interval))
+	v.LastReviewedAt = &at
+	return v.Save(ctx)
+}
+
+func (v *VocabularyEntry) Save(ctx context.Context) error {
+	return conn(ctx).Save(v).Error
+}
+
+func (v *VocabularyEntry) Delete(ctx context.Context) error {
+	return conn(ctx).Where("id = ? AND user_id = ?", v.ID, v.UserID).Delete(&VocabularyEntry{}).Error
+}
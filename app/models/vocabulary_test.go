@@ -0,0 +1,108 @@
+package models_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type VocabularyTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestVocabularyTestSuite(t *testing.T) {
+	suite.Run(t, new(VocabularyTestSuite))
+}
+
+func (suite *VocabularyTestSuite) TestCreateVocabularyEntry() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	article, _ := models.CreateArticle(ctx, "国会で選挙の日程が決まった。", 2023, 4, 1, newspaper.ID, nil)
+
+	// 読みを省略した場合は辞書の読みを使う
+	offset := 3
+	entry, err := models.CreateVocabularyEntry(ctx, "alice", " 選挙 ", "", "議員を選ぶこと", &article.ID, &offset, jst(2023, 4, 1, 8))
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("選挙", entry.Word)
+	suite.Assert().Equal("せんきょ", entry.Reading)
+	suite.Assert().Equal("2023-04-01", entry.DueDate)
+	suite.Assert().Equal(2.5, entry.EaseFactor)
+
+	// 本文のその位置に単語がない
+	wrongOffset := 0
+	_, err = models.CreateVocabularyEntry(ctx, "alice", "選挙", "", "", &article.ID, &wrongOffset, jst(2023, 4, 1, 8))
+	suite.Assert().ErrorIs(err, models.ErrWordNotInArticle)
+	tooLong := 13
+	_, err = models.CreateVocabularyEntry(ctx, "alice", "選挙", "", "", &article.ID, &tooLong, jst(2023, 4, 1, 8))
+	suite.Assert().ErrorIs(err, models.ErrWordNotInArticle)
+	_, err = models.CreateVocabularyEntry(ctx, "alice", "選挙", "", "", nil, &offset, jst(2023, 4, 1, 8))
+	suite.Assert().ErrorIs(err, models.ErrWordNotInArticle)
+	_, err = models.CreateVocabularyEntry(ctx, "alice", "  ", "", "", nil, nil, jst(2023, 4, 1, 8))
+	suite.Assert().ErrorIs(err, models.ErrInvalidVocabularyWord)
+
+	// 記事を削除しても単語は残り、出典だけが外れる
+	suite.Assert().Nil(article.Delete(ctx))
+	found, err := models.GetVocabularyEntry(ctx, "alice", entry.ID)
+	suite.Assert().Nil(err)
+	suite.Assert().Nil(found.ArticleID)
+	suite.Assert().Nil(found.Offset)
+
+	// 他のユーザーの単語は取得できない
+	_, err = models.GetVocabularyEntry(ctx, "bob", entry.ID)
+	suite.Assert().NotNil(err)
+}
+
+func (suite *VocabularyTestSuite) TestReviewAndDue() {
+	ctx := context.Background()
+	first, _ := models.CreateVocabularyEntry(ctx, "carol", "曙", "あけぼの", "夜明け", nil, nil, jst(2023, 4, 1, 8))
+	second, _ := models.CreateVocabularyEntry(ctx, "carol", "趣", "おもむき", "味わい", nil, nil, jst(2023, 4, 2, 8))
+	models.CreateVocabularyEntry(ctx, "dave", "春", "はる", "", nil, nil, jst(2023, 4, 1, 8))
+
+	due, err := models.GetDueVocabularyEntries(ctx, "carol", jst(2023, 4, 1, 20), 10, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(due, 1)
+	suite.Assert().Equal(first.ID, due[0].ID)
+
+	// 1回目は翌日、2回目は6日後に復習する
+	suite.Assert().Nil(first.Review(ctx, 5, jst(2023, 4, 1, 20)))
+	suite.Assert().Equal("2023-04-02", first.DueDate)
+	suite.Assert().Nil(first.Review(ctx, 4, jst(2023, 4, 2, 23)))
+	suite.Assert().Equal("2023-04-08", first.DueDate)
+	suite.Assert().Equal(2, first.Repetitions)
+	suite.Assert().NotNil(first.LastReviewedAt)
+
+	due, _ = models.GetDueVocabularyEntries(ctx, "carol", jst(2023, 4, 3, 8), 10, models.Fields{})
+	suite.Assert().Len(due, 1)
+	suite.Assert().Equal(second.ID, due[0].ID)
+
+	// 思い出せなかった単語は翌日に復習し直す
+	suite.Assert().Nil(first.Review(ctx, 1, jst(2023, 4, 8, 8)))
+	suite.Assert().Equal("2023-04-09", first.DueDate)
+	suite.Assert().Equal(0, first.Repetitions)
+
+	due, _ = models.GetDueVocabularyEntries(ctx, "carol", jst(2023, 4, 9, 8), 10, models.Fields{})
+	suite.Assert().Len(due, 2)
+	suite.Assert().Equal(second.ID, due[0].ID) // 予定日の古い順
+}
+
+func (suite *VocabularyTestSuite) TestGetAllVocabularyEntries() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "読売新聞")
+	article, _ := models.CreateArticle(ctx, "政府の方針", 2023, 5, 3, newspaper.ID, nil)
+	models.CreateVocabularyEntry(ctx, "erin", "政府", "", "", &article.ID, nil, jst(2023, 5, 3, 8))
+	models.CreateVocabularyEntry(ctx, "erin", "方針", "ほうしん", "", nil, nil, jst(2023, 5, 3, 8))
+
+	entries, err := models.GetAllVocabularyEntries(ctx, "erin")
+	suite.Assert().Nil(err)
+	suite.Assert().Len(entries, 2)
+	suite.Assert().Equal("読売新聞 2023年5月3日", entries[0].Source())
+	suite.Assert().Equal("", entries[1].Source())
+
+	entries, _ = models.GetVocabularyEntries(ctx, "erin", &article.ID, 10, 0, models.Fields{})
+	suite.Assert().Len(entries, 1)
+	suite.Assert().Equal("せいふ", entries[0].Reading)
+}
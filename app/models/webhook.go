@@ -0,0 +1,443 @@
+package models
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/pkg/logger"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/webhook"
+)
+
+var (
+	// 購読先のURLが http/https の絶対URLでない場合のエラー
+	ErrInvalidWebhookURL = errors.New("webhook url must be an absolute http or https URL")
+	// 購読するイベントが空か、存在しないイベントを含む場合のエラー
+	ErrInvalidWebhookEvent = errors.New("webhook events must be a non-empty list of known events")
+)
+
+const (
+	WebhookMaxAttempts   = 8                // 配信を諦めるまでに送る回数
+	webhookRetryBase     = 30 * time.Second // 1回目の失敗の後に再び送るまでの待ち時間
+	webhookRetryMax      = 6 * time.Hour    // 再び送るまでの待ち時間の上限
+	webhookBatchSize     = 100              // 1回に送る配信の数
+	webhookConcurrency   = 8                // 並行して送る購読先の数
+	maxWebhookErrorBytes = 1024             // 配信の記録に残すエラーの長さの上限
+)
+
+// WebhookSubscription はイベントを通知する購読先
+type WebhookSubscription struct {
+	ID        int
+	URL       string      `gorm:"size:2048"`
+	Events    []EventType `gorm:"serializer:json"` // 通知するイベントの種類
+	Secret    string      `gorm:"size:255"`        // 署名に使う秘密鍵（レスポンスには含めない）
+	Active    bool        // falseの間はイベントを通知しない
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	fields    selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var webhookSubscriptionFields = fieldColumns{
+	"id":        nil,
+	"url":       {"url"},
+	"events":    {"events"},
+	"active":    {"active"},
+	"createdAt": {"created_at"},
+}
+
+func (s *WebhookSubscription) MarshalJSON() ([]byte, error) {
+	events := make([]api.WebhookEvent, len(s.Events))
+	for i, event := range s.Events {
+		events[i] = api.WebhookEvent(event)
+	}
+	return s.fields.marshal(&api.WebhookSubscriptionResponse{
+		Id:        s.ID,
+		Url:       s.URL,
+		Events:    events,
+		Active:    s.Active,
+		CreatedAt: s.CreatedAt,
+	})
+}
+
+// Subscribes はイベントを購読しているかを判定する
+func (s *WebhookSubscription) Subscribes(event EventType) bool {
+	for _, subscribed := range s.Events {
+		if subscribed == event {
+			return true
+		}
+	}
+	return false
+}
+
+// URLとイベントを確認する（作成・更新時に実行される）
+func (s *WebhookSubscription) BeforeSave(tx *gorm.DB) error {
+	parsed, err := url.Parse(s.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return ErrInvalidWebhookURL
+	}
+	if len(s.Events) == 0 {
+		return ErrInvalidWebhookEvent
+	}
+	for _, event := range s.Events {
+		known := false
+		for _, e := range eventTypes {
+			known = known || e == event
+		}
+		if !known {
+			return ErrInvalidWebhookEvent
+		}
+	}
+	return nil
+}
+
+// 購読先の削除時に配信の記録もまとめて削除する
+func (s *WebhookSubscription) AfterDelete(tx *gorm.DB) error {
+	return tx.Where("subscription_id = ?", s.ID).Delete(&WebhookDelivery{}).Error
+}
+
+func CreateWebhookSubscription(ctx context.Context, url string, events []EventType, secret string) (*WebhookSubscription, error) {
+	subscription := &WebhookSubscription{URL: url, Events: events, Secret: secret, Active: true}
+	if err := conn(ctx).Create(subscription).Error; err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func GetWebhookSubscription(ctx context.Context, ID int) (*WebhookSubscription, error) {
+	return GetWebhookSubscriptionWithFields(ctx, ID, Fields{})
+}
+
+// 指定したフィールドに必要な列だけを読み込んで購読先を取得する（レスポンスを返すためだけに使い、保存しない）
+func GetWebhookSubscriptionWithFields(ctx context.Context, ID int, fields Fields) (*WebhookSubscription, error) {
+	selected, err := webhookSubscriptionFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	subscription := &WebhookSubscription{fields: selected}
+	if err := conn(ctx).Scopes(webhookSubscriptionFields.scope("webhook_subscriptions", selected)).First(subscription, ID).Error; err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+// 購読先をID順に、指定したフィールドに必要な列だけを読み込んで取得する
+func GetWebhookSubscriptions(ctx context.Context, fields Fields) ([]*WebhookSubscription, error) {
+	selected, err := webhookSubscriptionFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := []*WebhookSubscription{}
+	if err := conn(ctx).Scopes(webhookSubscriptionFields.scope("webhook_subscriptions", selected)).Order("id").Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	for _, subscription := range subscriptions {
+		subscription.fields = selected
+	}
+	return subscriptions, nil
+}
+
+func (s *WebhookSubscription) Save(ctx context.Context) error {
+	return conn(ctx).Save(s).Error
+}
+
+func (s *WebhookSubscription) Delete(ctx context.Context) error {
+	return conn(ctx).Where("id = ?", s.ID).Delete(s).Error
+}
+
+// WebhookDeliveryStatus は配信の状態
+type WebhookDeliveryStatus string
+
+const (
+	DeliveryPending   WebhookDeliveryStatus = "pending"   // 送る予定（失敗して再び送るのを待っている場合を含む）
+	DeliverySucceeded WebhookDeliveryStatus = "succeeded" // 購読先が 2xx を返した
+	DeliveryFailed    WebhookDeliveryStatus = "failed"    // 送るのを諦めた
+)
+
+// WebhookDelivery は購読先にイベントを1回通知する配信と、その結果の記録
+type WebhookDelivery struct {
+	ID             int
+	SubscriptionID int                   `gorm:"index"`
+	EventID        int                   `gorm:"index"` // 配信の元になったアウトボックスのイベント
+	Event          EventType             `gorm:"size:64"`
+	Payload        string                // 送るJSON（再配信でも同じ内容を送る）
+	Status         WebhookDeliveryStatus `gorm:"size:16;index:idx_webhook_deliveries_due,priority:1"`
+	Attempts       int                   // 送った回数
+	ResponseStatus *int                  // 最後に送ったときのレスポンスのステータスコード（接続できなかった場合はnil）
+	LastError      string                // 最後に送ったときのエラー
+	NextAttemptAt  *time.Time            `gorm:"index:idx_webhook_deliveries_due,priority:2"` // 次に送る時刻（送る予定がない場合はnil）
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	fields         selection // レスポンスに含めるフィールド（nilの場合は全て）
+}
+
+// レスポンスのフィールドと、そのフィールドに必要な列
+var webhookDeliveryFields = fieldColumns{
+	"id":             nil,
+	"subscriptionID": {"subscription_id"},
+	"event":          {"event"},
+	"payload":        {"payload"},
+	"status":         {"status"},
+	"attempts":       {"attempts"},
+	"responseStatus": {"response_status"},
+	"lastError":      {"last_error"},
+	"nextAttemptAt":  {"next_attempt_at"},
+	"deliveredAt":    {"delivered_at"},
+	"createdAt":      {"created_at"},
+}
+
+func (d *WebhookDelivery) MarshalJSON() ([]byte, error) {
+	return d.fields.marshal(&api.WebhookDeliveryResponse{
+		Id:             d.ID,
+		SubscriptionID: d.SubscriptionID,
+		Event:          api.WebhookEvent(d.Event),
+		Payload:        json.RawMessage(d.Payload),
+		Status:         api.WebhookDeliveryResponseStatus(d.Status),
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		DeliveredAt:    d.DeliveredAt,
+		CreatedAt:      d.CreatedAt,
+	})
+}
+
+// 購読先に送るJSON
+type webhookPayload struct {
+	ID         int             `json:"id"` // アウトボックスのイベントのID（再配信でも同じ）
+	Event      EventType       `json:"event"`
+	OccurredAt time.Time       `json:"occurredAt"`
+	Data       json.RawMessage `json:"data"` // 作成・更新ではレスポンスと同じ形式のリソース、削除では {"id": ...}
+}
+
+// WebhookSink はアウトボックスのイベントを、購読している有効な購読先への配信にする配信先（outbox.Sink の実装）
+// 配信は RunWebhookDeliveries が送る。同じイベントを何度届けられても購読先ごとの配信は1つしか作らない
+type WebhookSink struct{}
+
+func (WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (WebhookSink) Publish(ctx context.Context, event outbox.Event) error {
+	return conn(ctx).Transaction(func(tx *gorm.DB) error {
+		subscriptions := []*WebhookSubscription{}
+		if err := tx.Where("active = ?", true).Find(&subscriptions).Error; err != nil {
+			return err
+		}
+		var delivered []int
+		if err := tx.Model(&WebhookDelivery{}).Where("event_id = ?", event.ID).
+			Pluck("subscription_id", &delivered).Error; err != nil {
+			return err
+		}
+		done := make(map[int]bool, len(delivered))
+		for _, subscriptionID := range delivered {
+			done[subscriptionID] = true
+		}
+
+		payload, err := json.Marshal(webhookPayload{ID: event.ID, Event: EventType(event.Type), OccurredAt: event.OccurredAt, Data: event.Payload})
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		var deliveries []*WebhookDelivery
+		for _, subscription := range subscriptions {
+			if done[subscription.ID] || !subscription.Subscribes(EventType(event.Type)) {
+				continue
+			}
+			deliveries = append(deliveries, &WebhookDelivery{
+				SubscriptionID: subscription.ID,
+				EventID:        event.ID,
+				Event:          EventType(event.Type),
+				Payload:        string(payload),
+				Status:         DeliveryPending,
+				NextAttemptAt:  &now,
+			})
+		}
+		if len(deliveries) == 0 {
+			return nil
+		}
+		return tx.Create(&deliveries).Error
+	})
+}
+
+// 購読先の配信の記録を取得する
+func GetWebhookDelivery(ctx context.Context, subscriptionID int, ID int) (*WebhookDelivery, error) {
+	delivery := &WebhookDelivery{}
+	if err := conn(ctx).Where("id = ? AND subscription_id = ?", ID, subscriptionID).First(delivery).Error; err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// 購読先の配信の記録を新しい順に、指定したフィールドに必要な列だけを読み込んで取得する
+func GetWebhookDeliveries(ctx context.Context, subscriptionID int, limit int, offset int, fields Fields) ([]*WebhookDelivery, error) {
+	selected, err := webhookDeliveryFields.selection(fields)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := []*WebhookDelivery{}
+	if err := conn(ctx).Scopes(webhookDeliveryFields.scope("webhook_deliveries", selected)).
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC").Limit(limit).Offset(offset).Find(&deliveries).Error; err != nil {
+		return nil, err
+	}
+	for _, delivery := range deliveries {
+		delivery.fields = selected
+	}
+	return deliveries, nil
+}
+
+// Redeliver は同じ内容を送る新しい配信を作る（元の配信の記録はそのまま残す）
+func (d *WebhookDelivery) Redeliver(ctx context.Context, at time.Time) (*WebhookDelivery, error) {
+	delivery := &WebhookDelivery{
+		SubscriptionID: d.SubscriptionID,
+		EventID:        d.EventID,
+		Event:          d.Event,
+		Payload:        d.Payload,
+		Status:         DeliveryPending,
+		NextAttemptAt:  &at,
+	}
+	if err := conn(ctx).Create(delivery).Error; err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// 購読先に1回送り、結果に応じて次に送る時刻を決める
+func (d *WebhookDelivery) attempt(ctx context.Context, sender *webhook.Sender, subscription *WebhookSubscription, at time.Time) {
+	d.Attempts++
+	d.ResponseStatus = nil
+	var err error
+	if subscription == nil || !subscription.Active {
+		err = errors.New("subscription is inactive")
+	} else {
+		var status int
+		status, err = sender.Send(ctx, webhook.Message{
+			URL:        subscription.URL,
+			Secret:     subscription.Secret,
+			Event:      string(d.Event),
+			DeliveryID: d.ID,
+			Body:       []byte(d.Payload),
+		})
+		if status != 0 {
+			d.ResponseStatus = &status
+		}
+	}
+
+	if err == nil {
+		d.Status = DeliverySucceeded
+		d.LastError = ""
+		d.NextAttemptAt = nil
+		d.DeliveredAt = &at
+		return
+	}
+	d.LastError = err.Error()
+	if len(d.LastError) > maxWebhookErrorBytes {
+		d.LastError = d.LastError[:maxWebhookErrorBytes]
+	}
+	if d.Attempts >= WebhookMaxAttempts || subscription == nil || !subscription.Active {
+		d.Status = DeliveryFailed
+		d.NextAttemptAt = nil
+		return
+	}
+	next := at.Add(webhook.Backoff(d.Attempts, webhookRetryBase, webhookRetryMax))
+	d.NextAttemptAt = &next
+}
+
+// DeliverWebhooks は送る時刻になった配信を送り、送った配信の数を返す
+// 失敗した配信は待ち時間を倍にしながら再び送り、WebhookMaxAttempts 回失敗すると諦める
+// 送ってから結果を保存するまでにプロセスが終了すると同じ配信をもう一度送るため（at-least-once）、
+// 受け取る側は X-Webhook-Delivery ヘッダーで重複を除くこと
+func DeliverWebhooks(ctx context.Context, sender *webhook.Sender, at time.Time) (int, error) {
+	deliveries := []*WebhookDelivery{}
+	if err := conn(ctx).Where("status = ? AND next_attempt_at <= ?", DeliveryPending, at).
+		Order("next_attempt_at, id").Limit(webhookBatchSize).Find(&deliveries).Error; err != nil {
+		return 0, err
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	subscriptionIDs := make([]int, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		subscriptionIDs = append(subscriptionIDs, delivery.SubscriptionID)
+	}
+	subscriptions := []*WebhookSubscription{}
+	if err := conn(ctx).Find(&subscriptions, subscriptionIDs).Error; err != nil {
+		return 0, err
+	}
+	byID := make(map[int]*WebhookSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		byID[subscription.ID] = subscription
+	}
+
+	// 応答しない購読先が他の購読先への配信を遅らせないよう、購読先ごとに並行して送る
+	// 同じ購読先への配信は順に送る
+	groups := map[int][]*WebhookDelivery{}
+	for _, delivery := range deliveries {
+		groups[delivery.SubscriptionID] = append(groups[delivery.SubscriptionID], delivery)
+	}
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	sent := 0
+	semaphore := make(chan struct{}, webhookConcurrency)
+	for subscriptionID, group := range groups {
+		wg.Add(1)
+		go func(subscription *WebhookSubscription, group []*WebhookDelivery) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			n := deliverToSubscription(ctx, sender, subscription, group, at)
+			mu.Lock()
+			sent += n
+			mu.Unlock()
+		}(byID[subscriptionID], group)
+	}
+	wg.Wait()
+
+	for _, delivery := range deliveries {
+		if err := conn(ctx).Save(delivery).Error; err != nil {
+			return sent, err
+		}
+	}
+	return sent, nil
+}
+
+// 1つの購読先への配信を順に送り、送った配信の数を返す
+// 送るのに失敗した場合は、同じ購読先への残りの配信を送らずに、失敗した配信と同じ時刻まで延ばす
+func deliverToSubscription(ctx context.Context, sender *webhook.Sender, subscription *WebhookSubscription, deliveries []*WebhookDelivery, at time.Time) int {
+	for i, delivery := range deliveries {
+		delivery.attempt(ctx, sender, subscription, at)
+		if delivery.Status == DeliveryPending {
+			for _, rest := range deliveries[i+1:] {
+				rest.NextAttemptAt = delivery.NextAttemptAt
+			}
+			return i + 1
+		}
+	}
+	return len(deliveries)
+}
+
+// RunWebhookDeliveries は ctx がキャンセルされるまで interval ごとに配信を送る
+func RunWebhookDeliveries(ctx context.Context, sender *webhook.Sender, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if _, err := DeliverWebhooks(ctx, sender, time.Now()); err != nil {
+				logger.Error("failed to deliver webhooks", "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
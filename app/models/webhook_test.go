@@ -0,0 +1,223 @@
+package models_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/tester"
+	"go-api-newspaper/pkg/webhook"
+)
+
+type WebhookTestSuite struct {
+	tester.DBSQLiteSuite
+}
+
+func TestWebhookTestSuite(t *testing.T) {
+	suite.Run(t, new(WebhookTestSuite))
+}
+
+// 受け取ったリクエストを記録するテスト用の受信先
+type receiver struct {
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, request *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	body, _ := io.ReadAll(request.Body)
+	r.requests = append(r.requests, request)
+	r.bodies = append(r.bodies, body)
+	w.WriteHeader(r.status)
+}
+
+// アウトボックスのイベントから購読先への配信を作る
+func dispatchWebhooks(ctx context.Context) {
+	outbox.NewDispatcher(models.OutboxStore{}, 100, models.WebhookSink{}).DispatchOnce(ctx)
+}
+
+func (suite *WebhookTestSuite) TestDeliverArticleCreated() {
+	ctx := context.Background()
+	receiver := &receiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription, err := models.CreateWebhookSubscription(ctx, server.URL, []models.EventType{models.EventArticleCreated}, "0123456789abcdef")
+	suite.Assert().Nil(err)
+	newspaper, _ := models.CreateNewspaper(ctx, "朝日新聞")
+	article, _ := models.CreateArticle(ctx, "桜が咲いた。", 2023, 4, 1, newspaper.ID, nil)
+	// 購読していないイベントは通知しない
+	article.Body = "桜が散った。"
+	suite.Assert().Nil(article.Save(ctx))
+	dispatchWebhooks(ctx)
+
+	deliveries, err := models.GetWebhookDeliveries(ctx, subscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(deliveries, 1)
+	suite.Assert().Equal(models.DeliveryPending, deliveries[0].Status)
+
+	now := time.Now()
+	sent, err := models.DeliverWebhooks(ctx, webhook.NewSender(time.Second, true), now)
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(1, sent)
+	suite.Assert().Len(receiver.requests, 1)
+	suite.Assert().Equal("article.created", receiver.requests[0].Header.Get(webhook.EventHeader))
+	suite.Assert().True(webhook.Verify("0123456789abcdef", receiver.bodies[0], receiver.requests[0].Header.Get(webhook.SignatureHeader)))
+	var payload struct {
+		Event string `json:"event"`
+		Data  struct {
+			ID   int    `json:"id"`
+			Body string `json:"body"`
+		} `json:"data"`
+	}
+	suite.Assert().Nil(json.Unmarshal(receiver.bodies[0], &payload))
+	suite.Assert().Equal("article.created", payload.Event)
+	suite.Assert().Equal(article.ID, payload.Data.ID)
+	suite.Assert().Equal("桜が咲いた。", payload.Data.Body)
+
+	delivered, _ := models.GetWebhookDelivery(ctx, subscription.ID, deliveries[0].ID)
+	suite.Assert().Equal(models.DeliverySucceeded, delivered.Status)
+	suite.Assert().Equal(1, delivered.Attempts)
+	suite.Assert().Equal(http.StatusOK, *delivered.ResponseStatus)
+	suite.Assert().NotNil(delivered.DeliveredAt)
+
+	// 送り終えた配信は送らない
+	sent, _ = models.DeliverWebhooks(ctx, webhook.NewSender(time.Second, true), now.Add(time.Hour))
+	suite.Assert().Equal(0, sent)
+
+	// 再配信では同じ内容を別の配信として送る
+	redelivery, err := delivered.Redeliver(ctx, now)
+	suite.Assert().Nil(err)
+	suite.Assert().NotEqual(delivered.ID, redelivery.ID)
+	models.DeliverWebhooks(ctx, webhook.NewSender(time.Second, true), now)
+	suite.Assert().Len(receiver.requests, 2)
+	suite.Assert().Equal(receiver.bodies[0], receiver.bodies[1])
+	suite.Assert().NotEqual(receiver.requests[0].Header.Get(webhook.DeliveryHeader), receiver.requests[1].Header.Get(webhook.DeliveryHeader))
+}
+
+func (suite *WebhookTestSuite) TestRetryWithBackoff() {
+	ctx := context.Background()
+	receiver := &receiver{status: http.StatusServiceUnavailable}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription, _ := models.CreateWebhookSubscription(ctx, server.URL, []models.EventType{models.EventNewspaperDeleted}, "0123456789abcdef")
+	newspaper, _ := models.CreateNewspaper(ctx, "毎日新聞")
+	suite.Assert().Nil(newspaper.Delete(ctx))
+	dispatchWebhooks(ctx)
+
+	sender := webhook.NewSender(time.Second, true)
+	at := time.Now()
+	models.DeliverWebhooks(ctx, sender, at)
+	deliveries, _ := models.GetWebhookDeliveries(ctx, subscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Len(deliveries, 1)
+	delivery := deliveries[0]
+	suite.Assert().Equal(models.DeliveryPending, delivery.Status)
+	suite.Assert().Equal(1, delivery.Attempts)
+	suite.Assert().Equal(http.StatusServiceUnavailable, *delivery.ResponseStatus)
+	suite.Assert().NotEmpty(delivery.LastError)
+	suite.Assert().WithinDuration(at.Add(30*time.Second), *delivery.NextAttemptAt, time.Second)
+
+	// 次に送る時刻までは送らない
+	sent, _ := models.DeliverWebhooks(ctx, sender, at.Add(10*time.Second))
+	suite.Assert().Equal(0, sent)
+
+	// 失敗し続けると待ち時間を倍にしながら送り、上限の回数で諦める
+	for attempt := 2; attempt <= models.WebhookMaxAttempts; attempt++ {
+		delivery, _ = models.GetWebhookDelivery(ctx, subscription.ID, delivery.ID)
+		at = *delivery.NextAttemptAt
+		models.DeliverWebhooks(ctx, sender, at)
+	}
+	delivery, _ = models.GetWebhookDelivery(ctx, subscription.ID, delivery.ID)
+	suite.Assert().Equal(models.DeliveryFailed, delivery.Status)
+	suite.Assert().Equal(models.WebhookMaxAttempts, delivery.Attempts)
+	suite.Assert().Nil(delivery.NextAttemptAt)
+	suite.Assert().Len(receiver.requests, models.WebhookMaxAttempts)
+	var payload struct {
+		Data struct {
+			ID int `json:"id"`
+		} `json:"data"`
+	}
+	suite.Assert().Nil(json.Unmarshal(receiver.bodies[0], &payload))
+	suite.Assert().Equal(newspaper.ID, payload.Data.ID)
+}
+
+func (suite *WebhookTestSuite) TestSubscriptionValidation() {
+	ctx := context.Background()
+	_, err := models.CreateWebhookSubscription(ctx, "ftp://example.com", []models.EventType{models.EventArticleCreated}, "0123456789abcdef")
+	suite.Assert().ErrorIs(err, models.ErrInvalidWebhookURL)
+	_, err = models.CreateWebhookSubscription(ctx, "https://example.com", []models.EventType{"article.read"}, "0123456789abcdef")
+	suite.Assert().ErrorIs(err, models.ErrInvalidWebhookEvent)
+
+	// 無効にした購読先には通知しない
+	subscription, _ := models.CreateWebhookSubscription(ctx, "https://example.com/hook", []models.EventType{models.EventNewspaperCreated}, "0123456789abcdef")
+	subscription.Active = false
+	suite.Assert().Nil(subscription.Save(ctx))
+	models.CreateNewspaper(ctx, "読売新聞")
+	dispatchWebhooks(ctx)
+	deliveries, _ := models.GetWebhookDeliveries(ctx, subscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Empty(deliveries)
+
+	// 購読先を削除すると配信の記録も削除する
+	subscription.Active = true
+	suite.Assert().Nil(subscription.Save(ctx))
+	models.CreateNewspaper(ctx, "日経新聞")
+	dispatchWebhooks(ctx)
+	deliveries, _ = models.GetWebhookDeliveries(ctx, subscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Len(deliveries, 1)
+	suite.Assert().Nil(subscription.Delete(ctx))
+	deliveries, _ = models.GetWebhookDeliveries(ctx, subscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Empty(deliveries)
+}
+
+func (suite *WebhookTestSuite) TestSlowSubscriber() {
+	ctx := context.Background()
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select { // 応答しない購読先
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	defer close(release)
+	fast := &receiver{status: http.StatusOK}
+	fastServer := httptest.NewServer(fast)
+	defer fastServer.Close()
+
+	slowSubscription, _ := models.CreateWebhookSubscription(ctx, slow.URL, []models.EventType{models.EventNewspaperUpdated}, "0123456789abcdef")
+	models.CreateWebhookSubscription(ctx, fastServer.URL, []models.EventType{models.EventNewspaperUpdated}, "0123456789abcdef")
+	newspaper, _ := models.CreateNewspaper(ctx, "産経新聞")
+	for _, title := range []string{"産経新聞（朝刊）", "産経新聞（夕刊）"} {
+		newspaper.Title = title
+		suite.Assert().Nil(newspaper.Save(ctx))
+	}
+	dispatchWebhooks(ctx)
+
+	// 応答しない購読先を待つ間も他の購読先には送り、同じ購読先の残りの配信は送らずに延ばす
+	timeout := 300 * time.Millisecond
+	start := time.Now()
+	sent, err := models.DeliverWebhooks(ctx, webhook.NewSender(timeout, true), time.Now())
+	suite.Assert().Nil(err)
+	suite.Assert().Less(time.Since(start), 2*timeout)
+	suite.Assert().GreaterOrEqual(sent, 3)
+	suite.Assert().Len(fast.requests, 2)
+
+	deliveries, _ := models.GetWebhookDeliveries(ctx, slowSubscription.ID, 10, 0, models.Fields{})
+	suite.Assert().Len(deliveries, 2)
+	attempts := []int{deliveries[0].Attempts, deliveries[1].Attempts}
+	suite.Assert().ElementsMatch([]int{0, 1}, attempts)
+	suite.Assert().Equal(*deliveries[0].NextAttemptAt, *deliveries[1].NextAttemptAt)
+}
@@ -0,0 +1,135 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-api-newspaper/api/newspaperpb"
+	"go-api-newspaper/app/models"
+)
+
+type ArticleServer struct {
+	newspaperpb.UnimplementedArticleServiceServer
+}
+
+var articleOrders = map[newspaperpb.ArticleSort]models.ArticleOrder{
+	newspaperpb.ArticleSort_ARTICLE_SORT_UNSPECIFIED: models.OrderNewest,
+	newspaperpb.ArticleSort_ARTICLE_SORT_NEWEST:      models.OrderNewest,
+	newspaperpb.ArticleSort_ARTICLE_SORT_OLDEST:      models.OrderOldest,
+	newspaperpb.ArticleSort_ARTICLE_SORT_SHORTEST:    models.OrderShortest,
+	newspaperpb.ArticleSort_ARTICLE_SORT_LONGEST:     models.OrderLongest,
+}
+
+func articleMessage(article *models.Article) *newspaperpb.Article {
+	message := &newspaperpb.Article{
+		Id:          int64(article.ID),
+		Body:        article.Body,
+		Year:        int32(article.Year),
+		Month:       int32(article.Month),
+		Day:         int32(article.Day),
+		NewspaperId: int64(article.NewspaperID),
+		Stats: &newspaperpb.ArticleStats{
+			Characters:         int32(article.CharacterCount),
+			Sentences:          int32(article.SentenceCount),
+			Paragraphs:         int32(article.ParagraphCount),
+			KanjiRatio:         article.KanjiRatio,
+			KanaRatio:          article.KanaRatio,
+			ReadingTimeSeconds: int32(article.ReadingSeconds),
+		},
+		Summary: article.Summary,
+	}
+	if article.ColumnID != nil {
+		columnID := int64(*article.ColumnID)
+		message.ColumnId = &columnID
+	}
+	for _, tag := range article.Tags {
+		message.Tags = append(message.Tags, tag.Name)
+	}
+	for _, keyword := range article.Keywords {
+		message.Keywords = append(message.Keywords, keyword.Keyword)
+	}
+	return message
+}
+
+func (s *ArticleServer) CreateArticle(ctx context.Context, req *newspaperpb.CreateArticleRequest) (*newspaperpb.Article, error) {
+	article, err := models.CreateArticle(ctx, req.Body, int(req.Year), int(req.Month), int(req.Day), int(req.NewspaperId), optionalInt(req.ColumnId))
+	if err != nil {
+		return nil, toStatus(ctx, "failed to create article", err, "newspaper_id", req.NewspaperId, "column_id", req.ColumnId)
+	}
+	return articleMessage(article), nil
+}
+
+func (s *ArticleServer) GetArticle(ctx context.Context, req *newspaperpb.GetArticleRequest) (*newspaperpb.Article, error) {
+	article, err := models.GetArticle(ctx, int(req.Id))
+	if err != nil {
+		return nil, toStatus(ctx, "failed to get article", err, "article_id", req.Id)
+	}
+	return articleMessage(article), nil
+}
+
+func (s *ArticleServer) ListArticles(ctx context.Context, req *newspaperpb.ListArticlesRequest) (*newspaperpb.ListArticlesResponse, error) {
+	limit, offset, err := page(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	filter := models.ArticleFilter{
+		NewspaperID: optionalInt(req.NewspaperId),
+		ColumnID:    optionalInt(req.ColumnId),
+		Tags:        req.Tags,
+	}
+	if req.MinCharacters != nil {
+		minCharacters := int(*req.MinCharacters)
+		filter.MinCharacters = &minCharacters
+	}
+	if req.MaxCharacters != nil {
+		maxCharacters := int(*req.MaxCharacters)
+		filter.MaxCharacters = &maxCharacters
+	}
+	articles, err := models.FindArticles(ctx, filter, articleOrders[req.Sort], limit, offset, models.Fields{})
+	if err != nil {
+		return nil, toStatus(ctx, "failed to list articles", err)
+	}
+	res := &newspaperpb.ListArticlesResponse{Articles: make([]*newspaperpb.Article, len(articles))}
+	for i, article := range articles {
+		res.Articles[i] = articleMessage(article)
+	}
+	return res, nil
+}
+
+func (s *ArticleServer) UpdateArticle(ctx context.Context, req *newspaperpb.UpdateArticleRequest) (*newspaperpb.Article, error) {
+	article, err := models.GetArticle(ctx, int(req.Id))
+	if err != nil {
+		return nil, toStatus(ctx, "failed to get article", err, "article_id", req.Id)
+	}
+	if req.Body != nil {
+		article.Body = *req.Body
+	}
+	if req.Year != nil {
+		article.Year = int(*req.Year)
+	}
+	if req.Month != nil {
+		article.Month = int(*req.Month)
+	}
+	if req.Day != nil {
+		article.Day = int(*req.Day)
+	}
+	if req.NewspaperId != nil {
+		article.NewspaperID = int(*req.NewspaperId)
+	}
+	if req.ColumnId != nil {
+		article.ColumnID = optionalInt(req.ColumnId)
+	}
+	if err := article.Save(ctx); err != nil { // 本文が変わっていれば新しいリビジョンが記録される
+		return nil, toStatus(ctx, "failed to update article", err, "article_id", req.Id)
+	}
+	return articleMessage(article), nil
+}
+
+func (s *ArticleServer) DeleteArticle(ctx context.Context, req *newspaperpb.DeleteArticleRequest) (*emptypb.Empty, error) {
+	article := models.Article{ID: int(req.Id)}
+	if err := article.Delete(ctx); err != nil {
+		return nil, toStatus(ctx, "failed to delete article", err, "article_id", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
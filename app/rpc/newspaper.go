@@ -0,0 +1,76 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-api-newspaper/api/newspaperpb"
+	"go-api-newspaper/app/models"
+)
+
+type NewspaperServer struct {
+	newspaperpb.UnimplementedNewspaperServiceServer
+}
+
+func newspaperMessage(newspaper *models.Newspaper) *newspaperpb.Newspaper {
+	return &newspaperpb.Newspaper{
+		Id:         int64(newspaper.ID),
+		Title:      newspaper.Title,
+		ColumnName: newspaper.ColumnName,
+	}
+}
+
+func (s *NewspaperServer) CreateNewspaper(ctx context.Context, req *newspaperpb.CreateNewspaperRequest) (*newspaperpb.Newspaper, error) {
+	newspaper, err := models.CreateNewspaper(ctx, req.Title) // 非推奨の column_name は無視する
+	if err != nil {
+		return nil, toStatus(ctx, "failed to create newspaper", err)
+	}
+	return newspaperMessage(newspaper), nil
+}
+
+func (s *NewspaperServer) GetNewspaper(ctx context.Context, req *newspaperpb.GetNewspaperRequest) (*newspaperpb.Newspaper, error) {
+	newspaper, err := models.GetNewspaper(ctx, int(req.Id))
+	if err != nil {
+		return nil, toStatus(ctx, "failed to get newspaper", err, "newspaper_id", req.Id)
+	}
+	return newspaperMessage(newspaper), nil
+}
+
+func (s *NewspaperServer) ListNewspapers(ctx context.Context, req *newspaperpb.ListNewspapersRequest) (*newspaperpb.ListNewspapersResponse, error) {
+	limit, offset, err := page(req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	newspapers, err := models.GetNewspapers(ctx, limit, offset)
+	if err != nil {
+		return nil, toStatus(ctx, "failed to list newspapers", err)
+	}
+	res := &newspaperpb.ListNewspapersResponse{Newspapers: make([]*newspaperpb.Newspaper, len(newspapers))}
+	for i, newspaper := range newspapers {
+		res.Newspapers[i] = newspaperMessage(newspaper)
+	}
+	return res, nil
+}
+
+func (s *NewspaperServer) UpdateNewspaper(ctx context.Context, req *newspaperpb.UpdateNewspaperRequest) (*newspaperpb.Newspaper, error) {
+	newspaper, err := models.GetNewspaper(ctx, int(req.Id))
+	if err != nil {
+		return nil, toStatus(ctx, "failed to get newspaper", err, "newspaper_id", req.Id)
+	}
+	if req.Title != nil {
+		newspaper.Title = *req.Title
+	}
+	if err := newspaper.Save(ctx); err != nil {
+		return nil, toStatus(ctx, "failed to update newspaper", err, "newspaper_id", req.Id)
+	}
+	return newspaperMessage(newspaper), nil
+}
+
+func (s *NewspaperServer) DeleteNewspaper(ctx context.Context, req *newspaperpb.DeleteNewspaperRequest) (*emptypb.Empty, error) {
+	newspaper := models.Newspaper{ID: int(req.Id)}
+	if err := newspaper.Delete(ctx); err != nil {
+		return nil, toStatus(ctx, "failed to delete newspaper", err, "newspaper_id", req.Id)
+	}
+	return &emptypb.Empty{}, nil
+}
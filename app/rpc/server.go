@@ -0,0 +1,106 @@
+// Package rpc は新聞と記事の操作を提供するgRPCのサーバー（api/newspaper.proto の実装）
+//
+// REST APIと同じく app/models の関数をそのまま使う。REST APIと異なり、見つからない場合は NotFound を返す
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api/newspaperpb"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+const (
+	defaultLimit = 20  // limit を省略した場合の件数（REST APIの一覧と同じ）
+	maxLimit     = 100 // limit に指定できる件数の上限
+)
+
+// NewServer は新聞と記事のサービス、ヘルスチェック、リフレクションを登録したgRPCのサーバーを作る
+// ヘルスチェックは全てのサービスを SERVING にした状態で返す（終了時は Shutdown で NOT_SERVING にする）
+func NewServer() (*grpc.Server, *health.Server) {
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(contextInterceptor, recoveryInterceptor))
+	newspaperpb.RegisterNewspaperServiceServer(server, &NewspaperServer{})
+	newspaperpb.RegisterArticleServiceServer(server, &ArticleServer{})
+
+	healthServer := health.NewServer()
+	for _, service := range []string{"", newspaperpb.NewspaperService_ServiceDesc.ServiceName, newspaperpb.ArticleService_ServiceDesc.ServiceName} {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server) // grpcurl などでサービスの定義を取得できるようにする
+	return server, healthServer
+}
+
+// HTTPと同じく x-request-id と x-user-id のメタデータをログに付与する
+func contextInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx = logger.WithRoute(ctx, info.FullMethod)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(logger.RequestIDHeader); len(values) > 0 {
+			ctx = logger.WithRequestID(ctx, values[0])
+		}
+		if values := md.Get(logger.UserIDHeader); len(values) > 0 {
+			ctx = logger.WithUser(ctx, values[0])
+		}
+	}
+	return handler(ctx, req)
+}
+
+// パニックが発生した場合はログに記録して Internal を返す
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.FromContext(ctx).Errorw("panic in grpc handler", "method", info.FullMethod, "panic", r)
+			err = status.Error(codes.Internal, fmt.Sprint(r))
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// モデルのエラーをgRPCのステータスにする
+func toStatus(ctx context.Context, msg string, err error, keysAndValues ...interface{}) error {
+	keysAndValues = append(keysAndValues, "error", err)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrColumnNotInNewspaper):
+		logger.FromContext(ctx).Warnw(msg, keysAndValues...)
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		logger.FromContext(ctx).Errorw(msg, keysAndValues...)
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// 一覧のページを取り出す（limit が0の場合は既定の件数）
+func page(limit int32, offset int32) (int, int, error) {
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit < 1 || limit > maxLimit {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxLimit)
+	}
+	if offset < 0 {
+		return 0, 0, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	return int(limit), int(offset), nil
+}
+
+func optionalInt(value *int64) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
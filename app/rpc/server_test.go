@@ -0,0 +1,152 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"go-api-newspaper/api/newspaperpb"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/tester"
+)
+
+type RPCTestSuite struct {
+	tester.DBSQLiteSuite
+	server     *grpc.Server
+	conn       *grpc.ClientConn
+	newspapers newspaperpb.NewspaperServiceClient
+	articles   newspaperpb.ArticleServiceClient
+}
+
+func TestRPCTestSuite(t *testing.T) {
+	suite.Run(t, new(RPCTestSuite))
+}
+
+// メモリ上の接続でサーバーを起動し、クライアントをつなぐ
+func (suite *RPCTestSuite) SetupSuite() {
+	suite.DBSQLiteSuite.SetupSuite()
+	listener := bufconn.Listen(1024 * 1024)
+	suite.server, _ = NewServer()
+	go suite.server.Serve(listener)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	suite.Require().Nil(err)
+	suite.conn = conn
+	suite.newspapers = newspaperpb.NewNewspaperServiceClient(conn)
+	suite.articles = newspaperpb.NewArticleServiceClient(conn)
+}
+
+func (suite *RPCTestSuite) TearDownSuite() {
+	suite.conn.Close()
+	suite.server.Stop()
+	suite.DBSQLiteSuite.TearDownSuite()
+}
+
+func (suite *RPCTestSuite) TestNewspaper() {
+	ctx := context.Background()
+	// 非推奨の column_name は書き込めず、指定しても無視する
+	created, err := suite.newspapers.CreateNewspaper(ctx, &newspaperpb.CreateNewspaperRequest{Title: "朝日新聞", ColumnName: "天声人語"})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("朝日新聞", created.Title)
+	suite.Assert().Equal("", created.ColumnName)
+
+	got, err := suite.newspapers.GetNewspaper(ctx, &newspaperpb.GetNewspaperRequest{Id: created.Id})
+	suite.Assert().Nil(err)
+	suite.Assert().True(proto.Equal(created, got))
+
+	updated, err := suite.newspapers.UpdateNewspaper(ctx, &newspaperpb.UpdateNewspaperRequest{Id: created.Id, Title: proto.String("朝日新聞社"), ColumnName: proto.String("天声人語")})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("朝日新聞社", updated.Title)
+	suite.Assert().Equal("", updated.ColumnName)
+
+	list, err := suite.newspapers.ListNewspapers(ctx, &newspaperpb.ListNewspapersRequest{Limit: 100})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal(created.Id, list.Newspapers[len(list.Newspapers)-1].Id)
+
+	_, err = suite.newspapers.DeleteNewspaper(ctx, &newspaperpb.DeleteNewspaperRequest{Id: created.Id})
+	suite.Assert().Nil(err)
+	_, err = suite.newspapers.GetNewspaper(ctx, &newspaperpb.GetNewspaperRequest{Id: created.Id})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *RPCTestSuite) TestArticle() {
+	ctx := context.Background()
+	newspaper, _ := models.CreateNewspaper(ctx, "毎日新聞")
+	other, _ := models.CreateNewspaper(ctx, "読売新聞")
+	column, _ := models.CreateColumn(ctx, other.ID, "編集手帳", "henshu-techo-rpc", "", "")
+
+	created, err := suite.articles.CreateArticle(ctx, &newspaperpb.CreateArticleRequest{
+		Body: "桜が咲いた。", Year: 2023, Month: 4, Day: 1, NewspaperId: int64(newspaper.ID),
+	})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("桜が咲いた。", created.Summary)
+	suite.Assert().Equal(int32(6), created.Stats.Characters)
+	suite.Assert().Nil(created.ColumnId)
+
+	// 別の新聞のコラムは指定できない
+	columnID := int64(column.ID)
+	_, err = suite.articles.CreateArticle(ctx, &newspaperpb.CreateArticleRequest{
+		Body: "雨が降った。", Year: 2023, Month: 4, Day: 2, NewspaperId: int64(newspaper.ID), ColumnId: &columnID,
+	})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	updated, err := suite.articles.UpdateArticle(ctx, &newspaperpb.UpdateArticleRequest{Id: created.Id, Body: proto.String("桜が散った。")})
+	suite.Assert().Nil(err)
+	suite.Assert().Equal("桜が散った。", updated.Body)
+	suite.Assert().Equal(int32(4), updated.Month)
+
+	models.CreateArticle(ctx, "新緑がまぶしい季節になった。", 2023, 5, 1, newspaper.ID, nil)
+	newspaperID := int64(newspaper.ID)
+	list, err := suite.articles.ListArticles(ctx, &newspaperpb.ListArticlesRequest{
+		NewspaperId: &newspaperID, Sort: newspaperpb.ArticleSort_ARTICLE_SORT_SHORTEST, Limit: 1,
+	})
+	suite.Assert().Nil(err)
+	suite.Assert().Len(list.Articles, 1)
+	suite.Assert().Equal(created.Id, list.Articles[0].Id)
+
+	_, err = suite.articles.ListArticles(ctx, &newspaperpb.ListArticlesRequest{Limit: 101})
+	suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = suite.articles.DeleteArticle(ctx, &newspaperpb.DeleteArticleRequest{Id: created.Id})
+	suite.Assert().Nil(err)
+	_, err = suite.articles.GetArticle(ctx, &newspaperpb.GetArticleRequest{Id: created.Id})
+	suite.Assert().Equal(codes.NotFound, status.Code(err))
+}
+
+func (suite *RPCTestSuite) TestHealth() {
+	client := healthpb.NewHealthClient(suite.conn)
+	for _, service := range []string{"", "newspaper.v1.NewspaperService", "newspaper.v1.ArticleService"} {
+		res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		suite.Assert().Nil(err)
+		suite.Assert().Equal(healthpb.HealthCheckResponse_SERVING, res.Status)
+	}
+}
+
+func (suite *RPCTestSuite) TestReflection() {
+	stream, err := reflectionpb.NewServerReflectionClient(suite.conn).ServerReflectionInfo(context.Background())
+	suite.Require().Nil(err)
+	suite.Require().Nil(stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}))
+	res, err := stream.Recv()
+	suite.Require().Nil(err)
+	var services []string
+	for _, service := range res.GetListServicesResponse().Service {
+		services = append(services, service.Name)
+	}
+	suite.Assert().Contains(services, "newspaper.v1.NewspaperService")
+	suite.Assert().Contains(services, "newspaper.v1.ArticleService")
+	suite.Assert().Contains(services, "grpc.health.v1.Health")
+}
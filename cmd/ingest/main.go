@@ -0,0 +1,82 @@
+// ingest は設定ファイルに書いた取得元から定期的に記事を取り込むコマンド
+// 既に保存されている記事（同じ新聞・発行日で本文の内容が同じ記事）は保存しない
+//
+//	go run ./cmd/ingest -config sources.json
+//	go run ./cmd/ingest -config sources.json -once
+//
+// 設定ファイルの例:
+//
+//	[{"name": "tensei", "url": "https://example.com/column", "newspaperID": 1, "columnID": 2, "interval": "1h",
+//	  "selectors": {"article": "article", "body": "p", "date": "time", "dateLayout": "2006-01-02"}}]
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go-api-newspaper/api"
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/ingest"
+	"go-api-newspaper/pkg/logger"
+)
+
+// 設定ファイルに書く取得元
+type sourceConfig struct {
+	Name        string               `json:"name"`
+	URL         string               `json:"url"`
+	NewspaperID int                  `json:"newspaperID"`
+	ColumnID    *int                 `json:"columnID"`
+	Interval    string               `json:"interval"` // 取り込む間隔（time.ParseDuration の書式）
+	Selectors   ingest.HTMLSelectors `json:"selectors"`
+}
+
+func main() {
+	configPath := flag.String("config", "sources.json", "path of the JSON file listing the sources")
+	once := flag.Bool("once", false, "ingest every source once and exit")
+	flag.Parse()
+
+	if err := models.SetDatabase(models.InstanceMySQL); err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	data, err := os.ReadFile(*configPath)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	var configs []sourceConfig
+	if err := json.Unmarshal(data, &configs); err != nil {
+		logger.Fatal(err.Error(), "config", *configPath)
+	}
+	schedules := make([]ingest.Schedule, 0, len(configs))
+	for _, config := range configs {
+		interval, err := time.ParseDuration(config.Interval)
+		if err != nil || interval <= 0 {
+			logger.Fatal("invalid interval", "source", config.Name, "interval", config.Interval)
+		}
+		schedules = append(schedules, ingest.Schedule{
+			Source:   ingest.NewHTMLSource(config.Name, config.URL, config.NewspaperID, config.ColumnID, config.Selectors),
+			Interval: interval,
+		})
+	}
+
+	publisher := ingest.PublisherFunc(func(ctx context.Context, article api.ArticleCreateRequest) (bool, error) {
+		_, created, err := models.CreateArticleUnlessDuplicate(ctx, article.Body, article.Year, article.Month, article.Day, article.NewspaperID, article.ColumnID)
+		return created, err
+	})
+	scheduler := ingest.NewScheduler(publisher, schedules...)
+
+	if *once {
+		scheduler.RunOnce(context.Background())
+		return
+	}
+	// SIGINT/SIGTERM を受け取ったら、実行中の取り込みが終わるのを待って終了する
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	scheduler.Run(ctx)
+	logger.Info("ingest stopped")
+}
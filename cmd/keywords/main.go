@@ -0,0 +1,30 @@
+// keywords は既存の全記事のキーワードを抽出し直すコマンド
+// 辞書の更新後や、記事が増えてIDFが大きく変わったときに実行する
+//
+//	go run ./cmd/keywords -batch 100
+package main
+
+import (
+	"context"
+	"flag"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 100, "number of articles loaded at once")
+	limit := flag.Int("limit", models.KeywordLimit, "number of keywords stored per article")
+	flag.Parse()
+
+	if err := models.SetDatabase(models.InstanceMySQL); err != nil {
+		logger.Fatal(err.Error())
+	}
+	models.KeywordLimit = *limit
+
+	processed, err := models.RebuildKeywords(context.Background(), *batchSize)
+	if err != nil {
+		logger.Fatal(err.Error(), "processed", processed)
+	}
+	logger.Info("rebuilt keywords", "articles", processed)
+}
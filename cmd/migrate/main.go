@@ -0,0 +1,45 @@
+// migrate は既存のデータベースのテーブルを現在のモデルに合わせて更新し、データの移行を行うコマンド
+//
+//	go run ./cmd/migrate
+package main
+
+import (
+	"context"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+func main() {
+	if err := models.SetDatabase(models.InstanceMySQL); err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	// 新しいテーブルやカラム（columns テーブル、articles.column_id など）を追加する
+	for _, model := range models.GetModels() {
+		if err := models.DB.AutoMigrate(model); err != nil {
+			logger.Fatal(err.Error())
+		}
+	}
+
+	// 新聞の ColumnName をコラムに分割する
+	created, err := models.SplitNewspaperColumns(context.Background())
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	logger.Info("split newspaper columns", "created_columns", created)
+
+	// 統計を保存するようになる前の記事の本文の統計を計算する
+	processed, err := models.RebuildReadingStats(context.Background(), 100)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	logger.Info("rebuilt reading stats", "articles", processed)
+
+	// 要約を保存するようになる前の記事の要約を作る（SUMMARY_SENTENCES を変えた後も実行する）
+	processed, err = models.RebuildSummaries(context.Background(), 100)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	logger.Info("rebuilt summaries", "articles", processed)
+}
@@ -0,0 +1,43 @@
+// related は関連記事のインデックスの元になる、全記事の語の出現回数を本文から作り直すコマンド
+// -reload にサーバーのURLを指定すると、作り直した後に実行中のサーバーのインデックスも読み込み直させる
+//
+//	go run ./cmd/related -reload http://localhost:8080
+package main
+
+import (
+	"context"
+	"flag"
+	"net/http"
+
+	"go-api-newspaper/app/models"
+	"go-api-newspaper/pkg/logger"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 100, "number of articles loaded at once")
+	reloadURL := flag.String("reload", "", "base URL of the running server whose related index is reloaded (requires ADMIN_ENABLED)")
+	flag.Parse()
+
+	if err := models.SetDatabase(models.InstanceMySQL); err != nil {
+		logger.Fatal(err.Error())
+	}
+
+	processed, err := models.RebuildTerms(context.Background(), *batchSize)
+	if err != nil {
+		logger.Fatal(err.Error(), "processed", processed)
+	}
+	logger.Info("rebuilt article terms", "articles", processed)
+
+	if *reloadURL == "" {
+		return
+	}
+	response, err := http.Post(*reloadURL+"/admin/related-index/reload", "application/json", nil)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		logger.Fatal("failed to reload related index", "status", response.StatusCode)
+	}
+	logger.Info("reloaded related index", "url", *reloadURL)
+}
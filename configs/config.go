@@ -3,6 +3,7 @@ package configs
 import (
 	"os"               // 環境変数の取得に使用。
 	"strconv"          // 文字列を数値に変換するため。
+	"strings"
 
 	"go.uber.org/zap"  //高速で構造化されたロギングライブラリ。
 
@@ -17,16 +18,42 @@ func GetEnvDefault(key, defVal string) string {
 	return val
 }
 
+// カンマ区切りの値を空白を除いて分割する（空の要素は除く）
+func splitList(value string) []string {
+	list := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 // ConfigList 構造体は、アプリケーションの設定を保持します。
 type ConfigList struct {
-	Env                 string
-	DBHost              string
-	DBPort              int
-	DBDriver            string
-	DBName              string
-	DBUser              string
-	DBPassword          string
-	APICorsAllowOrigins []string
+	Env                  string
+	DBHost               string
+	DBPort               int
+	DBDriver             string
+	DBName               string
+	DBUser               string
+	DBPassword           string
+	APICorsAllowOrigins  []string
+	MetricsEnabled       bool     // /metrics エンドポイントを公開するかどうか
+	MetricsPath          string   // メトリクスを公開するパス
+	TracingExporter      string   // トレースの出力先（none / stdout / otlp）
+	ServiceName          string   // トレースに記録するサービス名
+	AdminEnabled         bool     // /admin 配下の管理用エンドポイント（ログレベルの変更など）を公開するかどうか
+	SummarySentences     int      // 記事の要約に含める文の数
+	FeedItems            int      // RSS/Atomフィードに含める記事の数（limit を指定しない場合）
+	PublicURL            string   // フィードのリンクに使う、外部から見たサーバーのURL
+	OutboxSinks          []string // アウトボックスのイベントの配信先（webhook / stdout / file をカンマ区切りで指定）
+	OutboxFile           string   // 配信先に file を指定した場合に追記するファイル
+	EventReplayLimit     int      // イベントのストリームに再接続したときに送り直すイベントの上限
+	GraphQLMaxDepth      int      // GraphQLのクエリで入れ子にできるフィールドの深さの上限
+	GraphQLMaxComplexity int      // GraphQLのクエリの複雑さ（取得しうるフィールドの数の見積もり）の上限
+	GRPCAddr             string   // gRPCのサーバーが待ち受けるアドレス
+	WebhookAllowPrivate  bool     // Webhookを内部のネットワーク（ループバック・プライベートアドレスなど）に送ることを許すかどうか
 }
 
 // 環境が開発用かどうかを判定するメソッド
@@ -42,16 +69,66 @@ func LoadEnv() error { // MYSQL_PORT は数値変換が必要なので、strconv
 	if err != nil {
 		return err
 	}
+	MetricsEnabled, err := strconv.ParseBool(GetEnvDefault("METRICS_ENABLED", "true"))
+	if err != nil {
+		return err
+	}
+	SummarySentences, err := strconv.Atoi(GetEnvDefault("SUMMARY_SENTENCES", "3"))
+	if err != nil {
+		return err
+	}
+	FeedItems, err := strconv.Atoi(GetEnvDefault("FEED_ITEMS", "20"))
+	if err != nil {
+		return err
+	}
+	EventReplayLimit, err := strconv.Atoi(GetEnvDefault("EVENT_REPLAY_LIMIT", "1000"))
+	if err != nil {
+		return err
+	}
+	GraphQLMaxDepth, err := strconv.Atoi(GetEnvDefault("GRAPHQL_MAX_DEPTH", "6"))
+	if err != nil {
+		return err
+	}
+	GraphQLMaxComplexity, err := strconv.Atoi(GetEnvDefault("GRAPHQL_MAX_COMPLEXITY", "5000"))
+	if err != nil {
+		return err
+	}
+	// 購読先のURLは誰でも登録できるため、既定では内部のネットワークには送らない
+	WebhookAllowPrivate, err := strconv.ParseBool(GetEnvDefault("WEBHOOK_ALLOW_PRIVATE", "false"))
+	if err != nil {
+		return err
+	}
+	env := GetEnvDefault("APP_ENV", "development")
+	// 管理用エンドポイントは認証がないため、既定では開発環境でのみ公開する
+	AdminEnabled, err := strconv.ParseBool(GetEnvDefault("ADMIN_ENABLED", strconv.FormatBool(env == "development")))
+	if err != nil {
+		return err
+	}
 
 	Config = ConfigList{
-		Env:                 GetEnvDefault("APP_ENV", "development"),
-		DBDriver:            GetEnvDefault("DB_DRIVER", "mysql"),
-		DBHost:              GetEnvDefault("DB_HOST", "0.0.0.0"),
-		DBPort:              DBPort,
-		DBUser:              GetEnvDefault("DB_USER", "app"),
-		DBPassword:          GetEnvDefault("DB_PASSWORD", "password"),
-		DBName:              GetEnvDefault("DB_NAME", "api_database"),
-		APICorsAllowOrigins: []string{"http://0.0.0.0:8001"},
+		Env:                  env,
+		DBDriver:             GetEnvDefault("DB_DRIVER", "mysql"),
+		DBHost:               GetEnvDefault("DB_HOST", "0.0.0.0"),
+		DBPort:               DBPort,
+		DBUser:               GetEnvDefault("DB_USER", "app"),
+		DBPassword:           GetEnvDefault("DB_PASSWORD", "password"),
+		DBName:               GetEnvDefault("DB_NAME", "api_database"),
+		APICorsAllowOrigins:  []string{"http://0.0.0.0:8001"},
+		MetricsEnabled:       MetricsEnabled,
+		MetricsPath:          GetEnvDefault("METRICS_PATH", "/metrics"),
+		TracingExporter:      GetEnvDefault("OTEL_TRACES_EXPORTER", "none"),
+		ServiceName:          GetEnvDefault("OTEL_SERVICE_NAME", "go-api-newspaper"),
+		AdminEnabled:         AdminEnabled,
+		SummarySentences:     SummarySentences,
+		FeedItems:            FeedItems,
+		PublicURL:            GetEnvDefault("PUBLIC_URL", "http://0.0.0.0:8080"),
+		OutboxSinks:          splitList(GetEnvDefault("OUTBOX_SINKS", "webhook")),
+		OutboxFile:           GetEnvDefault("OUTBOX_FILE", "events.jsonl"),
+		EventReplayLimit:     EventReplayLimit,
+		GraphQLMaxDepth:      GraphQLMaxDepth,
+		GraphQLMaxComplexity: GraphQLMaxComplexity,
+		GRPCAddr:             GetEnvDefault("GRPC_ADDR", "0.0.0.0:9090"),
+		WebhookAllowPrivate:  WebhookAllowPrivate,
 	}
 	return nil
 }
@@ -18,4 +18,9 @@ func TestInitEnv(t *testing.T) {
 	assert.Equal(t, "app", Config.DBUser)
 	assert.Equal(t, "password", Config.DBPassword)
 	assert.Equal(t, true, Config.IsDevelopment())
+	assert.Equal(t, true, Config.MetricsEnabled)
+	assert.Equal(t, "/metrics", Config.MetricsPath)
+	assert.Equal(t, "none", Config.TracingExporter)
+	assert.Equal(t, "go-api-newspaper", Config.ServiceName)
+	assert.Equal(t, true, Config.AdminEnabled)
 }
@@ -10,14 +10,183 @@ CREATE TABLE newspapers (
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
 );
 
+CREATE TABLE `columns` (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    newspaper_id INT,
+    name VARCHAR(255),
+    slug VARCHAR(191),
+    description TEXT,
+    schedule VARCHAR(32) DEFAULT 'daily',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_columns_newspaper_slug (newspaper_id, slug),
+    FOREIGN KEY (newspaper_id) REFERENCES newspapers(id)
+);
+
 CREATE TABLE articles (
     id INT PRIMARY KEY AUTO_INCREMENT,
     body TEXT,
     newspaper_id INT,
+    column_id INT NULL,
     year INT,
     month INT,
     day INT,
+    character_count INT DEFAULT 0,
+    sentence_count INT DEFAULT 0,
+    paragraph_count INT DEFAULT 0,
+    kanji_ratio DOUBLE DEFAULT 0,
+    kana_ratio DOUBLE DEFAULT 0,
+    reading_seconds INT DEFAULT 0,
+    summary TEXT,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
-    FOREIGN KEY (newspaper_id) REFERENCES newspapers(id)
-);
\ No newline at end of file
+    INDEX idx_articles_column_id (column_id),
+    INDEX idx_articles_character_count (character_count),
+    FOREIGN KEY (newspaper_id) REFERENCES newspapers(id),
+    FOREIGN KEY (column_id) REFERENCES `columns`(id)
+);
+
+CREATE TABLE article_revisions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    article_id INT,
+    revision INT,
+    body TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_article_revisions_article_id (article_id),
+    UNIQUE INDEX idx_article_revisions_article_revision (article_id, revision),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+
+CREATE TABLE tags (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    name VARCHAR(191),
+    UNIQUE INDEX idx_tags_name (name)
+);
+
+CREATE TABLE article_tags (
+    article_id INT,
+    tag_id INT,
+    PRIMARY KEY (article_id, tag_id),
+    INDEX idx_article_tags_tag_id (tag_id),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE TABLE article_terms (
+    article_id INT,
+    term VARCHAR(191),
+    count INT,
+    PRIMARY KEY (article_id, term),
+    INDEX idx_article_terms_term (term),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE TABLE article_keywords (
+    article_id INT,
+    position INT,
+    keyword VARCHAR(191),
+    score DOUBLE,
+    PRIMARY KEY (article_id, position),
+    INDEX idx_article_keywords_keyword (keyword),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE TABLE article_annotations (
+    article_id INT,
+    revision INT,
+    segments LONGTEXT,
+    PRIMARY KEY (article_id, revision),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE TABLE practice_sessions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id VARCHAR(128),
+    article_id INT,
+    text TEXT,
+    correct INT,
+    missing INT,
+    extra INT,
+    substituted INT,
+    accuracy DOUBLE,
+    errors TEXT,
+    duration_seconds INT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_practice_sessions_user_created (user_id, created_at),
+    INDEX idx_practice_sessions_article_id (article_id),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE TABLE user_activities (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id VARCHAR(128),
+    article_id INT,
+    kind VARCHAR(16),
+    activity_date VARCHAR(10),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_user_activities_unique (user_id, article_id, kind, activity_date),
+    INDEX idx_user_activities_article_id (article_id),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE CASCADE
+);
+
+CREATE TABLE vocabulary_entries (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    user_id VARCHAR(128),
+    word VARCHAR(191),
+    reading TEXT,
+    meaning TEXT,
+    article_id INT NULL,
+    char_offset INT NULL,
+    ease_factor DOUBLE,
+    interval_days INT,
+    repetitions INT,
+    due_date VARCHAR(10),
+    last_reviewed_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_vocabulary_entries_user_due (user_id, due_date),
+    INDEX idx_vocabulary_entries_article_id (article_id),
+    FOREIGN KEY (article_id) REFERENCES articles(id) ON DELETE SET NULL
+);
+
+CREATE TABLE outbox_events (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    sequence INT NULL,
+    type VARCHAR(64),
+    payload LONGTEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP NULL,
+    INDEX idx_outbox_events_sent_at (sent_at),
+    UNIQUE INDEX idx_outbox_events_sequence (sequence)
+);
+
+CREATE TABLE webhook_subscriptions (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    url VARCHAR(2048),
+    events TEXT,
+    secret VARCHAR(255),
+    active BOOLEAN DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+CREATE TABLE webhook_deliveries (
+    id INT PRIMARY KEY AUTO_INCREMENT,
+    subscription_id INT,
+    event_id INT,
+    event VARCHAR(64),
+    payload LONGTEXT,
+    status VARCHAR(16),
+    attempts INT DEFAULT 0,
+    response_status INT NULL,
+    last_error TEXT,
+    next_attempt_at TIMESTAMP NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_webhook_deliveries_subscription_id (subscription_id),
+    INDEX idx_webhook_deliveries_event_id (event_id),
+    INDEX idx_webhook_deliveries_due (status, next_attempt_at),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(id) ON DELETE CASCADE
+);
@@ -6,13 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"go-api-newspaper/pkg/logger"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-contrib/timeout"
 	ginzap "github.com/gin-contrib/zap"
@@ -21,26 +25,37 @@ import (
 	swaggerfiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"github.com/swaggo/swag"
+	"go.uber.org/zap/zapcore"
 
 	"go-api-newspaper/api"
 	"go-api-newspaper/app/controllers"
 	"go-api-newspaper/app/models"
+	"go-api-newspaper/app/rpc"
 	"go-api-newspaper/configs"
+	"go-api-newspaper/pkg/metrics"
+	"go-api-newspaper/pkg/outbox"
+	"go-api-newspaper/pkg/tracing"
+	"go-api-newspaper/pkg/webhook"
 )
 
+// 終了時に処理中のリクエストやスパンの出力を待つ時間の上限（HTTP・gRPC・トレースのそれぞれ）
+const shutdownTimeout = 2 * time.Second
+
 func corsMiddleware(allowOrigins []string) gin.HandlerFunc {
 	config := cors.DefaultConfig()
 	config.AllowOrigins = allowOrigins
 	return cors.New(config)
 }
 
-func timeoutMiddleware(duration time.Duration) gin.HandlerFunc {
-	return timeout.New(
+// skipPaths のルートはタイムアウトさせない（イベントのストリームのように接続を保ち続けるもの）
+func timeoutMiddleware(duration time.Duration, skipPaths ...string) gin.HandlerFunc {
+	withTimeout := timeout.New(
 		timeout.WithTimeout(duration),
 		timeout.WithHandler(func(c *gin.Context) {
 			c.Next()
 		}),
 		timeout.WithResponse(func(c *gin.Context) {
+			metrics.IncTimeout(c) // タイムアウトした回数を記録
 			c.JSON(
 				http.StatusRequestTimeout,
 				api.ErrorResponse{Message: "timeout"},
@@ -48,24 +63,100 @@ func timeoutMiddleware(duration time.Duration) gin.HandlerFunc {
 			c.Abort()
 		}),
 	)
+	return func(c *gin.Context) {
+		for _, path := range skipPaths {
+			if c.FullPath() == path {
+				c.Next()
+				return
+			}
+		}
+		withTimeout(c)
+	}
+}
+
+// 設定した名前の配信先を作る（ファイルの配信先は返した関数で閉じる）
+func outboxSinks(names []string, path string) ([]outbox.Sink, func(), error) {
+	sinks := []outbox.Sink{}
+	closers := []io.Closer{}
+	closeAll := func() {
+		for _, closer := range closers {
+			closer.Close()
+		}
+	}
+	for _, name := range names {
+		switch name {
+		case "webhook":
+			sinks = append(sinks, models.WebhookSink{})
+		case "stdout":
+			sinks = append(sinks, outbox.NewStdoutSink())
+		case "file":
+			sink, closer, err := outbox.NewFileSink(path)
+			if err != nil {
+				closeAll()
+				return nil, nil, err
+			}
+			sinks = append(sinks, sink)
+			closers = append(closers, closer)
+		default:
+			closeAll()
+			return nil, nil, fmt.Errorf("unknown outbox sink: %s", name)
+		}
+	}
+	return sinks, closeAll, nil
 }
 
 func main() {
 	if err := models.SetDatabase(models.InstanceMySQL); err != nil {
 		logger.Fatal(err.Error())
 	}
+	// トレースの出力先を設定（OTEL_TRACES_EXPORTER で切り替え）
+	shutdownTracing, err := tracing.Setup(context.Background(), configs.Config.TracingExporter, configs.Config.ServiceName)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	if err := models.DB.Use(&tracing.GormPlugin{}); err != nil { // クエリごとにスパンを作成
+		logger.Fatal(err.Error())
+	}
+	if configs.Config.MetricsEnabled {
+		// クエリ時間とコネクションプールの統計情報をメトリクスに登録
+		if err := metrics.RegisterDB(models.DB, configs.Config.DBName); err != nil {
+			logger.Fatal(err.Error())
+		}
+	}
+
+	// 関連記事のインデックスを保存済みの語の出現回数から作る（以降は記事の更新のたびに反映される）
+	if articles, err := models.LoadRelatedIndex(context.Background()); err != nil {
+		logger.Fatal(err.Error())
+	} else {
+		logger.Info("loaded related index", "articles", articles)
+	}
 
 	router := gin.Default() // HTTPリクエストを振り分けるためのルーター
 
-	router.Use(ginzap.Ginzap(logger.ZapLogger, time.RFC3339, true)) // リクエストやレスポンスをログに出力するための関数
+	router.Use(tracing.Middleware(configs.Config.ServiceName)) // リクエストのスパンを作成（traceparent ヘッダーを引き継ぐ）
+	router.Use(logger.RequestIDMiddleware())                    // X-Request-ID を引き継ぐか発行し、ログに付与する
+	router.Use(logger.UserMiddleware())                         // X-User-ID のユーザーIDをログに付与する
+	router.Use(ginzap.GinzapWithConfig(logger.ZapLogger, &ginzap.Config{ // リクエストやレスポンスをログに出力するための関数
+		TimeFormat:   time.RFC3339,
+		UTC:          true,
+		DefaultLevel: zapcore.InfoLevel,
+		Context: func(c *gin.Context) []zapcore.Field { // ログにリクエストIDやトレースIDを付与
+			return logger.Fields(c.Request.Context())
+		},
+	}))
 	router.Use(ginzap.RecoveryWithZap(logger.ZapLogger, true))      // パニックが発生したときにログにエラーとスタックトレースを記録するための関数
 	router.Use(corsMiddleware(configs.Config.APICorsAllowOrigins))
+	if configs.Config.MetricsEnabled {
+		router.Use(metrics.Middleware()) // リクエスト数とレイテンシを operationId ごとに計測
+	}
 
 	// OpenAPI仕様を取得（API仕様のバリデーション用）
 	swagger, err := api.GetSwagger()
 	if err != nil {
 		panic(err)
 	}
+	// JSON Merge Patch のリクエストボディも JSON としてバリデーションする（JSON Patch は標準で登録済み）
+	openapi3filter.RegisterBodyDecoder("application/merge-patch+json", openapi3filter.JSONBodyDecoder)
 
 	// 開発環境の場合、Swagger UIを有効化
 	if configs.Config.IsDevelopment() {
@@ -79,16 +170,38 @@ func main() {
 	}
 
 	router.GET("/health", controllers.Health)
-	
+
+	// 新聞と記事を1回のリクエストで関連ごと取得するためのGraphQL API（REST APIと同じモデルを使う）
+	router.POST("/graphql", timeoutMiddleware(2*time.Second), controllers.GraphQL)
+
+	// メトリクスやトレースのラベルに使う operationId をルートと対応付ける
+	metrics.RegisterOperations(swagger, "/api/v1")
+
+	// メトリクスはAPIグループの外に置き、タイムアウトやリクエストバリデーションの対象外にする
+	if configs.Config.MetricsEnabled {
+		router.GET(configs.Config.MetricsPath, metrics.Handler())
+	}
+
+	// 管理用エンドポイント: GET で現在のログレベルを取得し、PUT {"level":"debug"} で実行中に変更する
+	// POST /admin/related-index/reload で関連記事のインデックスを作り直す
+	if configs.Config.AdminEnabled {
+		adminGroup := router.Group("/admin")
+		adminGroup.GET("/log-level", gin.WrapH(logger.Level))
+		adminGroup.PUT("/log-level", gin.WrapH(logger.Level))
+		adminGroup.POST("/related-index/reload", controllers.ReloadRelatedIndex)
+	}
+
 	apiGroup := router.Group("/api")
 	{
-		apiGroup.Use(timeoutMiddleware(2 * time.Second))
+		apiGroup.Use(timeoutMiddleware(2*time.Second, "/api/v1/events/stream"))
 		v1 := apiGroup.Group("/v1")
 		{
 			// OpenAPI仕様に基づくリクエストバリデーションをミドルウェアとして追加
-			v1.Use(middleware.OapiRequestValidator(swagger)) // 変数swaggerのAPI仕様に基づくバリデーション
-			newspaperHandler := &controllers.NewspaperHandler{}
-			api.RegisterHandlers(v1, newspaperHandler) // ルーターに登録
+			v1.Use(tracing.ValidatorMiddleware(middleware.OapiRequestValidator(swagger))) // 変数swaggerのAPI仕様に基づくバリデーション
+			handler := &controllers.Handler{}
+			api.RegisterHandlersWithOptions(v1, handler, api.GinServerOptions{ // ルーターに登録
+				Middlewares: []api.MiddlewareFunc{tracing.HandlerMiddleware}, // ハンドラーごとのスパンを作成
+			})
 		}
 	}
 
@@ -96,6 +209,19 @@ func main() {
 		Addr:    "0.0.0.0:8080",
 		Handler: router,
 	}
+	// 終了時に接続中のイベントのストリーム（SSE）を終える（Shutdown は終わらない接続を待ち続けるため）
+	srv.RegisterOnShutdown(models.EventBroker.Close)
+
+	// アウトボックスのイベントを配信先に届け、Webhookの配信を送る（失敗した配信は間隔を空けて送り直す）
+	sinks, closeSinks, err := outboxSinks(configs.Config.OutboxSinks, configs.Config.OutboxFile)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	defer closeSinks()
+	sinks = append(sinks, models.EventBroker) // イベントのストリームの接続にも届ける
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	go outbox.NewDispatcher(models.OutboxStore{}, 100, sinks...).Run(backgroundCtx, time.Second)
+	go models.RunWebhookDeliveries(backgroundCtx, webhook.NewSender(10*time.Second, configs.Config.WebhookAllowPrivate), 5*time.Second)
 
 	go func() { // ListenAndServe　サーバーを起動しリクエストをまつ
 		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -103,17 +229,56 @@ func main() {
 		}
 	}()
 
+	// HTTPと同じモデルを使うgRPCのサーバーを起動する（ヘルスチェックとリフレクションも提供する）
+	grpcServer, grpcHealth := rpc.NewServer()
+	grpcListener, err := net.Listen("tcp", configs.Config.GRPCAddr)
+	if err != nil {
+		logger.Fatal(err.Error())
+	}
+	go func() {
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			logger.Fatal(err.Error())
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)                      //  os.Signalを受け取るためのチャネルを作成
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM) // シグナルがあればquitチャネルに送信
 	<-quit
 	log.Println("Shutdown Server ...")
+	stopBackground()
 	defer logger.Sync() // ログのバッファをフラッシュする
 
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second) // ２秒のタイムアウトを持つコンテキスト
+	// 新しいリクエストを送らないよう先にgRPCを NOT_SERVING にし、HTTPとgRPCの処理中のリクエストをそれぞれの期限まで並行して待つ
+	grpcHealth.Shutdown()
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			logger.Error(fmt.Sprintf("Server Shutdown: %s", err.Error()))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		stopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(shutdownTimeout): // 期限までに終わらないリクエストは打ち切る
+			grpcServer.Stop()
+		}
+	}()
+	wg.Wait()
+
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
 	defer cancel()
-	if err := srv.Shutdown(ctx); err != nil {
-		logger.Error(fmt.Sprintf("Server Shutdown: %s", err.Error()))
+	if err := shutdownTracing(ctx); err != nil { // 未送信のスパンを出力する
+		logger.Error(fmt.Sprintf("Tracing Shutdown: %s", err.Error()))
 	}
-	<-ctx.Done()
 	logger.Info("Shutdown")
 }
@@ -0,0 +1,53 @@
+// Package anki は単語帳をAnkiで読み込めるCSV形式で書き出す
+//
+// 先頭にAnkiのファイルヘッダー（#separator など）を付けるため、読み込み時に区切り文字や列の対応を指定し直す必要はない
+package anki
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Writer はノート（カードの元になる行）をCSV形式で書き出す
+type Writer struct {
+	out     io.Writer
+	csv     *csv.Writer
+	columns []string
+	header  bool
+}
+
+// NewWriter は列名columnsのノートを書き出すWriterを返す（列名はヘッダーにそのまま書くため , を含めない）
+func NewWriter(w io.Writer, columns []string) *Writer {
+	return &Writer{out: w, csv: csv.NewWriter(w), columns: columns}
+}
+
+// Write はノートを1件書き出す（初回はファイルヘッダーも書き出す）
+func (w *Writer) Write(fields []string) error {
+	if len(fields) != len(w.columns) {
+		return fmt.Errorf("anki: expected %d fields, got %d", len(w.columns), len(fields))
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	return w.csv.Write(fields)
+}
+
+// Flush は書き出していないデータを書き出す（ノートがなくてもファイルヘッダーは書き出す）
+func (w *Writer) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *Writer) writeHeader() error {
+	if w.header {
+		return nil
+	}
+	w.header = true
+	_, err := fmt.Fprintf(w.out, "#separator:Comma\n#html:false\n#columns:%s\n", strings.Join(w.columns, ","))
+	return err
+}
@@ -0,0 +1,28 @@
+package anki
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWrite(t *testing.T) {
+	var sb strings.Builder
+	w := NewWriter(&sb, []string{"Word", "Reading", "Meaning"})
+	assert.Nil(t, w.Write([]string{"曙", "あけぼの", "夜明け"}))
+	assert.Nil(t, w.Write([]string{"趣", "おもむき", "味わい, 風情"})) // 区切り文字を含む値は引用符で囲む
+	assert.NotNil(t, w.Write([]string{"春"}))
+	assert.Nil(t, w.Flush())
+
+	assert.Equal(t, "#separator:Comma\n#html:false\n#columns:Word,Reading,Meaning\n"+
+		"曙,あけぼの,夜明け\n"+
+		"趣,おもむき,\"味わい, 風情\"\n", sb.String())
+}
+
+func TestFlushWithoutNotes(t *testing.T) {
+	var sb strings.Builder
+	w := NewWriter(&sb, []string{"Front", "Back"})
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, "#separator:Comma\n#html:false\n#columns:Front,Back\n", sb.String())
+}
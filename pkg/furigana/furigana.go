@@ -0,0 +1,130 @@
+// Package furigana は文章の漢字に読み（ふりがな）を付ける
+//
+// 読みは tokenizer の組み込み辞書から引くため、辞書にない語の漢字には読みを付けない
+package furigana
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	"go-api-newspaper/pkg/tokenizer"
+)
+
+// Segment は文章を区切った断片で、漢字を含む断片には読みが付く
+// 断片のTextを順につなげると元の文章（空白や改行を含む）に戻る
+type Segment struct {
+	Text    string
+	Reading string // ひらがなの読み（読みを付けない断片は空）
+	Offset  int    // 元の文章における文字（rune）単位の位置
+}
+
+// Annotate は組み込み辞書を使って文章の漢字に読みを付ける
+func Annotate(text string) []Segment {
+	return New(tokenizer.New(tokenizer.DefaultDictionary())).Annotate(text)
+}
+
+// Annotator は形態素解析の結果から読みを付ける
+type Annotator struct {
+	tokenizer *tokenizer.Tokenizer
+}
+
+func New(t *tokenizer.Tokenizer) *Annotator {
+	return &Annotator{tokenizer: t}
+}
+
+// Annotate は文章の漢字に読みを付ける（読みのない断片は隣り合うものをまとめる）
+func (a *Annotator) Annotate(text string) []Segment {
+	runes := []rune(text)
+	segments := []Segment{}
+	add := func(segment Segment) {
+		last := len(segments) - 1
+		if segment.Reading == "" && last >= 0 && segments[last].Reading == "" {
+			segments[last].Text += segment.Text
+			return
+		}
+		segments = append(segments, segment)
+	}
+
+	position := 0
+	for _, token := range a.tokenizer.Tokenize(text) {
+		if token.Start > position { // 形態素解析で取り除かれた空白
+			add(Segment{Text: string(runes[position:token.Start]), Offset: position})
+		}
+		for _, segment := range split(token.Surface, tokenizer.ToHiragana(token.Reading)) {
+			segment.Offset += token.Start
+			add(segment)
+		}
+		position = token.Start + len([]rune(token.Surface))
+	}
+	if position < len(runes) {
+		add(Segment{Text: string(runes[position:]), Offset: position})
+	}
+	return segments
+}
+
+// 語を送り仮名などのかなと漢字の部分に分け、漢字の部分にだけ読みを付ける
+// 例: 「決まる」（きまる）→「決」（き）＋「まる」
+func split(surface string, reading string) []Segment {
+	s, r := []rune(surface), []rune(reading)
+	if reading == "" || !hasKanji(s) {
+		return []Segment{{Text: surface}}
+	}
+
+	// 語の前後でかなが読みと一致する部分を取り除く
+	head := 0
+	for head < len(s) && head < len(r) && isKana(s[head]) && sameKana(s[head], r[head]) {
+		head++
+	}
+	tail := 0
+	for tail < len(s)-head && tail < len(r)-head && isKana(s[len(s)-1-tail]) && sameKana(s[len(s)-1-tail], r[len(r)-1-tail]) {
+		tail++
+	}
+	if head+tail >= len(r) { // 漢字の部分に対応する読みが残らない
+		return []Segment{{Text: surface}}
+	}
+
+	var segments []Segment
+	if head > 0 {
+		segments = append(segments, Segment{Text: string(s[:head])})
+	}
+	segments = append(segments, Segment{Text: string(s[head : len(s)-tail]), Reading: string(r[head : len(r)-tail]), Offset: head})
+	if tail > 0 {
+		segments = append(segments, Segment{Text: string(s[len(s)-tail:]), Offset: len(s) - tail})
+	}
+	return segments
+}
+
+func hasKanji(s []rune) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isKana(r rune) bool {
+	return unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r)
+}
+
+func sameKana(a, b rune) bool {
+	return tokenizer.ToHiragana(string(a)) == tokenizer.ToHiragana(string(b))
+}
+
+// HTML は読みを <ruby> 要素で付けたHTMLを返す（文字列はエスケープする）
+func HTML(segments []Segment) string {
+	var sb strings.Builder
+	for _, segment := range segments {
+		if segment.Reading == "" {
+			sb.WriteString(html.EscapeString(segment.Text))
+			continue
+		}
+		sb.WriteString("<ruby>")
+		sb.WriteString(html.EscapeString(segment.Text))
+		sb.WriteString("<rp>(</rp><rt>")
+		sb.WriteString(html.EscapeString(segment.Reading))
+		sb.WriteString("</rt><rp>)</rp></ruby>")
+	}
+	return sb.String()
+}
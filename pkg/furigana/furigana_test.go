@@ -0,0 +1,63 @@
+package furigana
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotate(t *testing.T) {
+	segments := Annotate("日本の新聞を読んだと思った。")
+	assert.Equal(t, []Segment{
+		{Text: "日本", Reading: "にほん", Offset: 0},
+		{Text: "の", Offset: 2},
+		{Text: "新聞", Reading: "しんぶん", Offset: 3},
+		{Text: "を", Offset: 5},
+		{Text: "読", Reading: "よ", Offset: 6}, // 活用した形は語幹に読みを付ける
+		{Text: "んだと", Offset: 7},
+		{Text: "思", Reading: "おも", Offset: 10},
+		{Text: "った。", Offset: 11}, // 送り仮名には読みを付けない
+	}, segments)
+
+	// 辞書にない語の漢字には読みを付けない
+	assert.Equal(t, []Segment{{Text: "檸檬", Offset: 0}}, Annotate("檸檬"))
+}
+
+func TestAnnotateCompound(t *testing.T) {
+	// 漢字の連続は語ごとに読みを付ける
+	assert.Equal(t,
+		"<ruby>日本<rp>(</rp><rt>にほん</rt><rp>)</rp></ruby><ruby>政府<rp>(</rp><rt>せいふ</rt><rp>)</rp></ruby>は"+
+			"<ruby>選挙<rp>(</rp><rt>せんきょ</rt><rp>)</rp></ruby>の<ruby>結果<rp>(</rp><rt>けっか</rt><rp>)</rp></ruby>を"+
+			"<ruby>発表<rp>(</rp><rt>はっぴょう</rt><rp>)</rp></ruby>した。",
+		HTML(Annotate("日本政府は選挙の結果を発表した。")))
+	assert.Contains(t, Annotate("首相の答弁"), Segment{Text: "答弁", Reading: "とうべん", Offset: 3})
+	assert.Contains(t, Annotate("桜が咲く"), Segment{Text: "咲", Reading: "さ", Offset: 2})
+}
+
+func TestAnnotateKeepsSpaces(t *testing.T) {
+	text := "日本\n　世界 に対して"
+	segments := Annotate(text)
+
+	// 断片をつなげると元の文章に戻る
+	var sb strings.Builder
+	for _, segment := range segments {
+		sb.WriteString(segment.Text)
+	}
+	assert.Equal(t, text, sb.String())
+
+	// 前後のかなを除いた漢字の部分にだけ読みを付ける
+	assert.Contains(t, segments, Segment{Text: "対", Reading: "たい", Offset: 8})
+}
+
+func TestSplit(t *testing.T) {
+	assert.Equal(t, []Segment{{Text: "見", Reading: "み"}, {Text: "る", Offset: 1}}, split("見る", "みる"))
+	assert.Equal(t, []Segment{{Text: "はい"}}, split("はい", "はい"))
+	assert.Equal(t, []Segment{{Text: "人"}}, split("人", "")) // 読みがわからない
+}
+
+func TestHTML(t *testing.T) {
+	assert.Equal(t,
+		"<ruby>日本<rp>(</rp><rt>にほん</rt><rp>)</rp></ruby>&lt;b&gt;",
+		HTML([]Segment{{Text: "日本", Reading: "にほん"}, {Text: "<b>", Offset: 2}}))
+}
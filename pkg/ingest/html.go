@@ -0,0 +1,129 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"go-api-newspaper/api"
+)
+
+const (
+	fetchTimeout    = 30 * time.Second
+	maxDocumentSize = 10 << 20 // 取得する文書の大きさの上限（10MB）
+	userAgent       = "go-api-newspaper-ingest/1.0"
+)
+
+// HTMLSelectors はHTMLから記事を抜き出すためのCSSセレクタ
+type HTMLSelectors struct {
+	Article    string `json:"article"`    // 記事ごとの要素
+	Body       string `json:"body"`       // 記事の要素の中の本文の要素（複数ある場合は段落として改行でつなぐ）
+	Date       string `json:"date"`       // 記事の要素の中の発行日の要素（datetime属性があれば属性の値を使う）
+	DateLayout string `json:"dateLayout"` // 発行日の書式（time.Parse のレイアウト。省略した場合は 2006-01-02）
+}
+
+// HTMLSource はWebページのHTMLから記事を抜き出す取得元
+// 文書はUTF-8であることを前提とする
+type HTMLSource struct {
+	name        string
+	url         string
+	newspaperID int
+	columnID    *int
+	selectors   HTMLSelectors
+	client      *http.Client
+}
+
+// NewHTMLSource は取り込んだ記事を newspaperID の新聞（columnIDがnilでない場合はそのコラム）の記事とする取得元を作る
+func NewHTMLSource(name string, url string, newspaperID int, columnID *int, selectors HTMLSelectors) *HTMLSource {
+	if selectors.DateLayout == "" {
+		selectors.DateLayout = "2006-01-02"
+	}
+	return &HTMLSource{
+		name:        name,
+		url:         url,
+		newspaperID: newspaperID,
+		columnID:    columnID,
+		selectors:   selectors,
+		client:      &http.Client{Timeout: fetchTimeout},
+	}
+}
+
+func (s *HTMLSource) Name() string {
+	return s.name
+}
+
+func (s *HTMLSource) Fetch(ctx context.Context) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("User-Agent", userAgent)
+	response, err := s.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from %s", response.StatusCode, s.url)
+	}
+	// 途中までの文書から記事を取り込まないよう、上限を超える文書はエラーにする
+	data, err := io.ReadAll(io.LimitReader(response.Body, maxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDocumentSize {
+		return nil, fmt.Errorf("document from %s exceeds %d bytes", s.url, maxDocumentSize)
+	}
+	return data, nil
+}
+
+// Parse はHTMLから記事を抜き出す（本文が空の記事は飛ばす）
+// 発行日を読み取れない記事がある場合は、ページの構造が変わった可能性があるためエラーにする
+func (s *HTMLSource) Parse(data []byte) ([]api.ArticleCreateRequest, error) {
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	articles := []api.ArticleCreateRequest{}
+	var parseErr error
+	document.Find(s.selectors.Article).EachWithBreak(func(i int, selection *goquery.Selection) bool {
+		var paragraphs []string
+		selection.Find(s.selectors.Body).Each(func(_ int, paragraph *goquery.Selection) {
+			if text := strings.TrimSpace(paragraph.Text()); text != "" {
+				paragraphs = append(paragraphs, text)
+			}
+		})
+		if len(paragraphs) == 0 {
+			return true
+		}
+
+		dateElement := selection.Find(s.selectors.Date).First()
+		value := strings.TrimSpace(dateElement.AttrOr("datetime", dateElement.Text()))
+		date, err := time.Parse(s.selectors.DateLayout, value)
+		if err != nil {
+			parseErr = fmt.Errorf("article %d: invalid date %q: %w", i, value, err)
+			return false
+		}
+
+		articles = append(articles, api.ArticleCreateRequest{
+			Body:        strings.Join(paragraphs, "\n"),
+			Year:        date.Year(),
+			Month:       int(date.Month()),
+			Day:         date.Day(),
+			NewspaperID: s.newspaperID,
+			ColumnID:    s.columnID,
+		})
+		return true
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	return articles, nil
+}
@@ -0,0 +1,77 @@
+package ingest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-api-newspaper/api"
+)
+
+var columnSelectors = HTMLSelectors{Article: "article.column", Body: ".body p", Date: "time"}
+
+func TestHTMLSourceParse(t *testing.T) {
+	data, err := os.ReadFile("testdata/column.html")
+	assert.Nil(t, err)
+
+	columnID := 3
+	source := NewHTMLSource("column", "", 1, &columnID, columnSelectors)
+	articles, err := source.Parse(data)
+	assert.Nil(t, err)
+	// 本文が空の記事は飛ばす
+	assert.Equal(t, []api.ArticleCreateRequest{
+		{Body: "桜が満開になった。\n週末は花見の人でにぎわう。", Year: 2023, Month: 4, Day: 2, NewspaperID: 1, ColumnID: &columnID},
+		{Body: "新年度が始まった。", Year: 2023, Month: 4, Day: 1, NewspaperID: 1, ColumnID: &columnID},
+	}, articles)
+}
+
+func TestHTMLSourceParseDateLayout(t *testing.T) {
+	source := NewHTMLSource("column", "", 1, nil, HTMLSelectors{
+		Article: "div", Body: "p", Date: "span", DateLayout: "2006年1月2日",
+	})
+	articles, err := source.Parse([]byte(`<div><span>2023年4月1日</span><p>春。</p></div>`))
+	assert.Nil(t, err)
+	assert.Len(t, articles, 1)
+	assert.Equal(t, 2023, articles[0].Year)
+	assert.Equal(t, 4, articles[0].Month)
+	assert.Equal(t, 1, articles[0].Day)
+
+	// 発行日を読み取れない場合はエラーにする
+	_, err = source.Parse([]byte(`<div><span>4月1日</span><p>春。</p></div>`))
+	assert.NotNil(t, err)
+}
+
+func TestHTMLSourceFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/column" && r.URL.Path != "/large" {
+			http.NotFound(w, r)
+			return
+		}
+		assert.Equal(t, userAgent, r.Header.Get("User-Agent"))
+		if r.URL.Path == "/large" {
+			w.Write(bytes.Repeat([]byte(" "), maxDocumentSize+1))
+			return
+		}
+		http.ServeFile(w, r, "testdata/column.html")
+	}))
+	defer server.Close()
+
+	source := NewHTMLSource("column", server.URL+"/column", 1, nil, columnSelectors)
+	data, err := source.Fetch(context.Background())
+	assert.Nil(t, err)
+	articles, err := source.Parse(data)
+	assert.Nil(t, err)
+	assert.Len(t, articles, 2)
+
+	_, err = NewHTMLSource("missing", server.URL+"/missing", 1, nil, columnSelectors).Fetch(context.Background())
+	assert.NotNil(t, err)
+
+	// 上限を超える文書は切り詰めずにエラーにする
+	_, err = NewHTMLSource("large", server.URL+"/large", 1, nil, columnSelectors).Fetch(context.Background())
+	assert.NotNil(t, err)
+}
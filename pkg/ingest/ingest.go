@@ -0,0 +1,74 @@
+// Package ingest は外部の取得元（新聞社のWebページなど）から記事を取り込む
+//
+// 取得元（Source）が文書を取得して記事の作成リクエストに変換し、Publisher が保存する。
+// 同じ記事を何度取り込んでも重複して保存しないことは Publisher が保証するため、取り込みは何度実行してもよい
+package ingest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go-api-newspaper/api"
+)
+
+// Source は記事の取得元
+type Source interface {
+	// Name はログに出す取得元の名前を返す
+	Name() string
+	// Fetch は取得元から文書を取得する
+	Fetch(ctx context.Context) ([]byte, error)
+	// Parse は取得した文書を記事の作成リクエストに変換する
+	Parse(data []byte) ([]api.ArticleCreateRequest, error)
+}
+
+// Publisher は取り込んだ記事を保存する
+type Publisher interface {
+	// Publish は記事を保存し、既に同じ記事が保存されていて保存しなかった場合はfalseを返す
+	Publish(ctx context.Context, article api.ArticleCreateRequest) (bool, error)
+}
+
+// PublisherFunc は関数を Publisher として使うための型
+type PublisherFunc func(ctx context.Context, article api.ArticleCreateRequest) (bool, error)
+
+func (f PublisherFunc) Publish(ctx context.Context, article api.ArticleCreateRequest) (bool, error) {
+	return f(ctx, article)
+}
+
+// Result は1回の取り込みの結果
+type Result struct {
+	Fetched    int // 取得元から読み取った記事の数
+	Created    int // 新しく保存した記事の数
+	Duplicated int // 既に保存されていたため飛ばした記事の数
+	Failed     int // 保存に失敗した記事の数
+}
+
+// Ingest は取得元から記事を取得して保存する
+// 保存に失敗した記事があっても残りの記事は保存し、失敗した全ての記事のエラーをまとめて返す
+func Ingest(ctx context.Context, source Source, publisher Publisher) (Result, error) {
+	var result Result
+	data, err := source.Fetch(ctx)
+	if err != nil {
+		return result, fmt.Errorf("fetch %s: %w", source.Name(), err)
+	}
+	articles, err := source.Parse(data)
+	if err != nil {
+		return result, fmt.Errorf("parse %s: %w", source.Name(), err)
+	}
+	result.Fetched = len(articles)
+
+	var errs []error
+	for _, article := range articles {
+		created, err := publisher.Publish(ctx, article)
+		switch {
+		case err != nil:
+			result.Failed++
+			errs = append(errs, fmt.Errorf("publish %s %d-%d-%d: %w", source.Name(), article.Year, article.Month, article.Day, err))
+		case created:
+			result.Created++
+		default:
+			result.Duplicated++
+		}
+	}
+	return result, errors.Join(errs...)
+}
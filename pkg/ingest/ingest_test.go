@@ -0,0 +1,103 @@
+package ingest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go-api-newspaper/api"
+)
+
+// テスト用の取得元（決まった記事を返し、取得した回数を数える）
+type fakeSource struct {
+	mu       sync.Mutex
+	articles []api.ArticleCreateRequest
+	fetchErr error
+	fetched  int
+}
+
+func (s *fakeSource) Name() string { return "fake" }
+
+func (s *fakeSource) Fetch(ctx context.Context) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetched++
+	return nil, s.fetchErr
+}
+
+func (s *fakeSource) Parse(data []byte) ([]api.ArticleCreateRequest, error) {
+	return s.articles, nil
+}
+
+func (s *fakeSource) fetchCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetched
+}
+
+// 本文で重複を判定するテスト用の保存先
+type memoryPublisher struct {
+	mu     sync.Mutex
+	bodies map[string]bool
+}
+
+func (p *memoryPublisher) Publish(ctx context.Context, article api.ArticleCreateRequest) (bool, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if article.Body == "" {
+		return false, errors.New("empty body")
+	}
+	if p.bodies[article.Body] {
+		return false, nil
+	}
+	p.bodies[article.Body] = true
+	return true, nil
+}
+
+func TestIngest(t *testing.T) {
+	source := &fakeSource{articles: []api.ArticleCreateRequest{
+		{Body: "一つ目", Year: 2023, Month: 4, Day: 1, NewspaperID: 1},
+		{Body: "二つ目", Year: 2023, Month: 4, Day: 2, NewspaperID: 1},
+		{Body: "", Year: 2023, Month: 4, Day: 3, NewspaperID: 1},
+	}}
+	publisher := &memoryPublisher{bodies: map[string]bool{"一つ目": true}}
+
+	// 保存に失敗した記事があっても残りの記事は保存する
+	result, err := Ingest(context.Background(), source, publisher)
+	assert.NotNil(t, err)
+	assert.Equal(t, Result{Fetched: 3, Created: 1, Duplicated: 1, Failed: 1}, result)
+
+	source.articles = source.articles[:2]
+	result, err = Ingest(context.Background(), source, publisher)
+	assert.Nil(t, err)
+	assert.Equal(t, Result{Fetched: 2, Duplicated: 2}, result)
+
+	source.fetchErr = errors.New("fetch error")
+	_, err = Ingest(context.Background(), source, publisher)
+	assert.ErrorIs(t, err, source.fetchErr)
+}
+
+func TestSchedulerRun(t *testing.T) {
+	source := &fakeSource{articles: []api.ArticleCreateRequest{{Body: "記事", Year: 2023, Month: 4, Day: 1, NewspaperID: 1}}}
+	publisher := &memoryPublisher{bodies: map[string]bool{}}
+	scheduler := NewScheduler(publisher, Schedule{Source: source, Interval: 10 * time.Millisecond})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		scheduler.Run(ctx)
+		close(done)
+	}()
+	// 起動直後と間隔ごとに取り込む
+	assert.Eventually(t, func() bool { return source.fetchCount() >= 3 }, time.Second, 5*time.Millisecond)
+	cancel()
+	<-done
+	assert.Len(t, publisher.bodies, 1)
+
+	scheduler.RunOnce(context.Background())
+	assert.GreaterOrEqual(t, source.fetchCount(), 4)
+}
@@ -0,0 +1,69 @@
+package ingest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go-api-newspaper/pkg/logger"
+)
+
+// Schedule は取得元と取り込む間隔
+type Schedule struct {
+	Source   Source
+	Interval time.Duration
+}
+
+// Scheduler は取得元ごとに一定の間隔で記事を取り込む
+type Scheduler struct {
+	publisher Publisher
+	schedules []Schedule
+}
+
+func NewScheduler(publisher Publisher, schedules ...Schedule) *Scheduler {
+	return &Scheduler{publisher: publisher, schedules: schedules}
+}
+
+// RunOnce は全ての取得元から1回ずつ記事を取り込む
+func (s *Scheduler) RunOnce(ctx context.Context) {
+	for _, schedule := range s.schedules {
+		s.ingest(ctx, schedule.Source)
+	}
+}
+
+// Run は起動直後と間隔ごとに各取得元から記事を取り込む
+// 取得元ごとに別のゴルーチンで実行するため、遅い取得元が他の取得元の取り込みを遅らせることはない
+// ctxがキャンセルされると、実行中の取り込みが終わるのを待って戻る
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, schedule := range s.schedules {
+		wg.Add(1)
+		go func(schedule Schedule) {
+			defer wg.Done()
+			s.ingest(ctx, schedule.Source)
+			ticker := time.NewTicker(schedule.Interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					s.ingest(ctx, schedule.Source)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(schedule)
+	}
+	wg.Wait()
+}
+
+// 取り込みの結果をログに出す（失敗しても次の間隔で再び取り込む）
+func (s *Scheduler) ingest(ctx context.Context, source Source) {
+	result, err := Ingest(ctx, source, s.publisher)
+	if err != nil {
+		logger.Error("failed to ingest articles", "source", source.Name(), "fetched", result.Fetched,
+			"created", result.Created, "duplicated", result.Duplicated, "failed", result.Failed, "error", err)
+		return
+	}
+	logger.Info("ingested articles", "source", source.Name(), "fetched", result.Fetched,
+		"created", result.Created, "duplicated", result.Duplicated)
+}
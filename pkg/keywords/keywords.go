@@ -0,0 +1,100 @@
+// Package keywords はTF-IDFで文章のキーワードを抽出する
+package keywords
+
+import (
+	"math"
+	"sort"
+	"unicode"
+	"unicode/utf8"
+
+	"go-api-newspaper/pkg/tokenizer"
+)
+
+// Keyword は抽出したキーワードとそのスコア
+type Keyword struct {
+	Term  string
+	Score float64
+}
+
+// MaxTermLength はキーワードの候補にする語の最大の文字数（語を保存する列の長さ）
+const MaxTermLength = 191
+
+// Terms は文章からキーワードの候補となる名詞を切り出し、語ごとの出現回数を返す
+// 続けて書かれた漢字の名詞は1つの複合語にする（「日本政府」を「日本」と「政府」に分けない）
+// 辞書にない1文字の語は動詞の語幹などを誤って切り出したものが多いため候補にしない
+// MaxTermLength より長い語は保存できないため候補にしない
+func Terms(text string) map[string]int {
+	counts := map[string]int{}
+	tokens := tokenizer.Tokenize(text)
+	for i := 0; i < len(tokens); {
+		j := i + 1
+		for j < len(tokens) && kanjiNoun(tokens[j-1]) && kanjiNoun(tokens[j]) &&
+			tokens[j].Start == tokens[j-1].Start+utf8.RuneCountInString(tokens[j-1].Surface) {
+			j++
+		}
+		compound, content := "", false
+		for _, token := range tokens[i:j] {
+			compound += token.Surface
+			content = content || token.IsContentNoun()
+		}
+		known := j-i == 1 && tokens[i].Known
+		length := utf8.RuneCountInString(compound)
+		if content && (known || length >= 2) && length <= MaxTermLength {
+			counts[compound]++
+		}
+		i = j
+	}
+	return counts
+}
+
+// 複合語の一部になる漢字だけの名詞（非自立名詞・代名詞を含む）かどうか
+func kanjiNoun(token tokenizer.Token) bool {
+	switch token.POS {
+	case tokenizer.Noun, tokenizer.NounDependent, tokenizer.Pronoun:
+	default:
+		return false
+	}
+	for _, r := range token.Surface {
+		if !unicode.Is(unicode.Han, r) && r != '々' {
+			return false
+		}
+	}
+	return true
+}
+
+// IDF は文書数documentsのコーパスでfrequency件の文書に現れる語のIDFを返す
+// 平滑化しているため、全ての文書に現れる語でも0にならない
+func IDF(documents int, frequency int) float64 {
+	return math.Log(float64(1+documents)/float64(1+frequency)) + 1
+}
+
+// TopN は語の出現回数（tf）と、コーパス全体で各語を含む文書の数（df）からTF-IDFを計算し、スコアの高い順にn件返す
+// documentsはコーパスの文書数。dfにない語は対象の文書だけに現れる語として扱う
+func TopN(tf map[string]int, df map[string]int, documents int, n int) []Keyword {
+	total := 0
+	for _, count := range tf {
+		total += count
+	}
+	if total == 0 {
+		return []Keyword{}
+	}
+
+	keywords := make([]Keyword, 0, len(tf))
+	for term, count := range tf {
+		frequency := df[term]
+		if frequency == 0 {
+			frequency = 1
+		}
+		keywords = append(keywords, Keyword{Term: term, Score: float64(count) / float64(total) * IDF(documents, frequency)})
+	}
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Score != keywords[j].Score {
+			return keywords[i].Score > keywords[j].Score
+		}
+		return keywords[i].Term < keywords[j].Term // 同じスコアの場合も結果が変わらないようにする
+	})
+	if len(keywords) > n {
+		keywords = keywords[:n]
+	}
+	return keywords
+}
@@ -0,0 +1,40 @@
+package keywords
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerms(t *testing.T) {
+	terms := Terms("桜が咲いた。今年も花見の季節が来た。花見の名所は人でいっぱいだ。")
+	// 非自立名詞（今年・人）や動詞の語幹は含まない
+	assert.Equal(t, map[string]int{"桜": 1, "花見": 2, "季節": 1, "名所": 1}, terms)
+
+	// 続けて書かれた漢字の名詞は辞書の語に分けずに1つのキーワードにする
+	terms = Terms("日本政府は選挙の結果を発表した。日本人の人間関係")
+	assert.Equal(t, map[string]int{"日本政府": 1, "選挙": 1, "結果": 1, "発表": 1, "日本人": 1, "人間関係": 1}, terms)
+}
+
+func TestTermsTooLong(t *testing.T) {
+	// 保存できる長さを超える語は候補にしない
+	long := strings.Repeat("ア", MaxTermLength+1)
+	kanji := strings.Repeat("檸檬", MaxTermLength)
+	terms := Terms("桜と" + long + "と" + kanji + "と" + strings.Repeat("イ", MaxTermLength))
+	assert.Equal(t, map[string]int{"桜": 1, strings.Repeat("イ", MaxTermLength): 1}, terms)
+}
+
+func TestTopN(t *testing.T) {
+	tf := map[string]int{"花見": 2, "季節": 2, "名所": 1}
+	df := map[string]int{"花見": 1, "季節": 10, "名所": 1}
+
+	keywords := TopN(tf, df, 10, 2)
+	assert.Len(t, keywords, 2)
+	// 同じ出現回数でも、多くの文書に現れる語（季節）のスコアは低くなる
+	assert.Equal(t, "花見", keywords[0].Term)
+	assert.Equal(t, "名所", keywords[1].Term)
+	assert.Greater(t, keywords[0].Score, keywords[1].Score)
+
+	assert.Empty(t, TopN(map[string]int{}, df, 10, 5))
+}
@@ -0,0 +1,79 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace" // トレースIDをログに付与するため
+	"go.uber.org/zap"
+)
+
+type contextKey int
+
+const (
+	requestIDKey contextKey = iota // リクエストID
+	routeKey                       // ginのルートパス（例: /api/v1/newspaper/:id）
+	userKey                        // リクエストを送ったユーザー
+)
+
+// WithRequestID はリクエストIDをコンテキストに格納する
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestID はコンテキストに格納されたリクエストIDを返す（なければ空文字）
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// WithRoute はルートパスをコンテキストに格納する
+func WithRoute(ctx context.Context, route string) context.Context {
+	return context.WithValue(ctx, routeKey, route)
+}
+
+// WithUser はリクエストを送ったユーザーをコンテキストに格納する
+func WithUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, userKey, user)
+}
+
+// User はコンテキストに格納されたユーザーを返す（なければ空文字）
+func User(ctx context.Context) string {
+	user, _ := ctx.Value(userKey).(string)
+	return user
+}
+
+// TraceFields はコンテキストにスパンがあれば、トレースIDとスパンIDをログのフィールドとして返す
+func TraceFields(ctx context.Context) []zap.Field {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil
+	}
+	return []zap.Field{
+		zap.String("trace_id", spanContext.TraceID().String()),
+		zap.String("span_id", spanContext.SpanID().String()),
+	}
+}
+
+// Fields はコンテキストに格納されたリクエストID・ルート・ユーザー・トレースIDをログのフィールドとして返す
+func Fields(ctx context.Context) []zap.Field {
+	var fields []zap.Field
+	if requestID := RequestID(ctx); requestID != "" {
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+	if route, ok := ctx.Value(routeKey).(string); ok && route != "" {
+		fields = append(fields, zap.String("route", route))
+	}
+	if user := User(ctx); user != "" {
+		fields = append(fields, zap.String("user", user))
+	}
+	return append(fields, TraceFields(ctx)...)
+}
+
+// FromContext はコンテキストの情報（リクエストIDやトレースIDなど）を付与したロガーを返す
+func FromContext(ctx context.Context) *zap.SugaredLogger {
+	fields := Fields(ctx)
+	if len(fields) == 0 {
+		return zapSugaredLogger
+	}
+	return zapSugaredLogger.Desugar().With(fields...).Sugar()
+}
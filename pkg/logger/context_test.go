@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// ログの出力先をテスト用のobserverに差し替える
+func observeLogs(t *testing.T) *observer.ObservedLogs {
+	core, logs := observer.New(zap.DebugLevel)
+	original := zapSugaredLogger
+	zapSugaredLogger = zap.New(core).Sugar()
+	t.Cleanup(func() { zapSugaredLogger = original })
+	return logs
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	logs := observeLogs(t)
+
+	router := gin.New()
+	router.Use(RequestIDMiddleware())
+	router.GET("/newspaper/:id", func(c *gin.Context) {
+		ctx := WithUser(c.Request.Context(), "alice")
+		FromContext(ctx).Infow("handled", "newspaper_id", 1)
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	// クライアントが送ったリクエストIDを引き継ぐ
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/newspaper/1", nil)
+	request.Header.Set(RequestIDHeader, "req-123")
+	router.ServeHTTP(w, request)
+	assert.Equal(t, "req-123", w.Header().Get(RequestIDHeader))
+
+	entries := logs.TakeAll()
+	assert.Len(t, entries, 1)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "req-123", fields["request_id"])
+	assert.Equal(t, "/newspaper/:id", fields["route"])
+	assert.Equal(t, "alice", fields["user"])
+	assert.Equal(t, int64(1), fields["newspaper_id"])
+
+	// ヘッダーがない、または不正な場合は新しく発行する
+	for _, header := range []string{"", "contains space", string(make([]byte, 200))} {
+		w = httptest.NewRecorder()
+		request, _ = http.NewRequest("GET", "/newspaper/1", nil)
+		request.Header.Set(RequestIDHeader, header)
+		router.ServeHTTP(w, request)
+		assert.Len(t, w.Header().Get(RequestIDHeader), 32)
+	}
+}
+
+func TestUserMiddleware(t *testing.T) {
+	router := gin.New()
+	router.Use(UserMiddleware())
+	router.GET("/me", func(c *gin.Context) {
+		c.String(http.StatusOK, User(c.Request.Context()))
+	})
+
+	for header, user := range map[string]string{"alice": "alice", "": "", "contains space": ""} {
+		w := httptest.NewRecorder()
+		request, _ := http.NewRequest("GET", "/me", nil)
+		request.Header.Set(UserIDHeader, header)
+		router.ServeHTTP(w, request)
+		assert.Equal(t, user, w.Body.String())
+	}
+}
+
+func TestFromContextWithoutFields(t *testing.T) {
+	logs := observeLogs(t)
+
+	FromContext(context.Background()).Info("no fields")
+	entries := logs.TakeAll()
+	assert.Len(t, entries, 1)
+	assert.Empty(t, entries[0].Context)
+	assert.Equal(t, "", RequestID(context.Background()))
+}
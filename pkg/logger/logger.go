@@ -1,40 +1,107 @@
 package logger
 
 import (
-	"os"              // 環境変数の取得やファイル操作のため
-	"go.uber.org/zap" // Zapロギングライブラリ
+	"os"   // 環境変数の取得やファイル操作のため
+	"time" // 時間によるローテーションとサンプリングの間隔
+
+	"go.uber.org/zap"                  // Zapロギングライブラリ
+	"go.uber.org/zap/zapcore"          // 出力先やレベルを細かく組み立てるため
+	"gopkg.in/natefinch/lumberjack.v2" // ログファイルのローテーション
 )
 
 // グローバル変数: アプリケーション全体で使用するロガーを定義
 var (
-	ZapLogger        *zap.Logger       // 構造化ロガー (型安全性の高いLogger)
-	zapSugaredLogger *zap.SugaredLogger // 柔軟で使いやすいロガー (SugaredLogger)
+	ZapLogger        *zap.Logger            // 構造化ロガー (型安全性の高いLogger)
+	zapSugaredLogger *zap.SugaredLogger     // 柔軟で使いやすいロガー (SugaredLogger)
+	Level            = zap.NewAtomicLevel() // 実行中に変更できるログレベル (http.Handlerとしても使える)
 )
 
 // 初期化関数: パッケージが読み込まれるときに実行される
 func init() {
-	// プロダクション環境用のZap設定を生成
-	cfg := zap.NewProductionConfig()
-
-	// 環境変数からログファイル名を取得
-	logFile := os.Getenv("APP_LOG_FILE")
-	if logFile != "" {
-		// ログ出力先を標準エラー出力と指定されたファイルに設定
-		cfg.OutputPaths = []string{"stderr", logFile}
+	// 環境変数からログレベルや出力先、ローテーションの設定を読み込む
+	options, err := LoadOptions()
+	if err != nil {
+		panic(err)
 	}
+	Level.SetLevel(options.Level)
 
-	// 構造化ロガーを構築 (必須エラー処理でプログラム停止)
-	ZapLogger = zap.Must(cfg.Build())
-
-	// 開発環境では、デフォルトの開発用ロガーに切り替え
-	if os.Getenv("APP_ENV") == "development" {
-		ZapLogger = zap.Must(zap.NewDevelopment()) // 開発用のロガーはより詳細な情報を出力
-	}
+	// 構造化ロガーを構築
+	ZapLogger, _ = New(options, Level)
 
 	// SugaredLoggerを作成して、使いやすさを提供
 	zapSugaredLogger = ZapLogger.Sugar()
 }
 
+// New は設定に従ってロガーを構築する
+// 戻り値の関数は時間によるローテーションを止め、ログファイルを閉じる
+func New(options Options, level zap.AtomicLevel) (*zap.Logger, func()) {
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoder := zapcore.NewJSONEncoder(encoderConfig)
+	stacktraceLevel := zapcore.ErrorLevel
+	if options.Development {
+		// 開発環境では、人が読みやすいコンソール形式で出力
+		encoderConfig = zap.NewDevelopmentEncoderConfig()
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+		stacktraceLevel = zapcore.WarnLevel
+	}
+
+	output := zapcore.Lock(os.Stderr)
+	stop := func() {}
+	if options.File != "" {
+		// ログ出力先を標準エラー出力と指定されたファイルに設定 (ファイルはサイズと時間でローテーション)
+		rotator := &lumberjack.Logger{
+			Filename:   options.File,
+			MaxSize:    options.MaxSizeMB,
+			MaxAge:     options.MaxAgeDays,
+			MaxBackups: options.MaxBackups,
+			Compress:   options.Compress,
+		}
+		output = zapcore.NewMultiWriteSyncer(output, zapcore.AddSync(rotator))
+		stop = rotateEvery(rotator, options.RotateInterval)
+	}
+
+	// Info以下の大量に出るログだけを間引き、Warn以上は必ず出力する
+	lowPriority := zapcore.NewCore(encoder, output, zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+		return level.Enabled(l) && l < zapcore.WarnLevel
+	}))
+	highPriority := zapcore.NewCore(encoder, output, zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+		return level.Enabled(l) && l >= zapcore.WarnLevel
+	}))
+	if options.SamplingInitial > 0 {
+		lowPriority = zapcore.NewSamplerWithOptions(lowPriority, time.Second, options.SamplingInitial, options.SamplingThereafter)
+	}
+
+	logger := zap.New(zapcore.NewTee(lowPriority, highPriority), zap.AddCaller(), zap.AddStacktrace(stacktraceLevel))
+	if options.Development {
+		logger = logger.WithOptions(zap.Development())
+	}
+	return logger, stop
+}
+
+// rotateEvery は一定時間ごとにログファイルをローテーションする
+func rotateEvery(rotator *lumberjack.Logger, interval time.Duration) func() {
+	if interval <= 0 {
+		return func() { rotator.Close() }
+	}
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				rotator.Rotate()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		ticker.Stop()
+		close(done)
+		rotator.Close()
+	}
+}
+
 // ログのフラッシュ (重要: ログをファイルや出力先に書き込む)
 func Sync() {
 	err := zapSugaredLogger.Sync() // メモリに蓄積されたログをすべて出力
@@ -79,4 +146,3 @@ func Panic(msg string, keysAndValues ...interface{}) {
 	// メッセージとキー値ペアでパニックログを出力
 	zapSugaredLogger.Panicw(msg, keysAndValues...)
 }
-
@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestSamplingOnlyInfo(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "app.log")
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	logger, stop := New(Options{File: file, MaxSizeMB: 1, SamplingInitial: 2, SamplingThereafter: 0}, level)
+
+	for i := 0; i < 10; i++ {
+		logger.Info("same info")
+		logger.Warn("same warn")
+	}
+	stop()
+
+	content, err := os.ReadFile(file)
+	assert.Nil(t, err)
+	// Infoは最初の2件だけが出力され、Warnは間引かれない
+	assert.Equal(t, 2, strings.Count(string(content), "same info"))
+	assert.Equal(t, 10, strings.Count(string(content), "same warn"))
+}
+
+func TestRotateByInterval(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.log")
+	logger, stop := New(Options{File: file, MaxSizeMB: 1, RotateInterval: 20 * time.Millisecond}, zap.NewAtomicLevel())
+
+	logger.Info("before rotation")
+	assert.Eventually(t, func() bool {
+		entries, _ := os.ReadDir(dir)
+		return len(entries) >= 2 // 現在のファイルとローテーションされたファイル
+	}, time.Second, 10*time.Millisecond)
+	stop()
+}
+
+func TestChangeLevelAtRuntime(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "app.log")
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	logger, stop := New(Options{File: file, MaxSizeMB: 1}, level)
+	defer stop()
+
+	logger.Debug("hidden debug")
+
+	// 管理用エンドポイントと同じ http.Handler でレベルを変更する
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("PUT", "/admin/log-level", strings.NewReader(`{"level":"debug"}`))
+	level.ServeHTTP(w, request)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, zapcore.DebugLevel, level.Level())
+
+	logger.Debug("visible debug")
+	content, err := os.ReadFile(file)
+	assert.Nil(t, err)
+	assert.False(t, strings.Contains(string(content), "hidden debug"))
+	assert.True(t, strings.Contains(string(content), "visible debug"))
+}
+
+func TestLoadOptions(t *testing.T) {
+	t.Setenv("APP_LOG_LEVEL", "warn")
+	t.Setenv("APP_LOG_ROTATE_INTERVAL", "1h")
+	options, err := LoadOptions()
+	assert.Nil(t, err)
+	assert.Equal(t, zapcore.WarnLevel, options.Level)
+	assert.Equal(t, time.Hour, options.RotateInterval)
+	assert.Equal(t, 100, options.MaxSizeMB)
+
+	t.Setenv("APP_LOG_LEVEL", "verbose")
+	_, err = LoadOptions()
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,67 @@
+package logger
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	RequestIDHeader   = "X-Request-ID"
+	UserIDHeader      = "X-User-ID" // 認証を行うゲートウェイが付与する認証済みのユーザーID
+	maxHeaderIDLength = 128         // クライアントから受け取るリクエストIDやユーザーIDの最大長
+)
+
+// RequestIDMiddleware はリクエストIDとルートをリクエストのコンテキストに格納するミドルウェア
+// X-Request-ID ヘッダーがあればその値を引き継ぎ、なければ新しく発行してレスポンスヘッダーに返す
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(RequestIDHeader)
+		if !validHeaderID(requestID) {
+			requestID = newRequestID()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		ctx := WithRequestID(c.Request.Context(), requestID)
+		ctx = WithRoute(ctx, c.FullPath())
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request_id", requestID))
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// UserMiddleware は X-User-ID ヘッダーのユーザーIDをリクエストのコンテキストに格納し、ログに付与するミドルウェア
+// ヘッダーがない、または不正な場合は何もしない（ユーザーIDが必要なエンドポイントはリクエストバリデーションで弾く）
+func UserMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user := c.GetHeader(UserIDHeader); validHeaderID(user) {
+			c.Request = c.Request.WithContext(WithUser(c.Request.Context(), user))
+		}
+		c.Next()
+	}
+}
+
+// ログに出力しても安全な値（印字可能なASCII文字のみで長すぎないもの）だけを受け付ける
+func validHeaderID(id string) bool {
+	if id == "" || len(id) > maxHeaderIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r < 0x21 || r > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
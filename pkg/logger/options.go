@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Options はロガーの設定を保持する
+// configs パッケージがこのパッケージに依存しているため、設定は環境変数から直接読み込む
+type Options struct {
+	Development        bool          // 開発用の出力形式（コンソール形式、スタックトレースを多めに出力）にするかどうか
+	Level              zapcore.Level // 起動時のログレベル（実行中は Level で変更できる）
+	File               string        // ログファイルのパス（空なら標準エラー出力のみ）
+	MaxSizeMB          int           // ログファイルがこのサイズを超えたらローテーションする
+	MaxAgeDays         int           // ローテーションした古いファイルを保持する日数（0なら無期限）
+	MaxBackups         int           // ローテーションした古いファイルを保持する数（0なら無制限）
+	Compress           bool          // ローテーションした古いファイルをgzipで圧縮するかどうか
+	RotateInterval     time.Duration // サイズに関係なく一定時間ごとにローテーションする間隔（0なら行わない）
+	SamplingInitial    int           // 1秒間に同じメッセージのInfo以下のログをそのまま出力する件数
+	SamplingThereafter int           // 上記を超えた後は、この件数ごとに1件だけ出力する
+}
+
+func getEnvDefault(key, defVal string) string {
+	val, ok := os.LookupEnv(key)
+	if !ok {
+		return defVal
+	}
+	return val
+}
+
+// LoadOptions は環境変数からロガーの設定を読み込む
+func LoadOptions() (Options, error) {
+	development := os.Getenv("APP_ENV") == "development"
+	defaultLevel := "info"
+	if development {
+		defaultLevel = "debug"
+	}
+
+	var options Options
+	var err error
+	options.Development = development
+	options.File = os.Getenv("APP_LOG_FILE")
+	if options.Level, err = zapcore.ParseLevel(getEnvDefault("APP_LOG_LEVEL", defaultLevel)); err != nil {
+		return options, err
+	}
+	if options.MaxSizeMB, err = strconv.Atoi(getEnvDefault("APP_LOG_MAX_SIZE_MB", "100")); err != nil {
+		return options, err
+	}
+	if options.MaxAgeDays, err = strconv.Atoi(getEnvDefault("APP_LOG_MAX_AGE_DAYS", "7")); err != nil {
+		return options, err
+	}
+	if options.MaxBackups, err = strconv.Atoi(getEnvDefault("APP_LOG_MAX_BACKUPS", "5")); err != nil {
+		return options, err
+	}
+	if options.Compress, err = strconv.ParseBool(getEnvDefault("APP_LOG_COMPRESS", "false")); err != nil {
+		return options, err
+	}
+	if options.RotateInterval, err = time.ParseDuration(getEnvDefault("APP_LOG_ROTATE_INTERVAL", "24h")); err != nil {
+		return options, err
+	}
+	if options.SamplingInitial, err = strconv.Atoi(getEnvDefault("APP_LOG_SAMPLING_INITIAL", "100")); err != nil {
+		return options, err
+	}
+	if options.SamplingThereafter, err = strconv.Atoi(getEnvDefault("APP_LOG_SAMPLING_THEREAFTER", "100")); err != nil {
+		return options, err
+	}
+	return options, nil
+}
@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+)
+
+const startTimeKey = "metrics:start_time"
+
+// GormPlugin はGORMの各クエリの実行時間を計測するプラグイン
+type GormPlugin struct{}
+
+func (p *GormPlugin) Name() string {
+	return "metrics"
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	if err := callback.Create().Before("gorm:create").Register("metrics:before_create", before); err != nil {
+		return err
+	}
+	if err := callback.Create().After("gorm:create").Register("metrics:after_create", after("create")); err != nil {
+		return err
+	}
+	if err := callback.Query().Before("gorm:query").Register("metrics:before_query", before); err != nil {
+		return err
+	}
+	if err := callback.Query().After("gorm:query").Register("metrics:after_query", after("query")); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("gorm:update").Register("metrics:before_update", before); err != nil {
+		return err
+	}
+	if err := callback.Update().After("gorm:update").Register("metrics:after_update", after("update")); err != nil {
+		return err
+	}
+	if err := callback.Delete().Before("gorm:delete").Register("metrics:before_delete", before); err != nil {
+		return err
+	}
+	if err := callback.Delete().After("gorm:delete").Register("metrics:after_delete", after("delete")); err != nil {
+		return err
+	}
+	if err := callback.Row().Before("gorm:row").Register("metrics:before_row", before); err != nil {
+		return err
+	}
+	if err := callback.Row().After("gorm:row").Register("metrics:after_row", after("row")); err != nil {
+		return err
+	}
+	if err := callback.Raw().Before("gorm:raw").Register("metrics:before_raw", before); err != nil {
+		return err
+	}
+	return callback.Raw().After("gorm:raw").Register("metrics:after_raw", after("raw"))
+}
+
+func before(db *gorm.DB) {
+	db.InstanceSet(startTimeKey, time.Now())
+}
+
+func after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(startTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		dbQueryDuration.WithLabelValues(operation, db.Statement.Table).Observe(time.Since(start).Seconds())
+	}
+}
+
+// RegisterDB はクエリ時間の計測プラグインとコネクションプールの統計情報をレジストリに登録する
+func RegisterDB(db *gorm.DB, dbName string) error {
+	if err := db.Use(&GormPlugin{}); err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return Registry.Register(collectors.NewDBStatsCollector(sqlDB, dbName))
+}
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const unmatchedOperation = "unmatched" // ルーティングされなかったリクエストに付けるラベル
+
+var (
+	// アプリケーション全体で使用するメトリクスのレジストリ
+	Registry = prometheus.NewRegistry()
+
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total number of HTTP requests by OpenAPI operationId and status.",
+	}, []string{"operation", "method", "status"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by OpenAPI operationId and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation", "method", "status"})
+
+	httpRequestTimeouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_request_timeouts_total",
+		Help: "Total number of requests aborted by the timeout middleware.",
+	}, []string{"operation"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "db_query_duration_seconds",
+		Help:    "Database query latency by GORM operation and table.",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"operation", "table"})
+
+	// "METHOD ginのルートパス" から operationId への対応表
+	operations = map[string]string{}
+
+	pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+)
+
+func init() {
+	Registry.MustRegister(
+		httpRequestsTotal,
+		httpRequestDuration,
+		httpRequestTimeouts,
+		dbQueryDuration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// RegisterOperations はOpenAPI仕様からoperationIdを読み取り、ginのルートと対応付ける
+// basePathにはAPIをマウントしているグループのパス（例: /api/v1）を渡す
+func RegisterOperations(swagger *openapi3.T, basePath string) {
+	for path, pathItem := range swagger.Paths.Map() {
+		ginPath := basePath + pathParamPattern.ReplaceAllString(path, ":$1") // /article/{id} → /article/:id
+		for method, operation := range pathItem.Operations() {
+			operations[method+" "+ginPath] = operation.OperationID
+		}
+	}
+}
+
+// Operation はリクエストに対応するoperationIdを返す
+// OpenAPI仕様にないルート（/health など）はルートパスを、ルーティングされなかったものは unmatched を返す
+func Operation(c *gin.Context) string {
+	fullPath := c.FullPath()
+	if fullPath == "" {
+		return unmatchedOperation
+	}
+	if operationID, ok := operations[c.Request.Method+" "+fullPath]; ok {
+		return operationID
+	}
+	return fullPath
+}
+
+// Middleware はリクエスト数とレイテンシを計測するミドルウェア
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		operation := Operation(c)
+		status := strconv.Itoa(c.Writer.Status())
+		httpRequestsTotal.WithLabelValues(operation, c.Request.Method, status).Inc()
+		httpRequestDuration.WithLabelValues(operation, c.Request.Method, status).Observe(time.Since(start).Seconds())
+	}
+}
+
+// IncTimeout はタイムアウトしたリクエストを記録する
+func IncTimeout(c *gin.Context) {
+	httpRequestTimeouts.WithLabelValues(Operation(c)).Inc()
+}
+
+// Handler はレジストリの内容をPrometheusの形式で公開するハンドラー
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.HandlerFor(Registry, promhttp.HandlerOpts{}))
+}
@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/api"
+)
+
+func TestMiddlewareLabelsByOperationID(t *testing.T) {
+	swagger, err := api.GetSwagger()
+	assert.Nil(t, err)
+	RegisterOperations(swagger, "/api/v1")
+
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/api/v1/newspaper/:id", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{})
+	})
+	router.GET("/metrics", Handler())
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/newspaper/1", nil)
+	router.ServeHTTP(w, request)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(httpRequestsTotal.WithLabelValues("GetNewspaperById", "GET", "200")))
+
+	// ルーティングされないリクエストはパスではなく unmatched として集計する
+	w = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/does/not/exist", nil)
+	router.ServeHTTP(w, request)
+	assert.Equal(t, 1.0, testutil.ToFloat64(httpRequestsTotal.WithLabelValues("unmatched", "GET", "404")))
+
+	w = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/metrics", nil)
+	router.ServeHTTP(w, request)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.Contains(w.Body.String(), `http_requests_total{method="GET",operation="GetNewspaperById",status="200"} 1`))
+	assert.True(t, strings.Contains(w.Body.String(), "go_goroutines"))
+}
+
+func TestIncTimeout(t *testing.T) {
+	router := gin.New()
+	router.GET("/slow", func(c *gin.Context) {
+		IncTimeout(c)
+	})
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/slow", nil)
+	router.ServeHTTP(w, request)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(httpRequestTimeouts.WithLabelValues("/slow")))
+}
+
+func TestGormPlugin(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.Nil(t, err)
+	assert.Nil(t, RegisterDB(db, "metrics_test"))
+
+	type sample struct {
+		ID int
+	}
+	assert.Nil(t, db.AutoMigrate(&sample{}))
+	assert.Nil(t, db.Create(&sample{}).Error)
+	assert.Nil(t, db.First(&sample{}).Error)
+
+	// 操作とテーブルの組み合わせごとに計測されていることを確認
+	families, err := Registry.Gather()
+	assert.Nil(t, err)
+	observed := map[string]uint64{}
+	for _, family := range families {
+		if family.GetName() != "db_query_duration_seconds" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			observed[labels["operation"]+" "+labels["table"]] = metric.GetHistogram().GetSampleCount()
+		}
+	}
+	assert.Equal(t, uint64(1), observed["create samples"])
+	assert.Equal(t, uint64(1), observed["query samples"])
+	assert.Equal(t, 1, testutil.CollectAndCount(Registry, "go_sql_open_connections"))
+}
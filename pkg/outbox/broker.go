@@ -0,0 +1,71 @@
+package outbox
+
+import (
+	"context"
+	"sync"
+)
+
+// Broker はイベントをこのプロセスの購読者に配る配信先（SSEなどのリアルタイムの通知用）
+// 購読者が受け取りきれずバッファが一杯になった場合は、その購読者のチャネルを閉じて購読を打ち切る
+// （購読者は最後に受け取ったイベントのIDからアウトボックスを読み直して再開する）
+type Broker struct {
+	mu          sync.Mutex
+	buffer      int
+	subscribers map[chan Event]struct{}
+	closed      bool
+}
+
+func NewBroker(buffer int) *Broker {
+	return &Broker{buffer: buffer, subscribers: map[chan Event]struct{}{}}
+}
+
+func (b *Broker) Name() string {
+	return "broker"
+}
+
+// Publish は全ての購読者にイベントを配る（受け取るのを待たないため、遅い購読者が配信を止めることはない）
+func (b *Broker) Publish(ctx context.Context, event Event) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+	return nil
+}
+
+// Subscribe は以降に配られるイベントを受け取るチャネルと、購読をやめる関数を返す
+func (b *Broker) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, b.buffer)
+	b.mu.Lock()
+	if b.closed { // 閉じた後の購読はすぐに打ち切る
+		b.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
+	b.subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[ch]; ok {
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close は全ての購読者のチャネルを閉じ、以降の購読もすぐに打ち切る（サーバーの終了時に接続中のストリームを終えるため）
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for ch := range b.subscribers {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
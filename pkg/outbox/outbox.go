@@ -0,0 +1,107 @@
+// Package outbox はトランザクショナルアウトボックスに書かれたイベントを配信先（Sink）に届ける
+//
+// イベントはモデルの変更と同じトランザクションでアウトボックスに書かれるため、変更が確定したイベントだけが残り、
+// プロセスが途中で終了しても失われない。Dispatcher は送っていないイベントを古い順に全ての配信先に届け、
+// 届け終えたイベントに送信済みの印を付ける。印を付ける前に終了すると次の起動で同じイベントをもう一度届けるため（at-least-once）、
+// 配信先はイベントのIDで重複を除けるようにすること
+//
+// イベントのIDは書いた順に増えるが、トランザクションが確定する順とは限らない（後からIDを取ったトランザクションが先に確定しうる）。
+// そのため Dispatcher は届ける直前に、届ける順に増える番号（Sequence）をイベントに付ける。
+// 受け取ったイベントの続きから再開するクライアントは、IDではなくこの番号を使うこと
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go-api-newspaper/pkg/logger"
+)
+
+// Event はアウトボックスに書かれたドメインイベント
+type Event struct {
+	ID         int             `json:"id"`                 // 書いた順に増える一意のID
+	Sequence   int             `json:"sequence,omitempty"` // 届けた順に増える一意の番号（Dispatcher が届ける前に付ける）
+	Type       string          `json:"type"`
+	Payload    json.RawMessage `json:"payload"`
+	OccurredAt time.Time       `json:"occurredAt"`
+}
+
+// Sink はイベントの配信先
+type Sink interface {
+	// Name はログに出す配信先の名前を返す
+	Name() string
+	// Publish はイベントを届ける（エラーを返した場合は後でもう一度同じイベントを届ける）
+	Publish(ctx context.Context, event Event) error
+}
+
+// Store はアウトボックスを保存しているストア
+type Store interface {
+	// Pending は送信済みの印が付いていないイベントを、番号を付けたものは番号の順に、それ以外は古い順に最大limit件返す
+	Pending(ctx context.Context, limit int) ([]Event, error)
+	// Sequence はイベントに、これまでに付けたどの番号よりも大きい番号を付けて返す（付けてあればその番号を返す）
+	Sequence(ctx context.Context, ID int) (int, error)
+	// MarkSent はイベントに送信済みの印を付ける
+	MarkSent(ctx context.Context, ID int, at time.Time) error
+}
+
+// Dispatcher はアウトボックスのイベントを配信先に届ける
+type Dispatcher struct {
+	store     Store
+	sinks     []Sink
+	batchSize int
+}
+
+func NewDispatcher(store Store, batchSize int, sinks ...Sink) *Dispatcher {
+	return &Dispatcher{store: store, sinks: sinks, batchSize: batchSize}
+}
+
+// DispatchOnce は送っていないイベントを最大 batchSize 件届け、送信済みにしたイベントの数を返す
+// 配信先が失敗した場合はそこで止め、失敗したイベント以降は次の呼び出しで届ける（配信先ごとの順序を保つため）
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	events, err := d.store.Pending(ctx, d.batchSize)
+	if err != nil {
+		return 0, err
+	}
+	for i, event := range events {
+		if event.Sequence == 0 {
+			if event.Sequence, err = d.store.Sequence(ctx, event.ID); err != nil {
+				return i, err
+			}
+		}
+		for _, sink := range d.sinks {
+			if err := sink.Publish(ctx, event); err != nil {
+				return i, fmt.Errorf("publish event %d to %s: %w", event.ID, sink.Name(), err)
+			}
+		}
+		if err := d.store.MarkSent(ctx, event.ID, time.Now()); err != nil {
+			return i, err
+		}
+	}
+	return len(events), nil
+}
+
+// Run は ctx がキャンセルされるまで interval ごとにイベントを届ける
+// 1回で batchSize 件を届けきった場合は、残りのイベントを待たずに続けて届ける（失敗した場合はログに出して次の間隔で届け直す）
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			for {
+				dispatched, err := d.DispatchOnce(ctx)
+				if err != nil {
+					logger.Error("failed to dispatch outbox events", "error", err)
+					break
+				}
+				if dispatched < d.batchSize || ctx.Err() != nil {
+					break
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
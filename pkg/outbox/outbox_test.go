@@ -0,0 +1,183 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// テスト用のメモリ上のアウトボックス
+type memoryStore struct {
+	events    []Event
+	sent      map[int]bool
+	sequences map[int]int
+}
+
+func (s *memoryStore) Pending(ctx context.Context, limit int) ([]Event, error) {
+	pending := []Event{}
+	for _, event := range s.events {
+		if !s.sent[event.ID] && len(pending) < limit {
+			event.Sequence = s.sequences[event.ID]
+			pending = append(pending, event)
+		}
+	}
+	return pending, nil
+}
+
+func (s *memoryStore) Sequence(ctx context.Context, ID int) (int, error) {
+	if sequence, ok := s.sequences[ID]; ok {
+		return sequence, nil
+	}
+	s.sequences[ID] = len(s.sequences) + 1
+	return s.sequences[ID], nil
+}
+
+func (s *memoryStore) MarkSent(ctx context.Context, ID int, at time.Time) error {
+	s.sent[ID] = true
+	return nil
+}
+
+// 届いたイベントのIDを記録し、failOn のイベントで失敗する配信先
+type recordingSink struct {
+	received []int
+	failOn   int
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Publish(ctx context.Context, event Event) error {
+	if event.ID == s.failOn {
+		return errors.New("sink unavailable")
+	}
+	s.received = append(s.received, event.ID)
+	return nil
+}
+
+func newStore(n int) *memoryStore {
+	store := &memoryStore{sent: map[int]bool{}, sequences: map[int]int{}}
+	for id := 1; id <= n; id++ {
+		store.events = append(store.events, Event{ID: id, Type: "article.created", Payload: json.RawMessage(`{}`)})
+	}
+	return store
+}
+
+func TestDispatchOnce(t *testing.T) {
+	store := newStore(3)
+	first, second := &recordingSink{}, &recordingSink{failOn: 2}
+	dispatcher := NewDispatcher(store, 10, first, second)
+
+	// 失敗したイベントで止め、それ以降のイベントは届けない
+	dispatched, err := dispatcher.DispatchOnce(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, dispatched)
+	assert.Equal(t, []int{1, 2}, first.received)
+	assert.Equal(t, []int{1}, second.received)
+	assert.Equal(t, map[int]bool{1: true}, store.sent)
+
+	// 配信先が直ると失敗したイベントから届け直す（成功した配信先には同じイベントがもう一度届く）
+	second.failOn = 0
+	dispatched, err = dispatcher.DispatchOnce(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, dispatched)
+	assert.Equal(t, []int{1, 2, 2, 3}, first.received)
+	assert.Equal(t, []int{1, 2, 3}, second.received)
+
+	dispatched, _ = dispatcher.DispatchOnce(context.Background())
+	assert.Equal(t, 0, dispatched)
+	// 届け直したイベントには最初に付けた番号を使う
+	assert.Equal(t, map[int]int{1: 1, 2: 2, 3: 3}, store.sequences)
+}
+
+func TestDispatchSequence(t *testing.T) {
+	// 先にIDを取ったトランザクションが後から確定した場合も、届けた順に番号が増える
+	store := &memoryStore{sent: map[int]bool{}, sequences: map[int]int{}}
+	store.events = []Event{{ID: 11, Type: "article.created"}}
+	broker := NewBroker(10)
+	events, unsubscribe := broker.Subscribe()
+	defer unsubscribe()
+	dispatcher := NewDispatcher(store, 10, broker)
+	dispatcher.DispatchOnce(context.Background())
+	store.events = append([]Event{{ID: 10, Type: "article.created"}}, store.events...)
+	dispatcher.DispatchOnce(context.Background())
+
+	first, second := <-events, <-events
+	assert.Equal(t, []int{11, 10}, []int{first.ID, second.ID})
+	assert.Equal(t, []int{1, 2}, []int{first.Sequence, second.Sequence})
+}
+
+func TestRun(t *testing.T) {
+	store := newStore(5)
+	sink := &recordingSink{}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		// 1回に2件ずつでも、残りを待たずに続けて届ける
+		NewDispatcher(store, 2, sink).Run(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	<-done
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, sink.received)
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink("buffer", &buf)
+	occurredAt := time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC)
+	assert.Nil(t, sink.Publish(context.Background(), Event{ID: 1, Type: "newspaper.created", Payload: json.RawMessage(`{"id":3}`), OccurredAt: occurredAt}))
+	assert.Nil(t, sink.Publish(context.Background(), Event{ID: 2, Type: "newspaper.deleted", Payload: json.RawMessage(`{"id":3}`), OccurredAt: occurredAt}))
+	assert.Equal(t, `{"id":1,"type":"newspaper.created","payload":{"id":3},"occurredAt":"2023-04-01T09:00:00Z"}`+"\n"+
+		`{"id":2,"type":"newspaper.deleted","payload":{"id":3},"occurredAt":"2023-04-01T09:00:00Z"}`+"\n", buf.String())
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	for id := 1; id <= 2; id++ { // 開き直しても追記する
+		sink, closer, err := NewFileSink(path)
+		assert.Nil(t, err)
+		assert.Nil(t, sink.Publish(context.Background(), Event{ID: id, Type: "article.created", Payload: json.RawMessage(`{}`)}))
+		assert.Nil(t, closer.Close())
+	}
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, bytes.Count(data, []byte("\n")))
+}
+
+func TestBroker(t *testing.T) {
+	broker := NewBroker(1)
+	first, unsubscribeFirst := broker.Subscribe()
+	slow, _ := broker.Subscribe()
+
+	assert.Nil(t, broker.Publish(context.Background(), Event{ID: 1}))
+	assert.Equal(t, 1, (<-first).ID)
+	// 受け取っていない購読者はバッファが一杯になると打ち切られる
+	assert.Nil(t, broker.Publish(context.Background(), Event{ID: 2}))
+	assert.Equal(t, 2, (<-first).ID)
+	assert.Equal(t, 1, (<-slow).ID)
+	_, ok := <-slow
+	assert.False(t, ok)
+
+	unsubscribeFirst()
+	unsubscribeFirst() // 2回呼んでもよい
+	_, ok = <-first
+	assert.False(t, ok)
+	assert.Nil(t, broker.Publish(context.Background(), Event{ID: 3}))
+
+	// 閉じると購読中のチャネルを閉じ、以降の購読もすぐに打ち切る
+	live, _ := broker.Subscribe()
+	broker.Close()
+	_, ok = <-live
+	assert.False(t, ok)
+	late, unsubscribeLate := broker.Subscribe()
+	_, ok = <-late
+	assert.False(t, ok)
+	unsubscribeLate()
+}
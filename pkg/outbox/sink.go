@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+)
+
+// WriterSink はイベントを1行に1つのJSON（JSON Lines）として書き出す配信先
+type WriterSink struct {
+	name string
+	mu   sync.Mutex
+	w    io.Writer
+}
+
+func NewWriterSink(name string, w io.Writer) *WriterSink {
+	return &WriterSink{name: name, w: w}
+}
+
+// NewStdoutSink は標準出力に書き出す配信先を作る
+func NewStdoutSink() *WriterSink {
+	return NewWriterSink("stdout", os.Stdout)
+}
+
+// NewFileSink はファイルの末尾に追記する配信先を作る（ファイルがなければ作る）
+func NewFileSink(path string) (*WriterSink, io.Closer, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, nil, err
+	}
+	return NewWriterSink("file", file), file, nil
+}
+
+func (s *WriterSink) Name() string {
+	return s.name
+}
+
+func (s *WriterSink) Publish(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
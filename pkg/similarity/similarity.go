@@ -0,0 +1,153 @@
+// Package similarity は文書の語の出現回数からTF-IDFのベクトルを作り、コサイン類似度で似た文書を探すインメモリのインデックスを提供する
+package similarity
+
+import (
+	"math"
+	"sort"
+	"sync"
+
+	"go-api-newspaper/pkg/keywords"
+)
+
+// Match は類似する文書とその類似度（0〜1）
+type Match struct {
+	ID    int
+	Score float64
+}
+
+type document struct {
+	group int            // 絞り込みに使うグループ（新聞のIDなど）
+	terms map[string]int // 語の出現回数
+	total int            // 語の出現回数の合計
+}
+
+// Index は文書を語から引く転置インデックス
+// 文書の追加・削除のたびに文書頻度を更新するため、IDFは常に現在の文書の集合から計算される
+type Index struct {
+	mu       sync.RWMutex
+	docs     map[int]*document
+	postings map[string]map[int]int // 語 → 文書ID → 出現回数
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     map[int]*document{},
+		postings: map[string]map[int]int{},
+	}
+}
+
+// Add は文書を追加する（同じIDの文書があれば置き換える）
+func (x *Index) Add(id int, group int, terms map[string]int) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(id)
+
+	doc := &document{group: group, terms: make(map[string]int, len(terms))}
+	for term, count := range terms {
+		if count <= 0 {
+			continue
+		}
+		doc.terms[term] = count
+		doc.total += count
+		if x.postings[term] == nil {
+			x.postings[term] = map[int]int{}
+		}
+		x.postings[term][id] = count
+	}
+	x.docs[id] = doc
+}
+
+// Remove は文書を削除する
+func (x *Index) Remove(id int) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.remove(id)
+}
+
+func (x *Index) remove(id int) {
+	doc, ok := x.docs[id]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		delete(x.postings[term], id)
+		if len(x.postings[term]) == 0 {
+			delete(x.postings, term)
+		}
+	}
+	delete(x.docs, id)
+}
+
+// Len はインデックスに含まれる文書の数を返す
+func (x *Index) Len() int {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return len(x.docs)
+}
+
+// Replace はインデックスの内容をotherの内容に置き換える（作り直したインデックスを差し替えるときに使う）
+// otherと内部のデータを共有するため、呼び出した後にotherを使ってはならない
+func (x *Index) Replace(other *Index) {
+	other.mu.RLock()
+	docs, postings := other.docs, other.postings
+	other.mu.RUnlock()
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.docs, x.postings = docs, postings
+}
+
+// Similar はidの文書に似た文書を類似度の高い順に最大k件返す（kが0以下なら全件）
+// groupを指定した場合は同じグループの文書だけを対象にする。共通する語のない文書は含めない
+func (x *Index) Similar(id int, k int, group *int) []Match {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	query, ok := x.docs[id]
+	if !ok || query.total == 0 {
+		return []Match{}
+	}
+
+	// クエリと共通する語を持つ文書だけについて内積を求める
+	dots := map[int]float64{}
+	for term, count := range query.terms {
+		idf := x.idf(term)
+		weight := float64(count) / float64(query.total) * idf
+		for other, otherCount := range x.postings[term] {
+			if other == id || (group != nil && x.docs[other].group != *group) {
+				continue
+			}
+			dots[other] += weight * float64(otherCount) / float64(x.docs[other].total) * idf
+		}
+	}
+
+	queryNorm := x.norm(query)
+	matches := make([]Match, 0, len(dots))
+	for other, dot := range dots {
+		matches = append(matches, Match{ID: other, Score: dot / (queryNorm * x.norm(x.docs[other]))})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	if k > 0 && len(matches) > k {
+		matches = matches[:k]
+	}
+	return matches
+}
+
+func (x *Index) idf(term string) float64 {
+	return keywords.IDF(len(x.docs), len(x.postings[term]))
+}
+
+// TF-IDFベクトルの大きさ
+func (x *Index) norm(doc *document) float64 {
+	sum := 0.0
+	for term, count := range doc.terms {
+		weight := float64(count) / float64(doc.total) * x.idf(term)
+		sum += weight * weight
+	}
+	return math.Sqrt(sum)
+}
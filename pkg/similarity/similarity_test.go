@@ -0,0 +1,56 @@
+package similarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ids(matches []Match) []int {
+	result := []int{}
+	for _, match := range matches {
+		result = append(result, match.ID)
+	}
+	return result
+}
+
+func TestSimilar(t *testing.T) {
+	index := NewIndex()
+	index.Add(1, 1, map[string]int{"桜": 2, "花見": 1})
+	index.Add(2, 1, map[string]int{"桜": 1, "花見": 1, "名所": 1})
+	index.Add(3, 2, map[string]int{"桜": 1, "選挙": 3})
+	index.Add(4, 1, map[string]int{"選挙": 1})
+
+	matches := index.Similar(1, 0, nil)
+	assert.Equal(t, []int{2, 3}, ids(matches)) // 共通する語のない文書4は含めない
+	assert.Greater(t, matches[0].Score, matches[1].Score)
+	assert.LessOrEqual(t, matches[0].Score, 1.0)
+
+	group := 1
+	assert.Equal(t, []int{2}, ids(index.Similar(1, 0, &group)))
+	assert.Equal(t, []int{2}, ids(index.Similar(1, 1, nil)))
+
+	// 同じ内容の文書の類似度は1になる
+	index.Add(5, 1, map[string]int{"桜": 2, "花見": 1})
+	assert.InDelta(t, 1.0, index.Similar(1, 1, nil)[0].Score, 1e-9)
+
+	// 更新・削除がすぐに反映される
+	index.Add(2, 1, map[string]int{"選挙": 1})
+	index.Remove(5)
+	assert.Equal(t, []int{3}, ids(index.Similar(1, 0, nil)))
+	assert.Equal(t, 4, index.Len())
+	assert.Empty(t, index.Similar(99, 0, nil))
+}
+
+func TestReplace(t *testing.T) {
+	index := NewIndex()
+	index.Add(1, 1, map[string]int{"桜": 1})
+
+	rebuilt := NewIndex()
+	rebuilt.Add(2, 1, map[string]int{"桜": 1})
+	rebuilt.Add(3, 1, map[string]int{"桜": 1})
+	index.Replace(rebuilt)
+
+	assert.Equal(t, 2, index.Len())
+	assert.Equal(t, []int{3}, ids(index.Similar(2, 0, nil)))
+}
@@ -0,0 +1,48 @@
+// Package sm2 は間隔反復学習のアルゴリズムSM-2（SuperMemo 2）で次に復習する日を決める
+package sm2
+
+import "math"
+
+const (
+	DefaultEaseFactor = 2.5 // 新しいカードの易しさ
+	MinEaseFactor     = 1.3 // 易しさの下限（これより小さいと復習の間隔が伸びなくなる）
+	MaxQuality        = 5
+)
+
+// Card は復習の間隔を決めるためのカードの状態
+type Card struct {
+	EaseFactor  float64 // 易しさ（大きいほど復習の間隔が早く伸びる）
+	Interval    int     // 次の復習までの日数
+	Repetitions int     // 続けて正しく思い出せた回数
+}
+
+// NewCard はまだ復習していないカードを返す
+func NewCard() Card {
+	return Card{EaseFactor: DefaultEaseFactor}
+}
+
+// Review は復習の出来（0〜5）からカードの次の状態を返す
+// 3以上は思い出せた、2以下は思い出せなかったとみなし、思い出せなかった場合は翌日から復習し直す
+func (c Card) Review(quality int) Card {
+	quality = max(0, min(MaxQuality, quality))
+	next := c
+	if quality < 3 {
+		next.Repetitions = 0
+		next.Interval = 1
+	} else {
+		switch c.Repetitions {
+		case 0:
+			next.Interval = 1
+		case 1:
+			next.Interval = 6
+		default:
+			next.Interval = int(math.Round(float64(c.Interval) * c.EaseFactor))
+		}
+		next.Repetitions++
+	}
+
+	// 易しさは思い出せたかどうかに関わらず出来に応じて増減する
+	miss := float64(MaxQuality - quality)
+	next.EaseFactor = math.Max(MinEaseFactor, c.EaseFactor+0.1-miss*(0.08+miss*0.02))
+	return next
+}
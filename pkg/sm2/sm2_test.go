@@ -0,0 +1,42 @@
+package sm2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReview(t *testing.T) {
+	card := NewCard()
+
+	card = card.Review(5)
+	assert.Equal(t, 1, card.Interval)
+	assert.Equal(t, 1, card.Repetitions)
+	assert.InDelta(t, 2.6, card.EaseFactor, 1e-9)
+
+	card = card.Review(4)
+	assert.Equal(t, 6, card.Interval)
+	assert.InDelta(t, 2.6, card.EaseFactor, 1e-9)
+
+	card = card.Review(3)
+	assert.Equal(t, 16, card.Interval) // 6 * 2.6 = 15.6
+	assert.Equal(t, 3, card.Repetitions)
+	assert.InDelta(t, 2.46, card.EaseFactor, 1e-9)
+
+	// 思い出せなかった場合は最初からやり直す
+	card = card.Review(1)
+	assert.Equal(t, 1, card.Interval)
+	assert.Equal(t, 0, card.Repetitions)
+	assert.InDelta(t, 1.92, card.EaseFactor, 1e-9)
+}
+
+func TestReviewMinEaseFactor(t *testing.T) {
+	card := NewCard()
+	for i := 0; i < 10; i++ {
+		card = card.Review(0)
+	}
+	assert.Equal(t, MinEaseFactor, card.EaseFactor)
+
+	// 範囲外の出来は0〜5に丸める
+	assert.Equal(t, NewCard().Review(5), NewCard().Review(9))
+}
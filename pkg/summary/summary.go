@@ -0,0 +1,187 @@
+// Package summary は文章から重要な文を抜き出して要約を作る（抽出型要約）
+//
+// 文の重要度はTextRankで求める。共通する語の多い文どうしを結んだグラフでPageRankを計算し、
+// 多くの文と内容が重なる文ほど文章の中心的な内容を表す文とみなす
+package summary
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go-api-newspaper/pkg/tokenizer"
+)
+
+const (
+	damping       = 0.85 // PageRankの減衰係数
+	maxIterations = 100
+	tolerance     = 1e-6
+	// 重要度を求める文の数の上限（文の組の数だけ類似度を計算するため、本文の長さに関わらず計算量を抑える）
+	// 新聞の文章は冒頭に要点を書くことが多いため、これを超える文は要約に選ばない
+	maxSentences = 300
+)
+
+// 文の終わりを表す文字
+func isTerminator(r rune) bool {
+	return strings.ContainsRune("。！？!?", r)
+}
+
+// Sentences は文章を文に分割する
+// 。！？で区切り、直後の閉じ括弧は直前の文に含める。改行やコラムで段落の区切りに使われる▼も文の区切りとする
+func Sentences(text string) []string {
+	var sentences []string
+	var sb strings.Builder
+	flush := func() {
+		if sentence := strings.TrimSpace(sb.String()); sentence != "" {
+			sentences = append(sentences, sentence)
+		}
+		sb.Reset()
+	}
+
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r == '\n' || r == '▼' {
+			flush()
+			continue
+		}
+		sb.WriteRune(r)
+		if !isTerminator(r) {
+			continue
+		}
+		// 「！？」のように続く句点や、「…。」の閉じ括弧は同じ文に含める
+		for i+1 < len(runes) && (isTerminator(runes[i+1]) || strings.ContainsRune("」』）)", runes[i+1])) {
+			i++
+			sb.WriteRune(runes[i])
+		}
+		flush()
+	}
+	flush()
+	return sentences
+}
+
+// 文の内容を表す語（助詞・助動詞・記号などを除いた語）の集合
+func words(sentence string) map[string]bool {
+	set := map[string]bool{}
+	for _, token := range tokenizer.Tokenize(sentence) {
+		switch token.POS {
+		case tokenizer.Particle, tokenizer.Auxiliary, tokenizer.Symbol, tokenizer.Unknown, tokenizer.Conjunction:
+			continue
+		}
+		if strings.IndexFunc(token.Surface, unicode.IsLetter) < 0 { // 数字だけの語など
+			continue
+		}
+		set[token.Surface] = true
+	}
+	return set
+}
+
+// 2つの文の類似度（共通する語の数を文の長さで正規化したもの）
+// TextRankの元の式は log|Si| + log|Sj| で割るが、1語だけの文で0除算にならないよう1を足す
+func similarity(a, b map[string]bool) float64 {
+	common := 0
+	for word := range a {
+		if b[word] {
+			common++
+		}
+	}
+	if common == 0 {
+		return 0
+	}
+	return float64(common) / (math.Log(float64(1+len(a))) + math.Log(float64(1+len(b))))
+}
+
+// Rank は各文の重要度をTextRankで求める（合計は1になる）
+// 全ての文の組の類似度を計算するため、計算量は文の数の2乗に比例する
+func Rank(sentences []string) []float64 {
+	n := len(sentences)
+	if n == 0 {
+		return []float64{}
+	}
+	sets := make([]map[string]bool, n)
+	for i, sentence := range sentences {
+		sets[i] = words(sentence)
+	}
+	// 類似度が0の組は辺を作らないため、隣接リストで持つ
+	type edge struct {
+		from   int
+		weight float64
+	}
+	incoming := make([][]edge, n) // 各文に入る辺
+	totals := make([]float64, n)  // 各文から出る辺の重みの合計
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			w := similarity(sets[i], sets[j])
+			if w == 0 {
+				continue
+			}
+			incoming[i] = append(incoming[i], edge{j, w})
+			incoming[j] = append(incoming[j], edge{i, w})
+			totals[i] += w
+			totals[j] += w
+		}
+	}
+
+	scores := make([]float64, n)
+	for i := range scores {
+		scores[i] = 1 / float64(n)
+	}
+	for iteration := 0; iteration < maxIterations; iteration++ {
+		next := make([]float64, n)
+		dangling := 0.0 // 他の文とつながらない文の重要度は全ての文に等しく配る
+		for j := 0; j < n; j++ {
+			if totals[j] == 0 {
+				dangling += scores[j]
+			}
+		}
+		delta := 0.0
+		for i := 0; i < n; i++ {
+			sum := dangling / float64(n)
+			for _, e := range incoming[i] {
+				sum += e.weight / totals[e.from] * scores[e.from]
+			}
+			next[i] = (1-damping)/float64(n) + damping*sum
+			delta += math.Abs(next[i] - scores[i])
+		}
+		scores = next
+		if delta < tolerance {
+			break
+		}
+	}
+	return scores
+}
+
+// Summarize は文章から重要度の高い文を最大n文抜き出し、元の順に並べてつなげた要約を返す
+// 重要度が同じ場合は前にある文を優先する（新聞の文章は冒頭に要点を書くことが多いため）
+// 文が多い場合は先頭の maxSentences 文から選ぶ
+func Summarize(text string, n int) string {
+	sentences := Sentences(text)
+	if n <= 0 || len(sentences) == 0 {
+		return ""
+	}
+	if len(sentences) <= n {
+		return strings.Join(sentences, "")
+	}
+
+	ranked := sentences
+	if len(ranked) > maxSentences {
+		ranked = ranked[:maxSentences]
+	}
+	scores := Rank(ranked)
+	indexes := make([]int, len(ranked))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(a, b int) bool {
+		return scores[indexes[a]] > scores[indexes[b]]
+	})
+	selected := indexes[:n]
+	sort.Ints(selected)
+
+	var sb strings.Builder
+	for _, i := range selected {
+		sb.WriteString(sentences[i])
+	}
+	return sb.String()
+}
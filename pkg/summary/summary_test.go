@@ -0,0 +1,57 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const column = "政府は経済の対策を国会に示した。桜が咲いた。首相は国会で経済と政府の方針を語った。\n" +
+	"野党は国会で政府を批判した。春の空は青い！？「本当か。」"
+
+func TestSentences(t *testing.T) {
+	assert.Equal(t, []string{
+		"政府は経済の対策を国会に示した。",
+		"桜が咲いた。",
+		"首相は国会で経済と政府の方針を語った。",
+		"野党は国会で政府を批判した。",
+		"春の空は青い！？", // 続く句点は1つの文の終わり
+		"「本当か。」",   // 閉じ括弧は直前の文に含める
+	}, Sentences(column))
+
+	// 改行と▼も文の区切りとする（句点のない見出しなど）
+	assert.Equal(t, []string{"見出し", "本文。", "次の段落"}, Sentences("見出し\n本文。▼次の段落"))
+	assert.Empty(t, Sentences(" \n "))
+}
+
+func TestRank(t *testing.T) {
+	scores := Rank(Sentences(column))
+	assert.Len(t, scores, 6)
+	sum := 0.0
+	for _, score := range scores {
+		sum += score
+	}
+	assert.InDelta(t, 1.0, sum, 1e-6)
+
+	// 他の文と語を共有する文ほど重要度が高い
+	assert.Greater(t, scores[0], scores[1])
+	assert.Greater(t, scores[2], scores[4])
+	assert.InDelta(t, scores[1], scores[5], 1e-9) // どの文ともつながらない文は同じ重要度
+}
+
+func TestSummarize(t *testing.T) {
+	// 重要度の高い文を元の順に並べる
+	assert.Equal(t, "政府は経済の対策を国会に示した。野党は国会で政府を批判した。", Summarize(column, 2))
+
+	// 文の数がn以下なら全文をそのまま返す
+	assert.Equal(t, "桜が咲いた。春が来た。", Summarize("桜が咲いた。\n春が来た。", 3))
+	assert.Equal(t, "", Summarize("", 3))
+	assert.Equal(t, "", Summarize(column, 0))
+}
+
+func TestSummarizeLong(t *testing.T) {
+	// 文が多くても先頭の文から選び、全ての文の組を計算しない
+	text := "政府は経済の対策を示した。" + strings.Repeat("あ。", 20000)
+	assert.Equal(t, "政府は経済の対策を示した。", Summarize(text, 1))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/stretchr/testify/suite"
@@ -19,7 +20,7 @@ import (
 // CheckPortは指定されたホストとポートに接続可能かを確認する関数
 func CheckPort(host string, port int) bool {
 	// 指定されたホストとポートにTCP接続を試みる
-	conn, err := net.Dial("tcp", fmt.Sprintf("%s:%d", host, port))
+	conn, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(port)))
 	if conn != nil {
 		// 接続が成功した場合は閉じてfalseを返す（ポートが使用中）
 		conn.Close()
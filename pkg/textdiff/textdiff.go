@@ -0,0 +1,211 @@
+package textdiff
+
+import "strings"
+
+// Op は差分における編集操作の種類
+type Op string
+
+const (
+	OpEqual  Op = "equal"  // 両方に共通する部分
+	OpInsert Op = "insert" // 新しい側にだけ存在する部分
+	OpDelete Op = "delete" // 古い側にだけ存在する部分
+)
+
+// Edit は連続する同じ操作をひとまとめにした差分の単位
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// Lines は2つの文字列を行単位で比較する（改行は各行の末尾に含める）
+func Lines(a, b string) []Edit {
+	return merge(diff(splitLines(a), splitLines(b)))
+}
+
+// Runes は2つの文字列を文字（rune）単位で比較する
+// バイト単位ではなくruneで比較するため、日本語などのマルチバイト文字が途中で分割されることはない
+func Runes(a, b string) []Edit {
+	return merge(diff(toStrings([]rune(a)), toStrings([]rune(b))))
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" { // 末尾が改行で終わる場合に生じる空要素を取り除く
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func toStrings(rs []rune) []string {
+	tokens := make([]string, len(rs))
+	for i, r := range rs {
+		tokens[i] = string(r)
+	}
+	return tokens
+}
+
+type tokenEdit struct {
+	op    Op
+	token string
+}
+
+// diff はMyersの差分アルゴリズムの線形空間版（中央スネークで分割統治する）で
+// トークン列aをbに変換する最短の編集列を求める
+// 探索状況を全て記録する版はメモリがO((N+M)^2)になるため、長い本文でも O(N+M) に収まるこちらを使う
+func diff(a, b []string) []tokenEdit {
+	return compare(a, b, make([]tokenEdit, 0, len(a)+len(b)))
+}
+
+// compare は共通の先頭・末尾を取り除いてから残りを分割し、編集列をeditsに追加する
+func compare(a, b []string, edits []tokenEdit) []tokenEdit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	edits = appendTokens(edits, OpEqual, a[:prefix])
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := 0
+	for suffix < len(a) && suffix < len(b) && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	common := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	switch {
+	case len(a) == 0:
+		edits = appendTokens(edits, OpInsert, b)
+	case len(b) == 0:
+		edits = appendTokens(edits, OpDelete, a)
+	default:
+		x, y, ok := middleSnake(a, b)
+		if ok {
+			edits = compare(a[:x], b[:y], edits)
+			edits = compare(a[x:], b[y:], edits)
+		} else { // 共通部分がない
+			edits = appendTokens(edits, OpDelete, a)
+			edits = appendTokens(edits, OpInsert, b)
+		}
+	}
+	return appendTokens(edits, OpEqual, common)
+}
+
+// middleSnake は始点からの探索と終点からの探索を同時に進め、両者が重なる点（最短の編集列が通る点）を返す
+func middleSnake(a, b []string) (int, int, bool) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	forward := make([]int, 2*maxD+2)
+	backward := make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+
+	delta := n - m
+	odd := delta%2 != 0 // 差が奇数なら始点からの探索で、偶数なら終点からの探索で重なりを調べる
+	// 探索範囲が比較対象の外に出た対角線を次回から調べないための範囲
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1] // 下に移動（挿入）
+			} else {
+				x = forward[offset+k-1] + 1 // 右に移動（削除）
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				i := offset + delta - k
+				if i >= 0 && i < len(backward) && backward[i] != -1 && x >= n-backward[i] {
+					return x, y, true
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && backward[offset+k-1] < backward[offset+k+1]) {
+				x = backward[offset+k+1]
+			} else {
+				x = backward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			backward[offset+k] = x
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				i := offset + delta - k
+				if i >= 0 && i < len(forward) && forward[i] != -1 {
+					fx := forward[i]
+					fy := fx - (i - offset)
+					if fx >= n-x {
+						return fx, fy, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+func appendTokens(edits []tokenEdit, op Op, tokens []string) []tokenEdit {
+	for _, token := range tokens {
+		edits = append(edits, tokenEdit{op, token})
+	}
+	return edits
+}
+
+// merge は同じ操作が続くトークンを1つのEditにまとめる
+// 変更箇所（一致しない部分）の中では削除を挿入より先に並べる
+func merge(tokens []tokenEdit) []Edit {
+	edits := []Edit{}
+	var equal, deleted, inserted strings.Builder
+	flush := func(sb *strings.Builder, op Op) {
+		if sb.Len() > 0 {
+			edits = append(edits, Edit{Op: op, Text: sb.String()})
+			sb.Reset()
+		}
+	}
+	for _, t := range tokens {
+		switch t.op {
+		case OpEqual:
+			flush(&deleted, OpDelete)
+			flush(&inserted, OpInsert)
+			equal.WriteString(t.token)
+		case OpDelete:
+			flush(&equal, OpEqual)
+			deleted.WriteString(t.token)
+		case OpInsert:
+			flush(&equal, OpEqual)
+			inserted.WriteString(t.token)
+		}
+	}
+	flush(&equal, OpEqual)
+	flush(&deleted, OpDelete)
+	flush(&inserted, OpInsert)
+	return edits
+}
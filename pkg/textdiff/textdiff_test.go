@@ -0,0 +1,122 @@
+package textdiff
+
+import (
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 編集列から比較元・比較先の文字列を復元する
+func rebuild(edits []Edit) (string, string) {
+	var a, b strings.Builder
+	for _, e := range edits {
+		if e.Op != OpInsert {
+			a.WriteString(e.Text)
+		}
+		if e.Op != OpDelete {
+			b.WriteString(e.Text)
+		}
+	}
+	return a.String(), b.String()
+}
+
+func TestRunes(t *testing.T) {
+	edits := Runes("春はあけぼの。", "夏はよる。")
+	assert.Equal(t, []Edit{
+		{Op: OpDelete, Text: "春"},
+		{Op: OpInsert, Text: "夏"},
+		{Op: OpEqual, Text: "は"},
+		{Op: OpDelete, Text: "あけぼの"},
+		{Op: OpInsert, Text: "よる"},
+		{Op: OpEqual, Text: "。"},
+	}, edits)
+
+	a, b := rebuild(edits)
+	assert.Equal(t, "春はあけぼの。", a)
+	assert.Equal(t, "夏はよる。", b)
+}
+
+func TestLines(t *testing.T) {
+	edits := Lines("一行目\n二行目\n三行目\n", "一行目\n二行目を修正\n三行目\n")
+	assert.Equal(t, []Edit{
+		{Op: OpEqual, Text: "一行目\n"},
+		{Op: OpDelete, Text: "二行目\n"},
+		{Op: OpInsert, Text: "二行目を修正\n"},
+		{Op: OpEqual, Text: "三行目\n"},
+	}, edits)
+}
+
+func TestEmpty(t *testing.T) {
+	assert.Equal(t, []Edit{}, Runes("", ""))
+	assert.Equal(t, []Edit{{Op: OpInsert, Text: "追加"}}, Runes("", "追加"))
+	assert.Equal(t, []Edit{{Op: OpDelete, Text: "削除\n"}}, Lines("削除\n", ""))
+	assert.Equal(t, []Edit{{Op: OpEqual, Text: "同じ"}}, Runes("同じ", "同じ"))
+}
+
+// 動的計画法で最長共通部分列の長さを求める（最短の編集列の長さを確かめるための参照実装）
+func lcs(a, b []rune) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1] + 1
+			} else if dp[i-1][j] > dp[i][j-1] {
+				dp[i][j] = dp[i-1][j]
+			} else {
+				dp[i][j] = dp[i][j-1]
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
+
+func TestShortest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	alphabet := []rune("あいうえお")
+	random := func() string {
+		rs := make([]rune, rng.Intn(30))
+		for i := range rs {
+			rs[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return string(rs)
+	}
+	for i := 0; i < 500; i++ {
+		a, b := random(), random()
+		edits := Runes(a, b)
+
+		ra, rb := rebuild(edits)
+		assert.Equal(t, a, ra)
+		assert.Equal(t, b, rb)
+
+		equal := 0
+		for _, e := range edits {
+			if e.Op == OpEqual {
+				equal += len([]rune(e.Text))
+			}
+		}
+		assert.Equal(t, lcs([]rune(a), []rune(b)), equal, "%q → %q", a, b)
+	}
+}
+
+func TestLargeMemory(t *testing.T) {
+	a := strings.Repeat("春はあけぼの。", 1000)
+	b := strings.Repeat("夏はよる。月のころはさらなり。", 500)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	edits := Runes(a, b)
+	runtime.ReadMemStats(&after)
+
+	ra, rb := rebuild(edits)
+	assert.Equal(t, a, ra)
+	assert.Equal(t, b, rb)
+	// 探索状況を全て記録すると数百MBになる大きさでも、割り当てが入力に比例する程度に収まる
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64<<20))
+}
@@ -0,0 +1,91 @@
+// Package textstats は日本語の文章の文字数・文の数・段落の数などの統計を計算する
+package textstats
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// CharactersPerMinute は読了時間の見積もりに使う、1分間に読める文字数
+const CharactersPerMinute = 500
+
+// Stats は文章の統計
+type Stats struct {
+	Characters     int     // 空白・改行を除いた文字（rune）の数
+	Sentences      int     // 文の数
+	Paragraphs     int     // 段落の数
+	KanjiRatio     float64 // 文字数に占める漢字の割合
+	KanaRatio      float64 // 文字数に占めるひらがな・カタカナの割合
+	ReadingSeconds int     // 読了時間の見積もり（秒、切り上げ）
+}
+
+// 文の終わりを表す文字
+func isTerminator(r rune) bool {
+	return strings.ContainsRune("。．！？!?", r)
+}
+
+// 段落の区切り（改行、またはコラムで段落の区切りに使われる▼）
+func isParagraphSeparator(r rune) bool {
+	return r == '\n' || r == '▼'
+}
+
+// Compute は文章の統計を計算する
+// 句点などが連続する場合（「！？」など）は1つの文の終わりとして数え、段落の終わりや文章の終わりも文の終わりとして扱う
+func Compute(text string) Stats {
+	var stats Stats
+	var kanji, kana int
+	inSentence, inParagraph := false, false
+	for _, r := range text {
+		if isParagraphSeparator(r) {
+			if inParagraph {
+				stats.Paragraphs++
+			}
+			if inSentence {
+				stats.Sentences++
+			}
+			inSentence, inParagraph = false, false
+			if r == '\n' {
+				continue
+			}
+		}
+		if unicode.IsSpace(r) {
+			continue
+		}
+		stats.Characters++
+		if r == '▼' {
+			continue
+		}
+		inParagraph = true
+
+		switch {
+		case isTerminator(r):
+			if inSentence {
+				stats.Sentences++
+			}
+			inSentence = false
+			continue
+		case unicode.Is(unicode.Han, r) || r == '々':
+			kanji++
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || r == 'ー':
+			kana++
+		}
+		// 閉じ括弧は直前の文に含める（「…。」の 」で新しい文を始めない）
+		if !strings.ContainsRune("」』）)", r) {
+			inSentence = true
+		}
+	}
+	if inSentence {
+		stats.Sentences++
+	}
+	if inParagraph {
+		stats.Paragraphs++
+	}
+
+	if stats.Characters > 0 {
+		stats.KanjiRatio = float64(kanji) / float64(stats.Characters)
+		stats.KanaRatio = float64(kana) / float64(stats.Characters)
+	}
+	stats.ReadingSeconds = int(math.Ceil(float64(stats.Characters) * 60 / CharactersPerMinute))
+	return stats
+}
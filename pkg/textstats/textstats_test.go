@@ -0,0 +1,24 @@
+package textstats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompute(t *testing.T) {
+	stats := Compute("春はあけぼの。やうやう白くなりゆく山ぎは、\n\n少しあかりて！？「紫だちたる雲の」ほそくたなびきたる")
+	assert.Equal(t, 47, stats.Characters) // 改行は数えない
+	assert.Equal(t, 4, stats.Sentences)   // 段落の終わりも文の終わりとして扱う
+	assert.Equal(t, 2, stats.Paragraphs)
+	assert.InDelta(t, 6.0/47, stats.KanjiRatio, 1e-9)
+	assert.InDelta(t, 35.0/47, stats.KanaRatio, 1e-9)
+	assert.Equal(t, 6, stats.ReadingSeconds) // 47文字 ÷ 500文字/分 = 5.64秒
+
+	// コラムの▼は段落の区切りとして扱う
+	stats = Compute("「桜が咲いた。」▼花見の季節だ。")
+	assert.Equal(t, 2, stats.Sentences)
+	assert.Equal(t, 2, stats.Paragraphs)
+
+	assert.Equal(t, Stats{}, Compute(" \n "))
+}
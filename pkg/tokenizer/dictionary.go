@@ -0,0 +1,147 @@
+package tokenizer
+
+import (
+	"bufio"
+	_ "embed" // 組み込み辞書を読み込むため
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed dict.tsv
+var defaultDictionary string
+
+// Entry は辞書に登録された語
+type Entry struct {
+	Surface string
+	POS     POS
+	Reading string // カタカナの読み
+}
+
+// Dictionary は表層形から語を引く辞書
+type Dictionary struct {
+	entries map[string]Entry
+	stems   map[string]Entry // 活用する語の送り仮名を除いた語幹（「咲く」の「咲」）
+	maxLen  int              // 登録された語の最大の文字数（最長一致の探索範囲）
+}
+
+// LoadDictionary は「表層形<TAB>品詞<TAB>読み」形式の辞書を読み込む
+// 空行と # で始まる行は無視する。読みを省略した場合は表層形をカタカナにしたものを読みとする
+// 同じ表層形が複数ある場合は先に書かれたものを使う
+func LoadDictionary(r io.Reader) (*Dictionary, error) {
+	dictionary := &Dictionary{entries: map[string]Entry{}, stems: map[string]Entry{}}
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 || fields[0] == "" {
+			return nil, fmt.Errorf("dictionary line %d: expected surface and part of speech", lineNumber)
+		}
+		entry := Entry{Surface: fields[0], POS: POS(fields[1])}
+		if len(fields) >= 3 && fields[2] != "" {
+			entry.Reading = fields[2]
+		} else {
+			entry.Reading = ToKatakana(entry.Surface)
+		}
+		dictionary.Add(entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return dictionary, nil
+}
+
+// Add は語を辞書に追加する（既に登録されている表層形は上書きしない）
+func (d *Dictionary) Add(entry Entry) {
+	if _, ok := d.entries[entry.Surface]; ok {
+		return
+	}
+	d.entries[entry.Surface] = entry
+	if n := utf8.RuneCountInString(entry.Surface); n > d.maxLen {
+		d.maxLen = n
+	}
+	if stem, ok := stemOf(entry); ok {
+		if _, exists := d.stems[stem.Surface]; !exists {
+			d.stems[stem.Surface] = stem
+		}
+	}
+}
+
+// 動詞・形容詞の末尾のひらがな（送り仮名）を表層形と読みの両方から除いた語幹を返す
+// 「書き写す」（カキウツス）の語幹は「書き写」（カキウツ）。語幹が漢字で終わらない語には語幹を作らない
+func stemOf(entry Entry) (Entry, bool) {
+	if entry.POS != Verb && entry.POS != Adjective {
+		return Entry{}, false
+	}
+	surface, reading := []rune(entry.Surface), []rune(entry.Reading)
+	n := 0
+	for n < len(surface) && n < len(reading) && unicode.Is(unicode.Hiragana, surface[len(surface)-1-n]) {
+		if ToKatakana(string(surface[len(surface)-1-n])) != string(reading[len(reading)-1-n]) {
+			return Entry{}, false
+		}
+		n++
+	}
+	if n == 0 || n == len(surface) || n == len(reading) || !unicode.Is(unicode.Han, surface[len(surface)-1-n]) {
+		return Entry{}, false
+	}
+	return Entry{Surface: string(surface[:len(surface)-n]), POS: entry.POS, Reading: string(reading[:len(reading)-n])}, true
+}
+
+// Lookup は表層形が完全に一致する語を返す
+func (d *Dictionary) Lookup(surface string) (Entry, bool) {
+	entry, ok := d.entries[surface]
+	return entry, ok
+}
+
+// longestMatch は text[i:] の先頭に一致する最も長い語を返す
+func (d *Dictionary) longestMatch(text []rune, i int) (Entry, int, bool) {
+	for n := min(d.maxLen, len(text)-i); n > 0; n-- {
+		if entry, ok := d.entries[string(text[i:i+n])]; ok {
+			return entry, n, true
+		}
+	}
+	return Entry{}, 0, false
+}
+
+// prefixes は text[i:] の先頭に一致する語を長い順に返す
+func (d *Dictionary) prefixes(text []rune, i int) []Entry {
+	return d.match(d.entries, text, i)
+}
+
+// stemPrefixes は text[i:] の先頭に一致する語幹を長い順に返す
+func (d *Dictionary) stemPrefixes(text []rune, i int) []Entry {
+	return d.match(d.stems, text, i)
+}
+
+func (d *Dictionary) match(entries map[string]Entry, text []rune, i int) []Entry {
+	var result []Entry
+	for n := min(d.maxLen, len(text)-i); n > 0; n-- {
+		if entry, ok := entries[string(text[i:i+n])]; ok {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+var (
+	defaultOnce sync.Once
+	defaultDict *Dictionary
+)
+
+// DefaultDictionary は組み込み辞書を返す（初回の呼び出し時に読み込む）
+func DefaultDictionary() *Dictionary {
+	defaultOnce.Do(func() {
+		dictionary, err := LoadDictionary(strings.NewReader(defaultDictionary))
+		if err != nil {
+			panic(err) // 組み込み辞書の形式はテストで保証する
+		}
+		defaultDict = dictionary
+	})
+	return defaultDict
+}
@@ -0,0 +1,323 @@
+// Package tokenizer は外部の辞書やcgoに依存しない簡易的な日本語の形態素解析を行う
+//
+// 漢字の連続は辞書にない文字が最も少なくなるように辞書の語へ分割し、それ以外は組み込み辞書の語を最長一致で切り出す。
+// 辞書にない部分は文字種（漢字・ひらがな・カタカナなど）の連続を1語として扱う。MeCabなどと比べると精度は劣るが、キーワード抽出に必要な名詞の切り出しには十分な精度がある
+package tokenizer
+
+import (
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// POS は品詞
+type POS string
+
+const (
+	Noun          POS = "名詞"
+	NounDependent POS = "名詞-非自立" // こと・もの・年など、単独では意味をなさない名詞
+	Pronoun       POS = "名詞-代名詞"
+	Number        POS = "名詞-数"
+	Verb          POS = "動詞"
+	Adjective     POS = "形容詞"
+	Adverb        POS = "副詞"
+	Adnominal     POS = "連体詞"
+	Conjunction   POS = "接続詞"
+	Particle      POS = "助詞"
+	Auxiliary     POS = "助動詞"
+	Symbol        POS = "記号"
+	Unknown       POS = "未知語"
+)
+
+// Token は切り出した語
+type Token struct {
+	Surface string
+	POS     POS
+	Reading string // カタカナの読み（辞書にない漢字を含む語は空）
+	Known   bool   // 辞書に登録された語かどうか
+	Start   int    // 元の文章における文字（rune）単位の開始位置
+}
+
+// IsContentNoun はキーワードの候補となる名詞（代名詞・数・非自立名詞を除く）かどうかを返す
+func (t Token) IsContentNoun() bool {
+	return t.POS == Noun
+}
+
+type charClass int
+
+const (
+	classSpace charClass = iota
+	classKanji
+	classHiragana
+	classKatakana
+	classLatin
+	classDigit
+	classSymbol
+)
+
+func classOf(r rune, prev charClass) charClass {
+	switch {
+	case unicode.IsSpace(r):
+		return classSpace
+	case r == 'ー' || r == '々': // 長音記号・踊り字は直前の文字種を引き継ぐ
+		if prev == classSpace || prev == classSymbol {
+			return classSymbol
+		}
+		return prev
+	case unicode.Is(unicode.Han, r):
+		return classKanji
+	case unicode.Is(unicode.Hiragana, r):
+		return classHiragana
+	case unicode.Is(unicode.Katakana, r):
+		return classKatakana
+	case unicode.IsDigit(r):
+		return classDigit
+	case unicode.IsLetter(r):
+		return classLatin
+	default:
+		return classSymbol
+	}
+}
+
+// Tokenizer は辞書を使って文章を語に分割する
+type Tokenizer struct {
+	dictionary *Dictionary
+}
+
+func New(dictionary *Dictionary) *Tokenizer {
+	return &Tokenizer{dictionary: dictionary}
+}
+
+// Tokenize は組み込み辞書を使って文章を語に分割する
+func Tokenize(text string) []Token {
+	return New(DefaultDictionary()).Tokenize(text)
+}
+
+// Tokenize は文章を語に分割する（空白は取り除く）
+func (t *Tokenizer) Tokenize(text string) []Token {
+	runes := []rune(text)
+	classes := make([]charClass, len(runes))
+	prev := classSpace
+	for i, r := range runes {
+		classes[i] = classOf(r, prev)
+		prev = classes[i]
+	}
+
+	tokens := []Token{}
+	for i := 0; i < len(runes); {
+		class := classes[i]
+		runEnd := i + 1
+		for runEnd < len(runes) && classes[runEnd] == class {
+			runEnd++
+		}
+
+		switch class {
+		case classSpace:
+			i = runEnd
+		case classSymbol:
+			tokens = append(tokens, Token{Surface: string(runes[i]), POS: Symbol, Reading: string(runes[i]), Start: i})
+			i++
+		case classHiragana:
+			if entry, n, ok := t.dictionary.longestMatch(runes, i); ok {
+				tokens = append(tokens, known(entry, i))
+				i += n
+				continue
+			}
+			// 辞書にないひらがなは、次に辞書の語が始まる位置までをまとめて1語にする
+			j := i + 1
+			for ; j < runEnd; j++ {
+				if _, _, ok := t.dictionary.longestMatch(runes, j); ok {
+					break
+				}
+			}
+			surface := string(runes[i:j])
+			tokens = append(tokens, Token{Surface: surface, POS: Unknown, Reading: ToKatakana(surface), Start: i})
+			i = j
+		case classKanji:
+			segmented, end := t.segmentKanji(runes, i, runEnd)
+			tokens = append(tokens, segmented...)
+			i = end
+		default:
+			// カタカナ・英数字の連続は1語とみなし、辞書の語がその連続全体を覆う場合だけ辞書の語を使う
+			// （「スーパーマーケット」が辞書の「スーパー」で分割されないようにするため）
+			if entry, n, ok := t.dictionary.longestMatch(runes, i); ok && i+n >= runEnd {
+				tokens = append(tokens, known(entry, i))
+				i += n
+				continue
+			}
+			token := unknownRun(string(runes[i:runEnd]), class)
+			token.Start = i
+			tokens = append(tokens, token)
+			i = runEnd
+		}
+	}
+	return attachOkurigana(tokens)
+}
+
+// 漢字の連続を分割した途中の位置までの最良の分割
+type lattice struct {
+	reached bool
+	unknown int   // 辞書にない文字の数
+	words   int   // 語の数
+	from    int   // 直前の語の開始位置
+	entry   Entry // 直前の語（辞書にない語の場合は空）
+	known   bool
+}
+
+func (l lattice) better(other lattice) bool {
+	if !other.reached {
+		return true
+	}
+	if l.unknown != other.unknown {
+		return l.unknown < other.unknown
+	}
+	return l.words < other.words
+}
+
+// segmentKanji は漢字の連続 runes[start:runEnd] を辞書の語に分割し、分割した語と次に読む位置を返す
+// 辞書にない文字が最も少なく、その中で語の数が最も少ない分割を選ぶ（「日本政府」は「日本」と「政府」に分ける）
+// 最後の語は送り仮名など連続の後ろのかなまで含むことがある
+func (t *Tokenizer) segmentKanji(runes []rune, start, runEnd int) ([]Token, int) {
+	nodes := make([]lattice, runEnd-start+t.dictionary.maxLen+1)
+	nodes[0].reached = true
+	relax := func(from, to int, entry Entry, known bool) {
+		next := nodes[from-start]
+		next.from, next.entry, next.known = from, entry, known
+		next.words++
+		if !known {
+			next.unknown += to - from
+		}
+		if next.better(nodes[to-start]) {
+			nodes[to-start] = next
+		}
+	}
+	for i := start; i < runEnd; i++ {
+		if !nodes[i-start].reached {
+			continue
+		}
+		// 同じ長さの語と語幹では語幹を優先する（「話して」の「話」）
+		for _, entry := range t.stems(runes, i, runEnd) {
+			relax(i, i+utf8.RuneCountInString(entry.Surface), entry, true)
+		}
+		for _, entry := range t.dictionary.prefixes(runes, i) {
+			relax(i, i+utf8.RuneCountInString(entry.Surface), entry, true)
+		}
+		// 辞書にない漢字は、次に辞書の語が始まる位置までをまとめて1語にする
+		j := i + 1
+		for ; j < runEnd; j++ {
+			if len(t.dictionary.prefixes(runes, j)) > 0 || len(t.stems(runes, j, runEnd)) > 0 {
+				break
+			}
+		}
+		relax(i, j, Entry{}, false)
+	}
+
+	// 連続の末尾か、送り仮名まで含めた語の終わりのうち最良のものを選ぶ（同じ場合は長く読める方）
+	end := runEnd
+	for i := runEnd + 1; i-start < len(nodes); i++ {
+		if nodes[i-start].reached && !nodes[end-start].better(nodes[i-start]) {
+			end = i
+		}
+	}
+
+	var tokens []Token
+	for i := end; i > start; i = nodes[i-start].from {
+		node := nodes[i-start]
+		if node.known {
+			tokens = append(tokens, known(node.entry, node.from))
+		} else {
+			tokens = append(tokens, Token{Surface: string(runes[node.from:i]), POS: Noun, Start: node.from})
+		}
+	}
+	slices.Reverse(tokens)
+	return tokens, end
+}
+
+// stems は runes[i:] の先頭に一致する活用する語の語幹を返す
+// 語幹は漢字の連続の末尾まで続き、直後にひらがな（活用語尾）が続く場合だけ使う
+// 同じ表層形の語が辞書にあり、直後が助詞の場合は語幹にしない（「話が」の「話」は名詞）
+func (t *Tokenizer) stems(runes []rune, i, runEnd int) []Entry {
+	var result []Entry
+	for _, entry := range t.dictionary.stemPrefixes(runes, i) {
+		end := i + utf8.RuneCountInString(entry.Surface)
+		if end < runEnd || end >= len(runes) || !unicode.Is(unicode.Hiragana, runes[end]) {
+			continue
+		}
+		if _, ok := t.dictionary.Lookup(entry.Surface); ok {
+			if next, _, ok := t.dictionary.longestMatch(runes, end); ok && next.POS == Particle {
+				continue
+			}
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+func known(entry Entry, start int) Token {
+	return Token{Surface: entry.Surface, POS: entry.POS, Reading: entry.Reading, Known: true, Start: start}
+}
+
+func unknownRun(surface string, class charClass) Token {
+	switch class {
+	case classKatakana:
+		return Token{Surface: surface, POS: Noun, Reading: surface}
+	case classDigit:
+		return Token{Surface: surface, POS: Number, Reading: surface}
+	case classLatin:
+		return Token{Surface: surface, POS: Noun, Reading: surface}
+	default: // 漢字の読みは辞書がなければわからない
+		return Token{Surface: surface, POS: Noun}
+	}
+}
+
+// 辞書にない漢字や活用する語の語幹の直後に辞書にないひらがなが続く場合は、語幹と送り仮名とみなしてまとめる
+// 語幹の読みがわかる場合は送り仮名の読みを足す。間に空白がある場合は別の語とする
+func attachOkurigana(tokens []Token) []Token {
+	result := make([]Token, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if isStem(token) && i+1 < len(tokens) && tokens[i+1].POS == Unknown &&
+			tokens[i+1].Start == token.Start+utf8.RuneCountInString(token.Surface) {
+			merged := Token{Surface: token.Surface + tokens[i+1].Surface, POS: Verb, Known: token.Known, Start: token.Start}
+			if token.Known {
+				merged.POS = token.POS
+				merged.Reading = token.Reading + ToKatakana(tokens[i+1].Surface)
+			}
+			token = merged
+			i++
+		}
+		result = append(result, token)
+	}
+	return result
+}
+
+// 送り仮名が続きうる語（辞書にない漢字の語か、辞書の活用する語の語幹）かどうか
+func isStem(token Token) bool {
+	if !token.Known {
+		return token.POS == Noun && token.Reading == ""
+	}
+	last, _ := utf8.DecodeLastRuneInString(token.Surface)
+	return (token.POS == Verb || token.POS == Adjective) && unicode.Is(unicode.Han, last)
+}
+
+// ToKatakana はひらがなをカタカナに変換する（ひらがな以外はそのまま）
+func ToKatakana(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if r >= 'ぁ' && r <= 'ゖ' {
+			runes[i] = r + 'ァ' - 'ぁ'
+		}
+	}
+	return string(runes)
+}
+
+// ToHiragana はカタカナをひらがなに変換する（カタカナ以外はそのまま）
+func ToHiragana(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if r >= 'ァ' && r <= 'ヶ' {
+			runes[i] = r - 'ァ' + 'ぁ'
+		}
+	}
+	return string(runes)
+}
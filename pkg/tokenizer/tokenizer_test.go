@@ -0,0 +1,109 @@
+package tokenizer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func surfaces(tokens []Token) []string {
+	result := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, token.Surface)
+	}
+	return result
+}
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize("天声人語は檸檬新聞のコラムです。")
+	assert.Equal(t, []string{"天声人語", "は", "檸檬", "新聞", "の", "コラム", "です", "。"}, surfaces(tokens))
+	assert.Equal(t, Token{Surface: "天声人語", POS: Noun, Reading: "テンセイジンゴ", Known: true}, tokens[0])
+	assert.Equal(t, Particle, tokens[1].POS)
+	assert.Equal(t, Token{Surface: "檸檬", POS: Noun, Start: 5}, tokens[2]) // 辞書にない漢字は読みのない名詞
+	assert.Equal(t, Token{Surface: "新聞", POS: Noun, Reading: "シンブン", Known: true, Start: 7}, tokens[3])
+	assert.Equal(t, Token{Surface: "コラム", POS: Noun, Reading: "コラム", Start: 10}, tokens[5])
+}
+
+func TestTokenizeCompound(t *testing.T) {
+	// 漢字の連続は辞書の語に分けて読みを付ける
+	tokens := Tokenize("日本政府は選挙の結果を発表した。")
+	assert.Equal(t, []string{"日本", "政府", "は", "選挙", "の", "結果", "を", "発表", "した", "。"}, surfaces(tokens))
+	readings := map[string]string{}
+	for _, token := range tokens {
+		readings[token.Surface] = token.Reading
+	}
+	assert.Equal(t, "ニホン", readings["日本"])
+	assert.Equal(t, "セイフ", readings["政府"])
+	assert.Equal(t, "ケッカ", readings["結果"])
+	assert.Equal(t, "ハッピョウ", readings["発表"])
+
+	// 辞書の語の間に辞書にない漢字があっても、前後の語には読みを付ける
+	tokens = Tokenize("国会檸檬答弁")
+	assert.Equal(t, []string{"国会", "檸檬", "答弁"}, surfaces(tokens))
+	assert.Equal(t, "トウベン", tokens[2].Reading)
+	assert.Empty(t, tokens[1].Reading)
+}
+
+func TestTokenizeInflection(t *testing.T) {
+	// 辞書の形のままの動詞
+	assert.Equal(t, Token{Surface: "咲く", POS: Verb, Reading: "サク", Known: true}, Tokenize("咲く")[0])
+	assert.Equal(t, Token{Surface: "書き写す", POS: Verb, Reading: "カキウツス", Known: true}, Tokenize("書き写す")[0])
+
+	// 活用した形は語幹に読みを付け、辞書にない送り仮名は語幹とまとめる
+	tokens := Tokenize("桜が咲かない")
+	assert.Equal(t, Token{Surface: "咲", POS: Verb, Reading: "サ", Known: true, Start: 2}, tokens[2])
+	tokens = Tokenize("書けば")
+	assert.Equal(t, Token{Surface: "書け", POS: Verb, Reading: "カケ", Known: true}, tokens[0])
+	tokens = Tokenize("書き写した")
+	assert.Equal(t, []string{"書き写", "した"}, surfaces(tokens))
+	assert.Equal(t, "カキウツ", tokens[0].Reading)
+
+	// 助詞が続く漢字は語幹ではなく名詞として読む
+	tokens = Tokenize("話が")
+	assert.Equal(t, Token{Surface: "話", POS: Noun, Reading: "ハナシ", Known: true}, tokens[0])
+}
+
+func TestTokenizeRuns(t *testing.T) {
+	// 辞書の短い語（人）より長い語（人間）を優先して漢字の連続を分割する
+	assert.Equal(t, []string{"人間", "関係", "に", "悩む", "人", "は", "多い"}, surfaces(Tokenize("人間関係に悩む 人は多い")))
+
+	// 辞書にない漢字と送り仮名は活用する語としてまとめる
+	tokens := Tokenize("悩む")
+	assert.Equal(t, Verb, tokens[0].POS)
+
+	// 空白を挟んだ漢字とひらがなはまとめない（開始位置は空白を含む元の文章での位置）
+	tokens = Tokenize("悩 む")
+	assert.Equal(t, []string{"悩", "む"}, surfaces(tokens))
+	assert.Equal(t, 2, tokens[1].Start)
+
+	// 長音記号はカタカナの一部として扱う
+	assert.Equal(t, []string{"スーパー", "で", "2023", "年"}, surfaces(Tokenize("スーパーで2023年")))
+}
+
+func TestLoadDictionary(t *testing.T) {
+	dictionary, err := LoadDictionary(strings.NewReader("# comment\n\nさくら\t名詞\n桜\t名詞\tサクラ\n桜\t動詞\n"))
+	assert.Nil(t, err)
+	entry, ok := dictionary.Lookup("さくら")
+	assert.True(t, ok)
+	assert.Equal(t, "サクラ", entry.Reading) // 読みを省略した場合はかなから作る
+	entry, _ = dictionary.Lookup("桜")
+	assert.Equal(t, Noun, entry.POS) // 先に書かれたものを使う
+
+	_, err = LoadDictionary(strings.NewReader("桜だけ\n"))
+	assert.NotNil(t, err)
+
+	// 活用する語は語幹も登録する
+	dictionary, err = LoadDictionary(strings.NewReader("書き写す\t動詞\tカキウツス\nします\t動詞\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, []Entry{{Surface: "書き写", POS: Verb, Reading: "カキウツ"}}, dictionary.stemPrefixes([]rune("書き写した"), 0))
+	assert.Empty(t, dictionary.stemPrefixes([]rune("します"), 0)) // 漢字のない語には語幹を作らない
+
+	// 組み込み辞書が読み込めること
+	assert.NotNil(t, DefaultDictionary())
+}
+
+func TestKana(t *testing.T) {
+	assert.Equal(t, "サクラ漢字ー", ToKatakana("さくら漢字ー"))
+	assert.Equal(t, "さくら漢字ー", ToHiragana("サクラ漢字ー"))
+}
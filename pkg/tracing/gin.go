@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"go-api-newspaper/pkg/metrics"
+)
+
+const (
+	parentContextKey = "tracing:parent_context" // バリデーション前のリクエストのコンテキスト
+	validatorSpanKey = "tracing:validator_span"
+	handlerSpanKey   = "tracing:handler_span"
+)
+
+// Middleware はリクエスト全体のスパンを作成し、traceparent ヘッダーからトレースを引き継ぐ
+func Middleware(serviceName string) gin.HandlerFunc {
+	return otelgin.Middleware(serviceName)
+}
+
+// ValidatorMiddleware はOpenAPIのリクエストバリデーションをスパンで囲む
+// バリデーターは内部でハンドラーまで呼び出すため、ハンドラーのスパンは HandlerMiddleware で分けて作成し、ここでまとめて終了する
+func ValidatorMiddleware(validator gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parent := c.Request.Context()
+		ctx, span := Tracer().Start(parent, "OapiRequestValidator")
+		c.Set(parentContextKey, parent)
+		c.Set(validatorSpanKey, span)
+		c.Request = c.Request.WithContext(ctx)
+
+		validator(c)
+
+		handlerSpan, handled := c.Get(handlerSpanKey)
+		if !handled && c.IsAborted() {
+			span.SetStatus(codes.Error, "request validation failed")
+		}
+		span.End() // ハンドラーが呼ばれた場合は既に終了している（二重に終了しても無視される）
+		if handled {
+			if c.Writer.Status() >= 500 {
+				handlerSpan.(trace.Span).SetStatus(codes.Error, "handler returned server error")
+			}
+			handlerSpan.(trace.Span).End()
+		}
+		c.Request = c.Request.WithContext(parent)
+	}
+}
+
+// HandlerMiddleware はバリデーションのスパンを終了し、ハンドラーのスパンを開始する
+// api.GinServerOptions の Middlewares に登録し、ハンドラーの直前に実行する
+func HandlerMiddleware(c *gin.Context) {
+	parent := c.Request.Context()
+	if value, ok := c.Get(parentContextKey); ok {
+		parent = value.(context.Context)
+	}
+	if validatorSpan, ok := c.Get(validatorSpanKey); ok {
+		validatorSpan.(trace.Span).End()
+	}
+
+	ctx, span := Tracer().Start(parent, "handler "+metrics.Operation(c))
+	c.Set(handlerSpanKey, span)
+	c.Request = c.Request.WithContext(ctx)
+}
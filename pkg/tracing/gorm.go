@@ -0,0 +1,91 @@
+package tracing
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const spanKey = "tracing:span"
+
+// GormPlugin はGORMの各クエリをスパンとして記録するプラグイン
+// クエリは db.WithContext で渡されたコンテキストのスパンの子になる
+type GormPlugin struct{}
+
+func (p *GormPlugin) Name() string {
+	return "tracing"
+}
+
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	if err := callback.Create().Before("gorm:create").Register("tracing:before_create", before("create")); err != nil {
+		return err
+	}
+	if err := callback.Create().After("gorm:create").Register("tracing:after_create", after); err != nil {
+		return err
+	}
+	if err := callback.Query().Before("gorm:query").Register("tracing:before_query", before("query")); err != nil {
+		return err
+	}
+	if err := callback.Query().After("gorm:query").Register("tracing:after_query", after); err != nil {
+		return err
+	}
+	if err := callback.Update().Before("gorm:update").Register("tracing:before_update", before("update")); err != nil {
+		return err
+	}
+	if err := callback.Update().After("gorm:update").Register("tracing:after_update", after); err != nil {
+		return err
+	}
+	if err := callback.Delete().Before("gorm:delete").Register("tracing:before_delete", before("delete")); err != nil {
+		return err
+	}
+	if err := callback.Delete().After("gorm:delete").Register("tracing:after_delete", after); err != nil {
+		return err
+	}
+	if err := callback.Row().Before("gorm:row").Register("tracing:before_row", before("row")); err != nil {
+		return err
+	}
+	if err := callback.Row().After("gorm:row").Register("tracing:after_row", after); err != nil {
+		return err
+	}
+	if err := callback.Raw().Before("gorm:raw").Register("tracing:before_raw", before("raw")); err != nil {
+		return err
+	}
+	return callback.Raw().After("gorm:raw").Register("tracing:after_raw", after)
+}
+
+func before(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		_, span := Tracer().Start(ctx, "gorm."+operation, trace.WithSpanKind(trace.SpanKindClient))
+		db.InstanceSet(spanKey, span)
+	}
+}
+
+func after(db *gorm.DB) {
+	value, ok := db.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(
+		semconv.DBSystemKey.String(db.Dialector.Name()),
+		semconv.DBStatement(db.Statement.SQL.String()),
+		semconv.DBSQLTable(db.Statement.Table),
+		attribute.Int64("db.rows_affected", db.Statement.RowsAffected),
+	)
+	// レコードが見つからないことは呼び出し側で扱う正常系なのでエラーにしない
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ExporterNone   = "none"   // トレースを出力しない（伝播のみ行う）
+	ExporterStdout = "stdout" // 標準出力にJSONで出力する（オフラインでの確認用）
+	ExporterOTLP   = "otlp"   // OTLP/HTTPでコレクターに送信する（送信先は OTEL_EXPORTER_OTLP_ENDPOINT で指定）
+
+	tracerName = "go-api-newspaper"
+)
+
+var errInvalidExporter = errors.New("invalid trace exporter") // 不正なエクスポーター名を扱うエラー
+
+// Tracer はアプリケーション内でスパンを作成するためのトレーサーを返す
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// NewTracerProvider は指定したエクスポーターにスパンを送るTracerProviderを生成する
+func NewTracerProvider(exporter sdktrace.SpanExporter, serviceName string) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+}
+
+// Setup はエクスポーターを生成してグローバルなTracerProviderとW3C Trace Contextの伝播を設定する
+// 戻り値の関数はシャットダウン時に呼び出し、未送信のスパンを出力する
+func Setup(ctx context.Context, exporterName string, serviceName string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, // traceparent / tracestate ヘッダー
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case ExporterNone, "":
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return nil, errInvalidExporter
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewTracerProvider(exporter, serviceName)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,108 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"go-api-newspaper/pkg/logger"
+)
+
+// stdouttrace が出力するスパンのうち、テストで確認する項目
+type exportedSpan struct {
+	Name        string
+	SpanContext struct {
+		TraceID string
+		SpanID  string
+	}
+	Parent struct {
+		SpanID string
+	}
+}
+
+func TestSpansAcrossHTTPAndDatabase(t *testing.T) {
+	var buf bytes.Buffer
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(&buf))
+	assert.Nil(t, err)
+	provider := NewTracerProvider(exporter, "test")
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.Nil(t, err)
+	assert.Nil(t, db.Use(&GormPlugin{}))
+	type sample struct {
+		ID int
+	}
+	assert.Nil(t, db.AutoMigrate(&sample{}))
+
+	var loggedTraceID string
+	router := gin.New()
+	router.Use(Middleware("test"))
+	v1 := router.Group("/api/v1")
+	v1.Use(ValidatorMiddleware(func(c *gin.Context) { c.Next() })) // バリデーションを通過したものとして扱う
+	v1.GET("/sample/:id", HandlerMiddleware, func(c *gin.Context) {
+		db.WithContext(c.Request.Context()).Find(&[]sample{})
+		for _, field := range logger.TraceFields(c.Request.Context()) {
+			if field.Key == "trace_id" {
+				loggedTraceID = field.String
+			}
+		}
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	w := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/api/v1/sample/1", nil)
+	request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	router.ServeHTTP(w, request)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Nil(t, provider.ForceFlush(context.Background()))
+
+	spans := map[string]exportedSpan{}
+	decoder := json.NewDecoder(&buf)
+	for {
+		var span exportedSpan
+		if err := decoder.Decode(&span); err == io.EOF {
+			break
+		} else {
+			assert.Nil(t, err)
+		}
+		spans[span.Name] = span
+	}
+
+	server := spans["/api/v1/sample/:id"]
+	validator := spans["OapiRequestValidator"]
+	handler := spans["handler /api/v1/sample/:id"]
+	query := spans["gorm.query"]
+
+	// 全てのスパンが traceparent ヘッダーのトレースに属する
+	for _, span := range []exportedSpan{server, validator, handler, query} {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID)
+	}
+	assert.Equal(t, "00f067aa0ba902b7", server.Parent.SpanID)
+	assert.Equal(t, server.SpanContext.SpanID, validator.Parent.SpanID)
+	assert.Equal(t, server.SpanContext.SpanID, handler.Parent.SpanID)
+	assert.Equal(t, handler.SpanContext.SpanID, query.Parent.SpanID)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", loggedTraceID)
+}
+
+func TestSetupInvalidExporter(t *testing.T) {
+	_, err := Setup(context.Background(), "unknown", "test")
+	assert.Equal(t, errInvalidExporter, err)
+
+	shutdown, err := Setup(context.Background(), ExporterNone, "test")
+	assert.Nil(t, err)
+	assert.Nil(t, shutdown(context.Background()))
+}
@@ -0,0 +1,164 @@
+// Package transcription は書き写した文章を原文と文字（rune）単位で突き合わせ、正確さと誤りの箇所を求める
+package transcription
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"go-api-newspaper/pkg/textdiff"
+)
+
+// MaxRunes は突き合わせる文字数（空白を除く）の上限
+// 差分の計算量は文字数に比例して増えるため、これを超える部分は突き合わせずに脱字・衍字として数える
+const MaxRunes = 10000
+
+// Category は誤りの種類
+type Category string
+
+const (
+	Missing     Category = "missing"     // 原文の文字が書き写されていない（脱字）
+	Extra       Category = "extra"       // 原文にない文字が書き写されている（衍字）
+	Substituted Category = "substituted" // 原文の文字が別の文字に書き違えられている（誤字）
+)
+
+// Error は連続する同じ種類の誤り
+type Error struct {
+	Category Category
+	Position int    // 原文における文字（rune）単位の位置（extraの場合は直後の原文の文字の位置）
+	Expected string // 原文の文字列（extraの場合は空）
+	Actual   string // 書き写した文字列（missingの場合は空）
+}
+
+// Result は書き写しの採点結果
+type Result struct {
+	Correct     int // 原文と一致した文字数
+	Missing     int
+	Extra       int
+	Substituted int
+	Errors      []Error
+}
+
+// Distance は原文から書き写した文章への編集距離（脱字・衍字・誤字の文字数の合計）を返す
+func (r Result) Distance() int {
+	return r.Missing + r.Extra + r.Substituted
+}
+
+// Accuracy は突き合わせた文字のうち一致した文字の割合（0〜1）を返す
+// 衍字も分母に含めるため、原文を全て書き写していても余計な文字があれば1にならない
+func (r Result) Accuracy() float64 {
+	total := r.Correct + r.Distance()
+	if total == 0 {
+		return 1 // 原文も書き写した文章も空
+	}
+	return float64(r.Correct) / float64(total)
+}
+
+// Score は原文expectedと書き写した文章actualを比較する
+// 改行や字下げの違いは問わないため、空白文字は取り除いてから比較する（誤りの位置は空白を含む原文での位置）
+func Score(expected, actual string) Result {
+	expectedRunes, positions := stripSpace(expected)
+	actualRunes, _ := stripSpace(actual)
+
+	// i番目の文字の原文での位置（末尾の場合は原文の文字数）
+	end := utf8.RuneCountInString(expected)
+	position := func(i int) int {
+		if i < len(positions) {
+			return positions[i]
+		}
+		return end
+	}
+
+	var expectedRest, actualRest []rune
+	if len(expectedRunes) > MaxRunes {
+		expectedRunes, expectedRest = expectedRunes[:MaxRunes], expectedRunes[MaxRunes:]
+	}
+	if len(actualRunes) > MaxRunes {
+		actualRunes, actualRest = actualRunes[:MaxRunes], actualRunes[MaxRunes:]
+	}
+
+	result := Result{Errors: []Error{}}
+	edits := textdiff.Runes(string(expectedRunes), string(actualRunes))
+	i := 0 // 原文の何文字目まで進んだか
+	for k := 0; k < len(edits); k++ {
+		edit := edits[k]
+		if edit.Op == textdiff.OpEqual {
+			n := utf8.RuneCountInString(edit.Text)
+			result.Correct += n
+			i += n
+			continue
+		}
+
+		// 隣り合う削除と追加は、重なる文字数を誤字とみなして対応付ける
+		var deleted, inserted []rune
+		for ; k < len(edits) && edits[k].Op != textdiff.OpEqual; k++ {
+			if edits[k].Op == textdiff.OpDelete {
+				deleted = append(deleted, []rune(edits[k].Text)...)
+			} else {
+				inserted = append(inserted, []rune(edits[k].Text)...)
+			}
+		}
+		k--
+
+		n := min(len(deleted), len(inserted))
+		if n > 0 {
+			result.Substituted += n
+			result.Errors = append(result.Errors, Error{
+				Category: Substituted,
+				Position: position(i),
+				Expected: string(deleted[:n]),
+				Actual:   string(inserted[:n]),
+			})
+		}
+		if len(deleted) > n {
+			result.Missing += len(deleted) - n
+			result.Errors = append(result.Errors, Error{
+				Category: Missing,
+				Position: position(i + n),
+				Expected: string(deleted[n:]),
+			})
+		}
+		if len(inserted) > n {
+			result.Extra += len(inserted) - n
+			result.Errors = append(result.Errors, Error{
+				Category: Extra,
+				Position: position(i + len(deleted)),
+				Actual:   string(inserted[n:]),
+			})
+		}
+		i += len(deleted)
+	}
+
+	// 上限を超えた部分
+	if len(expectedRest) > 0 {
+		result.Missing += len(expectedRest)
+		result.Errors = append(result.Errors, Error{
+			Category: Missing,
+			Position: position(i),
+			Expected: string(expectedRest),
+		})
+	}
+	if len(actualRest) > 0 {
+		result.Extra += len(actualRest)
+		result.Errors = append(result.Errors, Error{
+			Category: Extra,
+			Position: position(i + len(expectedRest)),
+			Actual:   string(actualRest),
+		})
+	}
+	return result
+}
+
+// 空白文字を取り除いた文字列と、残した各文字の元の文字列での位置を返す
+func stripSpace(s string) ([]rune, []int) {
+	var runes []rune
+	var positions []int
+	i := 0
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			runes = append(runes, r)
+			positions = append(positions, i)
+		}
+		i++
+	}
+	return runes, positions
+}
@@ -0,0 +1,80 @@
+package transcription
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreExact(t *testing.T) {
+	// 改行や字下げの違いは誤りとしない
+	result := Score("春はあけぼの。\n　やうやう白くなりゆく", "春はあけぼの。やうやう白く\nなりゆく")
+	assert.Equal(t, 17, result.Correct)
+	assert.Equal(t, 0, result.Distance())
+	assert.Equal(t, 1.0, result.Accuracy())
+	assert.Empty(t, result.Errors)
+}
+
+func TestScoreErrors(t *testing.T) {
+	result := Score("春はあけぼの。", "夏はよる。")
+	assert.Equal(t, []Error{
+		{Category: Substituted, Position: 0, Expected: "春", Actual: "夏"},
+		{Category: Substituted, Position: 2, Expected: "あけ", Actual: "よる"},
+		{Category: Missing, Position: 4, Expected: "ぼの"},
+	}, result.Errors)
+	assert.Equal(t, 2, result.Correct)
+	assert.Equal(t, 3, result.Substituted)
+	assert.Equal(t, 2, result.Missing)
+	assert.Equal(t, 0, result.Extra)
+	assert.InDelta(t, 2.0/7.0, result.Accuracy(), 1e-9)
+
+	result = Score("春はあけぼの。", "春はあけぼのだ。")
+	assert.Equal(t, []Error{{Category: Extra, Position: 6, Actual: "だ"}}, result.Errors)
+	assert.InDelta(t, 7.0/8.0, result.Accuracy(), 1e-9)
+
+	// 書き写した文章の末尾に余計な文字がある場合は原文の末尾の位置になる
+	result = Score("春はあけぼの", "春はあけぼの。")
+	assert.Equal(t, []Error{{Category: Extra, Position: 6, Actual: "。"}}, result.Errors)
+}
+
+func TestScorePositionWithSpaces(t *testing.T) {
+	// 誤りの位置は空白を含む原文での位置
+	result := Score("春は\nあけぼの。", "春はあけの。")
+	assert.Equal(t, []Error{{Category: Missing, Position: 5, Expected: "ぼ"}}, result.Errors)
+}
+
+func TestScoreEmpty(t *testing.T) {
+	assert.Equal(t, 1.0, Score("", "").Accuracy())
+
+	result := Score("春", "")
+	assert.Equal(t, []Error{{Category: Missing, Position: 0, Expected: "春"}}, result.Errors)
+	assert.Equal(t, 0.0, result.Accuracy())
+}
+
+func TestScoreLimit(t *testing.T) {
+	// 原文が上限より長い場合、上限を超えた部分は脱字として数える
+	expected := strings.Repeat("春", MaxRunes) + "夏秋"
+	result := Score(expected, strings.Repeat("春", MaxRunes))
+	assert.Equal(t, MaxRunes, result.Correct)
+	assert.Equal(t, []Error{{Category: Missing, Position: MaxRunes, Expected: "夏秋"}}, result.Errors)
+
+	result = Score("春", "春"+strings.Repeat("夏", MaxRunes))
+	assert.Equal(t, MaxRunes, result.Extra)
+}
+
+func TestScoreLargeMemory(t *testing.T) {
+	expected := strings.Repeat("春はあけぼの。", 2000)
+	actual := strings.Repeat("夏はよる。", 3000)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	result := Score(expected, actual)
+	runtime.ReadMemStats(&after)
+
+	assert.Equal(t, len([]rune(expected)), result.Correct+result.Missing+result.Substituted)
+	// 上限いっぱいの 10000 文字同士でも割り当ては入力に比例する程度に収まる
+	assert.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(64<<20))
+}
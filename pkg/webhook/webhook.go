@@ -0,0 +1,158 @@
+// Package webhook は購読先のURLにイベントのJSONを署名して送る
+//
+// 受け取る側は X-Webhook-Signature ヘッダーの値と、共有している秘密鍵で計算したリクエストボディの
+// HMAC-SHA256 を比べることで、送り主と内容が正しいことを確かめられる
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature" // ボディの署名（sha256=<16進数>）
+	EventHeader     = "X-Webhook-Event"     // イベントの種類
+	DeliveryHeader  = "X-Webhook-Delivery"  // 配信ごとのID（再配信では別のIDになる）
+
+	signaturePrefix = "sha256="
+	userAgent       = "go-api-newspaper-webhook/1.0"
+	maxDiscardBody  = 64 << 10 // 読み捨てるレスポンスボディの長さの上限（超える場合はコネクションを再利用しない）
+)
+
+// 内部のネットワークのアドレスに送ろうとした場合のエラー
+var ErrForbiddenAddress = errors.New("forbidden address")
+
+// 送り先にしないアドレスの範囲（IsPrivate などで判定できないもの）
+var forbiddenNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),     // このネットワーク
+	mustParseCIDR("100.64.0.0/10"), // キャリアグレードNAT
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// forbidden はアドレスが内部のネットワーク（ループバック・プライベート・リンクローカルなど）のものかを判定する
+func forbidden(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsUnspecified() || ip.IsMulticast() {
+		return true
+	}
+	for _, network := range forbiddenNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign はボディの署名を X-Webhook-Signature ヘッダーの形式で返す
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify は署名がボディと秘密鍵に一致するかを判定する（受け取る側での検証用）
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Backoff は attempts 回目の送信に失敗した後、次に送るまでの待ち時間を返す
+// 最初は base で、失敗するたびに2倍にし、max を超えないようにする
+func Backoff(attempts int, base time.Duration, max time.Duration) time.Duration {
+	wait := base
+	for i := 1; i < attempts && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		return max
+	}
+	return wait
+}
+
+// Message は1回の配信で送る内容
+type Message struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID int
+	Body       []byte
+}
+
+// Sender はメッセージをHTTPのPOSTで送る
+type Sender struct {
+	client *http.Client
+}
+
+// NewSender は timeout を1回の送信の時間の上限とする Sender を作る
+// 購読先のURLは誰でも登録できるため、allowPrivate が false の場合は内部のネットワークのアドレスには接続しない
+// 名前解決した後のアドレスを接続時に確かめるので、DNSの応答を差し替えられても内部には送らない
+// リダイレクトには従わない（3xx は失敗とする）
+func NewSender(timeout time.Duration, allowPrivate bool) *Sender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || forbidden(ip) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, host)
+			}
+			return nil
+		}
+	}
+	return &Sender{client: &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext, // プロキシを経由すると接続先を確かめられないため使わない
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// Send はメッセージを送り、レスポンスのステータスコードを返す
+// 2xx 以外のレスポンスはエラーとする（接続に失敗した場合のステータスコードは0）
+// 配信の記録は誰でも読めるため、エラーにはレスポンスボディを含めない
+func (s *Sender) Send(ctx context.Context, message Message) (int, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, message.URL, bytes.NewReader(message.Body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", userAgent)
+	request.Header.Set(EventHeader, message.Event)
+	request.Header.Set(DeliveryHeader, strconv.Itoa(message.DeliveryID))
+	request.Header.Set(SignatureHeader, Sign(message.Secret, message.Body))
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(response.Body, maxDiscardBody)) // コネクションを再利用できるように読み切る
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("unexpected status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
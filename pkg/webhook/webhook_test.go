@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	body := []byte(`{"event":"article.created"}`)
+	signature := Sign("secret", body)
+	assert.Equal(t, "sha256=", signature[:7])
+	assert.Len(t, signature, 7+64)
+	assert.True(t, Verify("secret", body, signature))
+	assert.False(t, Verify("other", body, signature))
+	assert.False(t, Verify("secret", []byte(`{}`), signature))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, Backoff(1, time.Minute, time.Hour))
+	assert.Equal(t, 2*time.Minute, Backoff(2, time.Minute, time.Hour))
+	assert.Equal(t, 8*time.Minute, Backoff(4, time.Minute, time.Hour))
+	assert.Equal(t, time.Hour, Backoff(10, time.Minute, time.Hour))
+}
+
+func TestSend(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+		w.Write([]byte("busy"))
+	}))
+	defer server.Close()
+
+	sender := NewSender(time.Second, true) // テストのサーバーはループバックで待ち受ける
+	message := Message{URL: server.URL, Secret: "secret", Event: "article.created", DeliveryID: 7, Body: []byte(`{"id":1}`)}
+	code, err := sender.Send(context.Background(), message)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, `{"id":1}`, string(receivedBody))
+	assert.Equal(t, "article.created", received.Header.Get(EventHeader))
+	assert.Equal(t, "7", received.Header.Get(DeliveryHeader))
+	assert.True(t, Verify("secret", receivedBody, received.Header.Get(SignatureHeader)))
+
+	status = http.StatusServiceUnavailable
+	code, err = sender.Send(context.Background(), message)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.NotContains(t, err.Error(), "busy") // レスポンスボディは記録しない
+}
+
+func TestSendForbiddenAddress(t *testing.T) {
+	requests := 0
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer internal.Close()
+
+	// 内部のネットワークのアドレスには接続しない
+	message := Message{URL: internal.URL, Secret: "secret", Event: "article.created", Body: []byte(`{}`)}
+	code, err := NewSender(time.Second, false).Send(context.Background(), message)
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	assert.Equal(t, 0, code)
+	assert.Equal(t, 0, requests)
+
+	for _, ip := range []string{"127.0.0.1", "10.0.0.1", "192.168.1.1", "169.254.169.254", "0.0.0.0", "::1", "fd00::1", "100.64.0.1"} {
+		assert.True(t, forbidden(net.ParseIP(ip)), ip)
+	}
+	assert.False(t, forbidden(net.ParseIP("93.184.216.34")))
+}
+
+func TestSendNoRedirect(t *testing.T) {
+	requests := 0
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer internal.Close()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, internal.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	// リダイレクトには従わず失敗とする
+	message := Message{URL: server.URL, Secret: "secret", Event: "article.created", Body: []byte(`{}`)}
+	code, err := NewSender(time.Second, true).Send(context.Background(), message)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, code)
+	assert.Equal(t, 0, requests)
+}